@@ -0,0 +1,189 @@
+package mime
+
+import (
+	"fmt"
+	"mime"
+	"sort"
+)
+
+// builtinAliases maps legacy, experimental and misspelt type names, as sent
+// by clients or found in extToMimeType, to the IANA-registered name. Types
+// under non-standard top-level names such as chemical/ or x-world/ that have
+// no registered equivalent are left alone.
+var builtinAliases = map[string]string{
+	"application/x-pdf":               "application/pdf",
+	"application/acrobat":             "application/pdf",
+	"text/pdf":                        "application/pdf",
+	"text/xml":                        "application/xml",
+	"application/x-xml":               "application/xml",
+	"application/javascript":          "text/javascript",
+	"application/x-javascript":        "text/javascript",
+	"application/ecmascript":          "text/javascript",
+	"text/x-javascript":               "text/javascript",
+	"text/ecmascript":                 "text/javascript",
+	"application/x-json":              "application/json",
+	"text/json":                       "application/json",
+	"text/x-json":                     "application/json",
+	"application/x-gzip":              "application/gzip",
+	"application/x-zip":               "application/zip",
+	"application/x-zip-compressed":    "application/zip",
+	"application/x-rar":               "application/vnd.rar",
+	"application/x-rar-compressed":    "application/vnd.rar",
+	"application/x-ogg":               "application/ogg",
+	"application/x-rtf":               "text/rtf",
+	"application/rtf":                 "text/rtf",
+	"application/x-troff":             "text/troff",
+	"application/x-shockwave-flash":   "application/vnd.adobe.flash.movie",
+	"application/x-java-archive":      "application/java-archive",
+	"application/x-msdownload":        "application/vnd.microsoft.portable-executable",
+	"application/x-msdos-program":     "application/vnd.microsoft.portable-executable",
+	"application/x-ms-dos-executable": "application/vnd.microsoft.portable-executable",
+	"application/x-shellscript":       "application/x-sh",
+	"text/x-sh":                       "application/x-sh",
+	"application/x-dosexec":           "application/vnd.microsoft.portable-executable",
+	"application/x-pkcs12":            "application/pkcs12",
+	"application/x-pkcs7-mime":        "application/pkcs7-mime",
+	"application/x-pkcs7-signature":   "application/pkcs7-signature",
+	"application/vnd.ms-pkiseccat":    "application/vnd.ms-pki.seccat",
+	"application/vnd.ms-pkistl":       "application/vnd.ms-pki.stl",
+	"application/ynd.ms-pkipko":       "application/vnd.ms-pki.pko",
+	"application/x-font-ttf":          "font/ttf",
+	"application/csv":                 "text/csv",
+	"text/x-csv":                      "text/csv",
+	"text/x-markdown":                 "text/markdown",
+	"text/x-vcard":                    "text/vcard",
+	"text/x-vcalendar":                "text/calendar",
+	"image/jpg":                       "image/jpeg",
+	"image/pjpeg":                     "image/jpeg",
+	"image/pipeg":                     "image/jpeg",
+	"image/x-png":                     "image/png",
+	"image/svg":                       "image/svg+xml",
+	"image/x-icon":                    "image/vnd.microsoft.icon",
+	"image/x-ms-bmp":                  "image/bmp",
+	"image/x-bmp":                     "image/bmp",
+	"image/vnd.djvu+multipage":        "image/vnd.djvu",
+	"audio/x-wav":                     "audio/vnd.wave",
+	"audio/wav":                       "audio/vnd.wave",
+	"audio/wave":                      "audio/vnd.wave",
+	"audio/mp3":                       "audio/mpeg",
+	"audio/x-mp3":                     "audio/mpeg",
+	"audio/x-mpeg":                    "audio/mpeg",
+	"audio/mid":                       "audio/midi",
+	"audio/x-midi":                    "audio/midi",
+	"audio/x-pn-realaudio-plugin":     "audio/x-pn-realaudio",
+	"video/ogv":                       "video/ogg",
+	"video/x-mpeg":                    "video/mpeg",
+	"video/x-mp4":                     "video/mp4",
+	"drawing/x-dwf":                   "model/vnd.dwf",
+	"x-world/x-vrml":                  "model/vrml",
+	"i-world/i-vrml":                  "model/vrml",
+}
+
+// Canonical returns the registered name for typ, resolving aliases recorded
+// in r. The type is lower-cased; parameters are kept. A typ that cannot be
+// parsed is only lower-cased and stripped of its parameters.
+func (r *Registry) Canonical(typ string) string {
+	mediaType, params, err := mime.ParseMediaType(typ)
+	if err != nil {
+		return r.canonical(baseType(typ))
+	}
+	canon := r.canonical(mediaType)
+	if len(params) == 0 {
+		return canon
+	}
+	if s := mime.FormatMediaType(canon, params); s != "" {
+		return s
+	}
+	return canon
+}
+
+// canonical resolves the lower-cased base type typ through the alias table.
+func (r *Registry) canonical(typ string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.canonicalLocked(typ)
+}
+
+func (r *Registry) canonicalLocked(typ string) string {
+	// Follow chains of aliases, guarding against cycles.
+	for i := 0; i < 8; i++ {
+		c, ok := r.aliases[typ]
+		if !ok || c == typ {
+			break
+		}
+		typ = c
+	}
+	return typ
+}
+
+// Aliases returns the names that resolve to the canonical form of typ, in
+// lexical order. The canonical name itself is not included.
+func (r *Registry) Aliases(typ string) []string {
+	canon := r.canonical(baseType(typ))
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []string
+	for a := range r.aliases {
+		if a != canon && r.canonicalLocked(a) == canon {
+			out = append(out, a)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// AddAlias records alias as another name for the type canonical. Parent
+// relations recorded under alias move to canonical, and relations naming
+// alias as a parent now name canonical. Both names must be bare MIME types
+// without parameters.
+func (r *Registry) AddAlias(alias, canonical string) error {
+	alias, canonical = baseType(alias), baseType(canonical)
+	for _, typ := range []string{alias, canonical} {
+		if err := checkType(typ); err != nil {
+			return fmt.Errorf("mime: %v", err)
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.setAlias(alias, canonical)
+	return nil
+}
+
+// setAlias records alias as a name of canonical. An existing alias entry
+// pointing the other way is dropped so that the table stays free of cycles.
+// The parent relations are then rewritten to use the canonical name, as
+// addParent would have recorded them. r.mu must be held.
+func (r *Registry) setAlias(alias, canonical string) {
+	if alias == canonical {
+		return
+	}
+	if r.canonicalLocked(canonical) == alias {
+		delete(r.aliases, canonical)
+	}
+	r.aliases[alias] = canonical
+	if ps, ok := r.parents[alias]; ok {
+		delete(r.parents, alias)
+		for _, p := range ps {
+			r.addParent(alias, p)
+		}
+	}
+	for typ, ps := range r.parents {
+		var canon []string
+		for _, p := range ps {
+			if p = r.canonicalLocked(p); p != typ {
+				canon = appendUnique(canon, p)
+			}
+		}
+		r.parents[typ] = canon
+	}
+}
+
+// Canonical returns the registered name for typ in DefaultRegistry.
+func Canonical(typ string) string {
+	return DefaultRegistry.Canonical(typ)
+}
+
+// Aliases returns the aliases of typ in DefaultRegistry.
+func Aliases(typ string) []string {
+	return DefaultRegistry.Aliases(typ)
+}
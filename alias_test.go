@@ -0,0 +1,115 @@
+package mime
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		typ, want string
+	}{
+		{"application/x-shockwave-flash", "application/vnd.adobe.flash.movie"},
+		{"Application/X-PDF", "application/pdf"},
+		{"text/xml; charset=utf-8", "application/xml; charset=utf-8"},
+		{"image/jpg", "image/jpeg"},
+		{"video/ogv", "video/ogg"},
+		{"application/x-msdownload", "application/vnd.microsoft.portable-executable"},
+		{"application/x-java-archive", "application/java-archive"},
+		{"image/png", "image/png"},
+	}
+	for _, tt := range tests {
+		if got := Canonical(tt.typ); got != tt.want {
+			t.Errorf("Canonical(%q) = %q, want %q", tt.typ, got, tt.want)
+		}
+	}
+}
+
+// TestBuiltinAliasTargets checks that aliases resolve to the names the
+// built-in database uses, so that flags and kinds recorded under them apply.
+func TestBuiltinAliasTargets(t *testing.T) {
+	if got := Canonical(TypeByExtension("a.swf")); got != "application/vnd.adobe.flash.movie" {
+		t.Errorf("Canonical(TypeByExtension(a.swf)) = %q", got)
+	}
+	if !HasFlags(TypeByExtension("a.swf"), Scriptable) {
+		t.Errorf("a.swf is not Scriptable")
+	}
+	if k := Kind("application/x-shockwave-flash"); k != KindVideo {
+		t.Errorf("Kind(application/x-shockwave-flash) = %v, want %v", k, KindVideo)
+	}
+}
+
+func TestJavaArchiveAlias(t *testing.T) {
+	exts, err := ExtensionsByType("application/java-archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jar bool
+	for _, ext := range exts {
+		jar = jar || ext == ".jar"
+	}
+	if !jar {
+		t.Errorf("ExtensionsByType(application/java-archive) = %q, want .jar among them", exts)
+	}
+	if got := Canonical(TypeByExtension("app.jar")); got != "application/java-archive" {
+		t.Errorf("Canonical(TypeByExtension(app.jar)) = %q", got)
+	}
+}
+
+func TestAddAliasParents(t *testing.T) {
+	r := NewRegistry()
+	r.AddSharedMimeInfo(&SharedMimeInfo{Types: []TypeInfo{
+		{Type: "application/x-child", SubClassOf: []string{"application/x-old"}},
+		{Type: "application/x-old", SubClassOf: []string{"application/x-grand"}},
+		{Type: "application/x-sibling", SubClassOf: []string{"application/x-new", "application/x-old"}},
+	}})
+	if err := r.AddAlias("application/x-old", "Application/X-New"); err != nil {
+		t.Fatal(err)
+	}
+	for typ, want := range map[string][]string{
+		"application/x-child":   {"application/x-new"},
+		"application/x-new":     {"application/x-grand"},
+		"application/x-old":     {"application/x-grand"},
+		"application/x-sibling": {"application/x-new"},
+	} {
+		if got := r.Parents(typ); !reflect.DeepEqual(got, want) {
+			t.Errorf("Parents(%q) = %q, want %q", typ, got, want)
+		}
+	}
+	want := []string{"application/x-new", "application/x-grand", typeOctetStream}
+	if got := r.Ancestors("application/x-child"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Ancestors(application/x-child) = %q, want %q", got, want)
+	}
+
+	// An alias imported after the types that name it still links them.
+	r.AddSharedMimeInfo(&SharedMimeInfo{Types: []TypeInfo{
+		{Type: "application/x-newest", Aliases: []string{"application/x-new"}},
+	}})
+	want = []string{"application/x-newest", "application/x-grand", typeOctetStream}
+	if got := r.Ancestors("application/x-child"); !reflect.DeepEqual(got, want) {
+		t.Errorf("after import, Ancestors(application/x-child) = %q, want %q", got, want)
+	}
+}
+
+func TestAddAliasErrors(t *testing.T) {
+	r := NewRegistry()
+	for _, tt := range [][2]string{
+		{"application/x-a", "x-b"},
+		{"x-a", "application/x-b"},
+		{"", "application/x-b"},
+		{"application/x-a", "application/"},
+	} {
+		if err := r.AddAlias(tt[0], tt[1]); err == nil {
+			t.Errorf("AddAlias(%q, %q): no error", tt[0], tt[1])
+		}
+	}
+	if got := r.Canonical("application/x-a"); got != "application/x-a" {
+		t.Errorf("Canonical(application/x-a) = %q after rejected aliases", got)
+	}
+	if err := r.AddAlias("application/x-a; v=1", "application/x-b"); err != nil {
+		t.Errorf("AddAlias with parameters: %v", err)
+	}
+	if got := r.Canonical("application/x-a"); got != "application/x-b" {
+		t.Errorf("Canonical(application/x-a) = %q, want application/x-b", got)
+	}
+}
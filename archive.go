@@ -0,0 +1,141 @@
+package mime
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// Limits on the central directory of the archives DetectArchive and
+// DetectOffice open. They are checked against the end of central directory
+// record before the directory itself is read.
+const (
+	maxArchiveEntries       = 1 << 16
+	maxCentralDirectorySize = 1 << 24
+)
+
+const (
+	typeZip  = "application/zip"
+	typeJar  = "application/java-archive"
+	typeAPK  = "application/vnd.android.package-archive"
+	typeXPI  = "application/x-xpinstall"
+	typeKMZ  = "application/vnd.google-earth.kmz"
+	typeEPUB = "application/epub+zip"
+)
+
+// DetectArchive inspects the ZIP archive r of the given size and tells apart
+// the formats built on ZIP: Office Open XML and OpenDocument files (see
+// DetectOffice), EPUB, Android APK, Java JAR and WAR, Firefox XPI and Google
+// Earth KMZ. Other archives are reported as application/zip. Only the
+// central directory and a few small entries are read, with fixed size
+// limits, so it is safe to run on untrusted uploads. An error is returned
+// when r is not a readable ZIP archive or its central directory exceeds
+// those limits.
+func DetectArchive(r io.ReaderAt, size int64) (string, error) {
+	zr, err := openZip(r, size)
+	if err != nil {
+		return "", err
+	}
+	if typ, err := detectOffice(zr); err != nil || typ != "" {
+		return typ, err
+	}
+
+	names := make(map[string]bool, len(zr.File))
+	var rootKML bool
+	for _, f := range zr.File {
+		names[f.Name] = true
+		if !strings.Contains(f.Name, "/") && strings.EqualFold(path.Ext(f.Name), ".kml") {
+			rootKML = true
+		}
+	}
+	switch {
+	case names["AndroidManifest.xml"] && names["classes.dex"]:
+		return typeAPK, nil
+	case names["install.rdf"] || names["manifest.json"] && names["META-INF/mozilla.rsa"]:
+		return typeXPI, nil
+	case names["META-INF/container.xml"]:
+		return typeEPUB, nil
+	case names["META-INF/MANIFEST.MF"] || names["WEB-INF/web.xml"]:
+		return typeJar, nil
+	case rootKML:
+		return typeKMZ, nil
+	}
+	return typeZip, nil
+}
+
+// openZip opens the ZIP archive r of the given size after checking, from its
+// end of central directory record, that the central directory stays within
+// maxArchiveEntries and maxCentralDirectorySize.
+func openZip(r io.ReaderAt, size int64) (*zip.Reader, error) {
+	entries, dirSize, err := zipDirectorySize(r, size)
+	if err != nil {
+		return nil, err
+	}
+	if entries > maxArchiveEntries || dirSize > maxCentralDirectorySize {
+		return nil, fmt.Errorf("mime: zip archive with %d entries in a %d-byte central directory exceeds the limits", entries, dirSize)
+	}
+	return zip.NewReader(r, size)
+}
+
+// zipDirectorySize returns the number of entries and the size of the
+// central directory recorded in the end of central directory record of r,
+// or in its ZIP64 counterpart. Archives without such a record are left for
+// zip.NewReader to reject.
+func zipDirectorySize(r io.ReaderAt, size int64) (entries, dirSize uint64, err error) {
+	const (
+		eocdLen       = 22
+		zip64LocLen   = 20
+		zip64EOCDLen  = 56
+		maxCommentLen = 1<<16 - 1
+	)
+	n := int64(eocdLen + maxCommentLen)
+	if n > size {
+		n = size
+	}
+	buf := make([]byte, n)
+	if _, err := r.ReadAt(buf, size-n); err != nil && err != io.EOF {
+		return 0, 0, err
+	}
+	i := bytes.LastIndex(buf, []byte("PK\x05\x06"))
+	if i < 0 || len(buf)-i < eocdLen {
+		return 0, 0, nil
+	}
+	eocd := buf[i:]
+	entries = uint64(binary.LittleEndian.Uint16(eocd[10:]))
+	dirSize = uint64(binary.LittleEndian.Uint32(eocd[12:]))
+	if entries != 0xFFFF && dirSize != 0xFFFFFFFF {
+		return entries, dirSize, nil
+	}
+
+	// ZIP64: the locator just before the record points at the real one.
+	locOff := size - n + int64(i) - zip64LocLen
+	if locOff < 0 {
+		return entries, dirSize, nil
+	}
+	loc := make([]byte, zip64LocLen)
+	if _, err := r.ReadAt(loc, locOff); err != nil {
+		return 0, 0, err
+	}
+	if !bytes.HasPrefix(loc, []byte("PK\x06\x07")) {
+		return entries, dirSize, nil
+	}
+	off := binary.LittleEndian.Uint64(loc[8:])
+	if off > uint64(size) {
+		return 0, 0, zip.ErrFormat
+	}
+	rec := make([]byte, zip64EOCDLen)
+	if _, err := r.ReadAt(rec, int64(off)); err != nil {
+		if err == io.EOF {
+			err = zip.ErrFormat
+		}
+		return 0, 0, err
+	}
+	if !bytes.HasPrefix(rec, []byte("PK\x06\x06")) {
+		return 0, 0, zip.ErrFormat
+	}
+	return binary.LittleEndian.Uint64(rec[32:]), binary.LittleEndian.Uint64(rec[40:]), nil
+}
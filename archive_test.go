@@ -0,0 +1,86 @@
+package mime
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestDetectArchive(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []string
+		want    string
+	}{
+		{"jar", []string{"META-INF/MANIFEST.MF", "Manifest-Version: 1.0\n", "a/B.class", "\xCA\xFE\xBA\xBE"}, typeJar},
+		{"war", []string{"WEB-INF/web.xml", "<web-app/>"}, typeJar},
+		{"apk", []string{"AndroidManifest.xml", "\x03\x00", "classes.dex", "dex\n035\x00"}, typeAPK},
+		{"xpi", []string{"install.rdf", "<RDF/>"}, typeXPI},
+		{"epub", []string{"mimetype", "application/epub+zip", "META-INF/container.xml", "<container/>"}, typeEPUB},
+		{"kmz", []string{"doc.kml", "<kml/>"}, typeKMZ},
+		{"zip", []string{"a.txt", "hello", "dir/doc.kml", "<kml/>"}, typeZip},
+		{"docx", []string{"[Content_Types].xml", contentTypes("/word/document.xml", "application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml")},
+			"application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+	}
+	for _, tt := range tests {
+		data := zipFile(t, tt.entries...)
+		got, err := DetectArchive(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: DetectArchive = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// eocd returns an end of central directory record.
+func eocd(entries uint16, dirSize, dirOffset uint32) []byte {
+	b := make([]byte, 22)
+	copy(b, "PK\x05\x06")
+	binary.LittleEndian.PutUint16(b[8:], entries)
+	binary.LittleEndian.PutUint16(b[10:], entries)
+	binary.LittleEndian.PutUint32(b[12:], dirSize)
+	binary.LittleEndian.PutUint32(b[16:], dirOffset)
+	return b
+}
+
+func TestDetectArchiveLimits(t *testing.T) {
+	// A ZIP64 archive claiming more entries than the limit.
+	rec := make([]byte, 56)
+	copy(rec, "PK\x06\x06")
+	binary.LittleEndian.PutUint64(rec[4:], 44)
+	binary.LittleEndian.PutUint64(rec[24:], 100000)
+	binary.LittleEndian.PutUint64(rec[32:], 100000)
+	binary.LittleEndian.PutUint64(rec[40:], 4600000)
+	loc := make([]byte, 20)
+	copy(loc, "PK\x06\x07")
+	binary.LittleEndian.PutUint64(loc[8:], 0)
+	binary.LittleEndian.PutUint32(loc[16:], 1)
+	zip64 := append(append(rec, loc...), eocd(0xFFFF, 0xFFFFFFFF, 0xFFFFFFFF)...)
+
+	tests := map[string][]byte{
+		"zip64 entries":  zip64,
+		"directory size": append(make([]byte, 100), eocd(10, 1<<25, 0)...),
+		"after comment":  append(append(make([]byte, 10), eocd(10, maxCentralDirectorySize+1, 0)...), "trailing comment"...),
+	}
+	for name, data := range tests {
+		if _, err := DetectArchive(bytes.NewReader(data), int64(len(data))); err == nil || !strings.Contains(err.Error(), "exceeds the limits") {
+			t.Errorf("%s: DetectArchive error = %v", name, err)
+		}
+		if _, err := DetectOffice(bytes.NewReader(data), int64(len(data))); err == nil || !strings.Contains(err.Error(), "exceeds the limits") {
+			t.Errorf("%s: DetectOffice error = %v", name, err)
+		}
+	}
+	junk := []byte(strings.Repeat("x", 100))
+	if _, err := DetectArchive(bytes.NewReader(junk), int64(len(junk))); err == nil {
+		t.Errorf("DetectArchive(junk): no error")
+	}
+
+	entries, dirSize, err := zipDirectorySize(bytes.NewReader(zip64), int64(len(zip64)))
+	if err != nil || entries != 100000 || dirSize != 4600000 {
+		t.Errorf("zipDirectorySize(zip64) = %d, %d, %v", entries, dirSize, err)
+	}
+}
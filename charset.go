@@ -0,0 +1,189 @@
+package mime
+
+import (
+	"bytes"
+	"mime"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// builtinCharsets is the default charset policy: text types are served as
+// UTF-8, like the standard library does for the types it knows.
+var builtinCharsets = map[string]string{
+	"text/*":          "utf-8",
+	"application/xml": "utf-8",
+}
+
+// SetDefaultCharset makes r attach charset to typ when the type is looked up
+// by file name or detected by content without an explicit charset. typ may
+// be a media range such as "text/*"; an exact type takes precedence over a
+// range. An empty charset removes the policy for typ.
+func (r *Registry) SetDefaultCharset(typ, charset string) {
+	typ = baseType(typ)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if charset == "" {
+		delete(r.charsets, typ)
+		return
+	}
+	r.charsets[typ] = strings.ToLower(charset)
+}
+
+// DefaultCharset returns the charset r attaches to typ, or "" if none.
+func (r *Registry) DefaultCharset(typ string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.defaultCharsetLocked(baseType(typ))
+}
+
+func (r *Registry) defaultCharsetLocked(typ string) string {
+	if cs, ok := r.charsets[typ]; ok {
+		return cs
+	}
+	if canon := r.canonicalLocked(typ); canon != typ {
+		if cs, ok := r.charsets[canon]; ok {
+			return cs
+		}
+	}
+	if i := strings.IndexByte(typ, '/'); i >= 0 {
+		return r.charsets[typ[:i]+"/*"]
+	}
+	return ""
+}
+
+// withCharset adds charset to typ unless it already has one. When charset is
+// empty the default charset policy applies.
+func (r *Registry) withCharset(typ, charset string) string {
+	mediaType, params, err := mime.ParseMediaType(typ)
+	if err != nil {
+		return typ
+	}
+	if _, ok := params["charset"]; ok {
+		return typ
+	}
+	if charset == "" {
+		charset = r.DefaultCharset(mediaType)
+	}
+	if charset == "" {
+		return typ
+	}
+	params["charset"] = charset
+	if s := mime.FormatMediaType(mediaType, params); s != "" {
+		return s
+	}
+	return typ
+}
+
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF32BE = []byte{0x00, 0x00, 0xFE, 0xFF}
+	bomUTF32LE = []byte{0xFF, 0xFE, 0x00, 0x00}
+
+	xmlEncoding = regexp.MustCompile(`^<\?xml[^>]*?\sencoding\s*=\s*["']([A-Za-z0-9._:-]+)["']`)
+	htmlCharset = regexp.MustCompile(`(?i)<meta[^>]+?charset\s*=\s*["']?\s*([A-Za-z0-9._:-]+)`)
+)
+
+// metaScanLen is how far into an HTML document a <meta charset> declaration
+// is looked for, as in the WHATWG encoding sniffing algorithm.
+const metaScanLen = 1024
+
+// DetectCharset determines the character encoding of data from, in order, a
+// byte order mark, an XML declaration, an HTML <meta> charset declaration,
+// and the validity of data as UTF-8. Pure ASCII and valid UTF-8 are reported
+// as "utf-8"; other data without a declaration as "windows-1252". Binary data
+// yields "".
+func DetectCharset(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, bomUTF8):
+		return "utf-8"
+	case bytes.HasPrefix(data, bomUTF32LE):
+		return "utf-32le"
+	case bytes.HasPrefix(data, bomUTF32BE):
+		return "utf-32be"
+	case bytes.HasPrefix(data, bomUTF16LE):
+		return "utf-16le"
+	case bytes.HasPrefix(data, bomUTF16BE):
+		return "utf-16be"
+	}
+	if m := xmlEncoding.FindSubmatch(data); m != nil {
+		return strings.ToLower(string(m[1]))
+	}
+	head := data
+	if len(head) > metaScanLen {
+		head = head[:metaScanLen]
+	}
+	if m := htmlCharset.FindSubmatch(head); m != nil {
+		return strings.ToLower(string(m[1]))
+	}
+	if bytes.IndexByte(data, 0) >= 0 {
+		return ""
+	}
+	if validUTF8Prefix(data) {
+		return "utf-8"
+	}
+	return "windows-1252"
+}
+
+// validUTF8Prefix reports whether data is valid UTF-8, allowing a rune cut
+// off at the end.
+func validUTF8Prefix(data []byte) bool {
+	if utf8.Valid(data) {
+		return true
+	}
+	for i := 1; i < utf8.UTFMax && i <= len(data); i++ {
+		if utf8.Valid(data[:len(data)-i]) && !utf8.FullRune(data[len(data)-i:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectContentType detects the MIME type of data like DetectBytes and, for
+// text types, adds the charset parameter found by DetectCharset, falling
+// back to the default charset policy of r. The result is a full
+// Content-Type value such as "text/html; charset=utf-8".
+func (r *Registry) DetectContentType(data []byte) string {
+	charset := DetectCharset(data)
+	body := data
+	switch charset {
+	case "utf-8":
+		body = bytes.TrimPrefix(data, bomUTF8)
+	case "utf-16le", "utf-16be", "utf-32le", "utf-32be":
+		// The magic database only holds byte-oriented text signatures.
+		return r.withCharset(typeTextPlain, charset)
+	}
+	typ := DetectBytes(body).Type
+	if typ == typeOctetStream && charset == "windows-1252" && !hasControl(body) {
+		// Legacy 8-bit text is not valid UTF-8, which DetectBytes requires.
+		typ = typeTextPlain
+	}
+	if !r.isTextual(typ) {
+		return typ
+	}
+	return r.withCharset(typ, charset)
+}
+
+// hasControl reports whether data contains C0 control characters other than
+// common whitespace.
+func hasControl(data []byte) bool {
+	for _, c := range data {
+		if c < 0x20 && c != '\t' && c != '\n' && c != '\r' && c != '\f' || c == 0x7F {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectContentType detects the MIME type and charset of data using
+// DefaultRegistry's charset policy.
+func DetectContentType(data []byte) string {
+	return DefaultRegistry.DetectContentType(data)
+}
+
+// isTextual reports whether typ is text/plain or a kind of it.
+func (r *Registry) isTextual(typ string) bool {
+	return strings.HasPrefix(typ, "text/") || r.IsSubtypeOf(typ, typeTextPlain)
+}
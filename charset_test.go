@@ -0,0 +1,129 @@
+package mime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectCharset(t *testing.T) {
+	tests := []struct {
+		name, data, want string
+	}{
+		{"utf-8 bom", "\xEF\xBB\xBFhello", "utf-8"},
+		{"utf-16le bom", "\xFF\xFEh\x00i\x00", "utf-16le"},
+		{"utf-16be bom", "\xFE\xFF\x00h\x00i", "utf-16be"},
+		{"utf-32le bom", "\xFF\xFE\x00\x00h\x00\x00\x00", "utf-32le"},
+		{"utf-32be bom", "\x00\x00\xFE\xFF\x00\x00\x00h", "utf-32be"},
+		{"xml declaration", `<?xml version="1.0" encoding="ISO-8859-1"?><a/>`, "iso-8859-1"},
+		{"xml declaration, single quotes", `<?xml version='1.0' encoding='Shift_JIS'?>`, "shift_jis"},
+		{"meta charset", `<html><head><meta charset="KOI8-R">`, "koi8-r"},
+		{"meta http-equiv", `<meta http-equiv="Content-Type" content="text/html; charset=windows-1251">`, "windows-1251"},
+		{"meta too late", strings.Repeat(" ", 1100) + `<meta charset="koi8-r">`, "utf-8"},
+		{"ascii", "plain text\n", "utf-8"},
+		{"utf-8", "grüße", "utf-8"},
+		{"cut-off rune", "gr\xC3", "utf-8"},
+		{"latin-1", "gr\xFC\xDFe", "windows-1252"},
+		{"binary", "ab\x00cd", ""},
+	}
+	for _, tt := range tests {
+		if got := DetectCharset([]byte(tt.data)); got != tt.want {
+			t.Errorf("%s: DetectCharset = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		name, data, want string
+	}{
+		{"html", "<html><p>hi", "text/html; charset=utf-8"},
+		{"html after bom", "\xEF\xBB\xBF<html><p>hi", "text/html; charset=utf-8"},
+		{"html, declared", `<html><meta charset="iso-8859-1">`, "text/html; charset=iso-8859-1"},
+		{"xml, declared", `<?xml version="1.0" encoding="UTF-16"?><a/>`, "application/xml; charset=utf-16"},
+		{"utf-16", "\xFF\xFE<\x00h\x00", "text/plain; charset=utf-16le"},
+		{"latin-1 text", "gr\xFC\xDFe\n", "text/plain; charset=windows-1252"},
+		{"latin-1 with controls", "gr\xFC\x01", "application/octet-stream"},
+		{"png", "\x89PNG\r\n\x1A\n\x00\x00", "image/png"},
+		{"binary", "\x00\x01\x02", "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		if got := DetectContentType([]byte(tt.data)); got != tt.want {
+			t.Errorf("%s: DetectContentType = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTypeByExtensionCharset(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"a.txt", "text/plain; charset=utf-8"},
+		{"a.html", "text/html; charset=utf-8"},
+		{"a.css", "text/css; charset=utf-8"},
+		{"a.csv", "text/csv; charset=utf-8"},
+		{"a.png", "image/png"},
+		{"a.pdf", "application/pdf"},
+	}
+	for _, tt := range tests {
+		if got := TypeByExtension(tt.name); got != tt.want {
+			t.Errorf("TypeByExtension(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	// The system database may answer text/xml, an alias of application/xml.
+	if got := Canonical(TypeByExtension("a.xml")); got != "application/xml; charset=utf-8" {
+		t.Errorf("Canonical(TypeByExtension(a.xml)) = %q", got)
+	}
+}
+
+func TestSetDefaultCharset(t *testing.T) {
+	r := DefaultRegistry.Clone()
+	r.SetDefaultCharset("text/*", "ISO-8859-1")
+	r.SetDefaultCharset("text/html", "utf-8")
+	tests := []struct {
+		typ, want string
+	}{
+		{"text/plain", "iso-8859-1"},
+		{"TEXT/CSS; foo=bar", "iso-8859-1"},
+		{"text/html", "utf-8"},
+		{"text/xml", "utf-8"}, // an alias of application/xml
+		{"image/png", ""},
+	}
+	for _, tt := range tests {
+		if got := r.DefaultCharset(tt.typ); got != tt.want {
+			t.Errorf("DefaultCharset(%q) = %q, want %q", tt.typ, got, tt.want)
+		}
+	}
+	if got := r.TypeByExtension("a.txt"); got != "text/plain; charset=iso-8859-1" {
+		t.Errorf("TypeByExtension(a.txt) = %q", got)
+	}
+	if got := DefaultRegistry.DefaultCharset("text/plain"); got != "utf-8" {
+		t.Errorf("SetDefaultCharset on a clone changed DefaultRegistry: %q", got)
+	}
+
+	r.SetDefaultCharset("text/*", "")
+	if got := r.DefaultCharset("text/plain"); got != "" {
+		t.Errorf("DefaultCharset(text/plain) after reset = %q", got)
+	}
+	if got := r.TypeByExtension("a.md"); strings.Contains(got, "charset") {
+		t.Errorf("TypeByExtension(a.md) after reset = %q", got)
+	}
+	if got := r.DetectContentType([]byte("<html>")); got != "text/html; charset=utf-8" {
+		t.Errorf("DetectContentType(html) = %q", got)
+	}
+}
+
+func TestHasControl(t *testing.T) {
+	for s, want := range map[string]bool{
+		"":              false,
+		"tab\tcr\rlf\n": false,
+		"form\f":        false,
+		"nul\x00":       true,
+		"esc\x1B":       true,
+		"del\x7F":       true,
+		"\xFC\xDF":      false,
+	} {
+		if got := hasControl([]byte(s)); got != want {
+			t.Errorf("hasControl(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
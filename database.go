@@ -0,0 +1,30 @@
+package mime
+
+//go:generate go run gen.go
+
+// DatabaseSource describes one of the snapshots the built-in type database
+// was generated from.
+type DatabaseSource struct {
+	Name    string // such as "apache" or "shared-mime-info"
+	Version string // version of the snapshot, if known
+	URL     string // where the snapshot is fetched from, if anywhere
+}
+
+// DatabaseVersion returns the version of the built-in type database, which
+// changes whenever the snapshots it is generated from are updated.
+func DatabaseVersion() string {
+	return dbVersion
+}
+
+// DatabaseSources returns the snapshots the built-in type database was
+// generated from, in order of precedence.
+func DatabaseSources() []DatabaseSource {
+	return append([]DatabaseSource(nil), dbSources...)
+}
+
+// Provenance returns the name of the source the built-in entry for ext was
+// taken from, or "" if the built-in database has no entry for ext. Entries
+// added to a Registry at run time have no provenance.
+func Provenance(ext string) string {
+	return extSource[normExt(ext)]
+}
@@ -0,0 +1,47 @@
+package mime
+
+import "testing"
+
+func TestDatabaseSources(t *testing.T) {
+	if DatabaseVersion() == "" {
+		t.Errorf("DatabaseVersion() is empty")
+	}
+	sources := DatabaseSources()
+	if len(sources) == 0 || sources[0].Name != "overrides" {
+		t.Fatalf("DatabaseSources() = %+v, want overrides first", sources)
+	}
+	names := make(map[string]bool)
+	for _, s := range sources {
+		if names[s.Name] {
+			t.Errorf("source %q listed twice", s.Name)
+		}
+		names[s.Name] = true
+	}
+	sources[0].Name = "changed"
+	if DatabaseSources()[0].Name != "overrides" {
+		t.Errorf("DatabaseSources returned the package's own slice")
+	}
+
+	for ext := range extToMimeType {
+		if src := Provenance(ext); !names[src] {
+			t.Errorf("Provenance(%q) = %q, not one of the sources", ext, src)
+		}
+	}
+}
+
+func TestProvenance(t *testing.T) {
+	tests := []struct {
+		ext, want string
+	}{
+		{".jar", "legacy"},
+		{"JAR", "legacy"},
+		{".ear", "apache"},
+		{".exe", "overrides"},
+		{".no-such-extension", ""},
+	}
+	for _, tt := range tests {
+		if got := Provenance(tt.ext); got != tt.want {
+			t.Errorf("Provenance(%q) = %q, want %q", tt.ext, got, tt.want)
+		}
+	}
+}
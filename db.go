@@ -0,0 +1,7626 @@
+// Code generated by gen.go from the snapshots listed in data/sources.txt; DO NOT EDIT.
+
+package mime
+
+const dbVersion = "2026.10.17"
+
+var dbSources = []DatabaseSource{
+	{Name: "overrides", Version: "", URL: ""},
+	{Name: "legacy", Version: "", URL: ""},
+	{Name: "media-types", Version: "10.0.0", URL: ""},
+	{Name: "shared-mime-info", Version: "2.2", URL: ""},
+	{Name: "apache", Version: "mime-db-1.35.0", URL: "https://svn.apache.org/repos/asf/httpd/httpd/trunk/docs/conf/mime.types"},
+	{Name: "iana-application", Version: "mime-db-1.35.0", URL: "https://www.iana.org/assignments/media-types/application.csv"},
+	{Name: "iana-audio", Version: "mime-db-1.35.0", URL: "https://www.iana.org/assignments/media-types/audio.csv"},
+	{Name: "iana-font", Version: "mime-db-1.35.0", URL: "https://www.iana.org/assignments/media-types/font.csv"},
+	{Name: "iana-image", Version: "mime-db-1.35.0", URL: "https://www.iana.org/assignments/media-types/image.csv"},
+	{Name: "iana-message", Version: "mime-db-1.35.0", URL: "https://www.iana.org/assignments/media-types/message.csv"},
+	{Name: "iana-model", Version: "mime-db-1.35.0", URL: "https://www.iana.org/assignments/media-types/model.csv"},
+	{Name: "iana-multipart", Version: "mime-db-1.35.0", URL: "https://www.iana.org/assignments/media-types/multipart.csv"},
+	{Name: "iana-text", Version: "mime-db-1.35.0", URL: "https://www.iana.org/assignments/media-types/text.csv"},
+	{Name: "iana-video", Version: "mime-db-1.35.0", URL: "https://www.iana.org/assignments/media-types/video.csv"},
+}
+
+var extToMimeType = map[string]string{
+	".123":                            "application/vnd.lotus-1-2-3",
+	".1905.1":                         "application/vnd.ieee.1905",
+	".1clr":                           "application/clr",
+	".1km":                            "application/vnd.1000minds.decision-model+xml",
+	".210":                            "application/p21",
+	".323":                            "text/h323",
+	".32x":                            "application/x-genesis-32x-rom",
+	".3dm":                            "text/vnd.in3d.3dml",
+	".3dml":                           "text/vnd.in3d.3dml",
+	".3ds":                            "application/x-nintendo-3ds-rom",
+	".3dsx":                           "application/x-nintendo-3ds-executable",
+	".3g2":                            "video/3gpp2",
+	".3ga":                            "video/3gpp",
+	".3gp":                            "video/3gpp",
+	".3gp2":                           "video/3gpp2",
+	".3gpp":                           "video/3gpp",
+	".3gpp2":                          "video/3gpp2",
+	".3mf":                            "application/vnd.ms-3mfdocument",
+	".3tz":                            "application/vnd.maxar.archive.3tz+zip",
+	".602":                            "application/x-t602",
+	".669":                            "audio/x-mod",
+	".726":                            "audio/32kadpcm",
+	".7z":                             "application/x-7z-compressed",
+	".7z.001":                         "application/x-7z-compressed",
+	".a":                              "text/vnd.a",
+	".a26":                            "application/x-atari-2600-rom",
+	".a2l":                            "application/A2L",
+	".a78":                            "application/x-atari-7800-rom",
+	".aa":                             "audio/x-pn-audibleaudio",
+	".aa3":                            "audio/ATRAC3",
+	".aab":                            "application/x-authoware-bin",
+	".aac":                            "audio/aac",
+	".aal":                            "audio/ATRAC-ADVANCED-LOSSLESS",
+	".aam":                            "application/x-authoware-map",
+	".aas":                            "application/x-authoware-seg",
+	".aax":                            "audio/vnd.audible.aax",
+	".abc":                            "text/vnd.abc",
+	".abw":                            "application/x-abiword",
+	".abw.crashed":                    "application/x-abiword",
+	".abw.gz":                         "application/x-abiword",
+	".ac":                             "application/pkix-attr-cert",
+	".ac2":                            "application/vnd.banana-accounting",
+	".ac3":                            "audio/ac3",
+	".acc":                            "application/vnd.americandynamics.acc",
+	".ace":                            "application/x-ace",
+	".acn":                            "audio/asc",
+	".acu":                            "application/vnd.acucobol",
+	".acutc":                          "application/vnd.acucorp",
+	".acx":                            "application/internet-property-stream",
+	".adb":                            "text/x-adasrc",
+	".adf":                            "application/x-amiga-disk-format",
+	".adp":                            "audio/adpcm",
+	".ads":                            "text/x-adasrc",
+	".adts":                           "audio/aac",
+	".aep":                            "application/vnd.audiograph",
+	".afm":                            "application/x-font-afm",
+	".afp":                            "application/vnd.afpc.modca",
+	".ag":                             "image/x-applix-graphics",
+	".agb":                            "application/x-gba-rom",
+	".age":                            "application/vnd.age",
+	".ahead":                          "application/vnd.ahead.space",
+	".ai":                             "application/postscript",
+	".aif":                            "audio/x-aiff",
+	".aifc":                           "audio/x-aiff",
+	".aiff":                           "audio/x-aiff",
+	".aiffc":                          "audio/x-aifc",
+	".aion":                           "application/vnd.veritone.aion+json",
+	".air":                            "application/vnd.adobe.air-application-installer-package+zip",
+	".ait":                            "application/vnd.dvb.ait",
+	".al":                             "application/x-perl",
+	".alc":                            "chemical/x-alchemy",
+	".als":                            "audio/X-Alpha5",
+	".alz":                            "application/x-alz",
+	".amc":                            "application/x-mpeg",
+	".ami":                            "application/vnd.amiga.ami",
+	".aml":                            "application/AML",
+	".amlx":                           "application/automationml-amlx+zip",
+	".amr":                            "audio/AMR",
+	".amz":                            "audio/x-amzxml",
+	".ani":                            "application/octet-stream",
+	".anx":                            "application/annodex",
+	".ape":                            "audio/x-ape",
+	".apex":                           "application/vnd.apexlang",
+	".apexlang":                       "application/vnd.apexlang",
+	".apk":                            "application/vnd.android.package-archive",
+	".apkg":                           "application/vnd.anki",
+	".apng":                           "image/apng",
+	".appcache":                       "text/cache-manifest",
+	".appimage":                       "application/x-iso9660-appimage",
+	".application":                    "application/x-ms-application",
+	".apr":                            "application/vnd.lotus-approach",
+	".apxml":                          "application/auth-policy+xml",
+	".ar":                             "application/x-archive",
+	".arc":                            "application/x-freearc",
+	".arj":                            "application/x-arj",
+	".arrow":                          "application/vnd.apache.arrow.file",
+	".arrows":                         "application/vnd.apache.arrow.stream",
+	".art":                            "image/x-jg",
+	".artisan":                        "application/vnd.artisan+json",
+	".arw":                            "image/x-sony-arw",
+	".as":                             "application/x-applix-spreadsheet",
+	".asar":                           "application/x-asar",
+	".asc":                            "text/plain",
+	".ascii":                          "text/vnd.ascii-art",
+	".asd":                            "application/astound",
+	".asf":                            "video/x-ms-asf",
+	".asice":                          "application/vnd.etsi.asic-e+zip",
+	".asics":                          "application/vnd.etsi.asic-s+zip",
+	".asm":                            "text/x-asm",
+	".asn":                            "application/astound",
+	".aso":                            "application/vnd.accpac.simply.aso",
+	".asp":                            "application/x-asap",
+	".asr":                            "video/x-ms-asf",
+	".ass":                            "audio/aac",
+	".astc":                           "image/astc",
+	".asx":                            "video/x-ms-asf",
+	".at3":                            "audio/ATRAC3",
+	".atc":                            "application/vnd.acucorp",
+	".atf":                            "application/ATF",
+	".atfx":                           "application/ATFX",
+	".atom":                           "application/atom+xml",
+	".atomcat":                        "application/atomcat+xml",
+	".atomdeleted":                    "application/atomdeleted+xml",
+	".atomsrv":                        "application/atomserv+xml",
+	".atomsvc":                        "application/atomsvc+xml",
+	".atx":                            "audio/ATRAC-X",
+	".atxml":                          "application/ATXML",
+	".au":                             "audio/basic",
+	".auc":                            "application/tamp-apex-update-confirm",
+	".automount":                      "text/x-systemd-unit",
+	".avb":                            "application/octet-stream",
+	".avci":                           "image/avci",
+	".avcs":                           "image/avcs",
+	".avf":                            "video/x-msvideo",
+	".avi":                            "video/x-msvideo",
+	".avif":                           "image/avif",
+	".avifs":                          "image/avif",
+	".aw":                             "application/x-applix-word",
+	".awb":                            "audio/amr-wb",
+	".awk":                            "application/x-awk",
+	".axa":                            "audio/annodex",
+	".axs":                            "application/olescript",
+	".axv":                            "video/annodex",
+	".azf":                            "application/vnd.airzip.filesecure.azf",
+	".azs":                            "application/vnd.airzip.filesecure.azs",
+	".azv":                            "image/vnd.airzip.accelerator.azv",
+	".azw":                            "application/vnd.amazon.ebook",
+	".azw3":                           "application/vnd.amazon.mobi8-ebook",
+	".b":                              "chemical/x-molconn-Z",
+	".b16":                            "image/vnd.pco.b16",
+	".bak":                            "application/x-trash",
+	".bar":                            "application/vnd.qualcomm.brew-app-res",
+	".bas":                            "text/plain",
+	".bat":                            "application/x-msdos-program",
+	".bcpio":                          "application/x-bcpio",
+	".bdf":                            "application/x-font-bdf",
+	".bdm":                            "application/vnd.syncml.dm+wbxml",
+	".bdmv":                           "video/mp2t",
+	".bed":                            "application/vnd.realvnc.bed",
+	".bh2":                            "application/vnd.fujitsu.oasysprs",
+	".bib":                            "text/x-bibtex",
+	".bik":                            "video/vnd.radgamettools.bink",
+	".bin":                            "application/octet-stream",
+	".bk2":                            "video/vnd.radgamettools.bink",
+	".bkm":                            "application/vnd.nervana",
+	".blb":                            "application/x-blorb",
+	".bld":                            "application/bld",
+	".bld2":                           "application/bld2",
+	".blend":                          "application/x-blender",
+	".blender":                        "application/x-blender",
+	".blorb":                          "application/x-blorb",
+	".bmed":                           "multipart/vnd.bint.med-plus",
+	".bmi":                            "application/vnd.bmi",
+	".bmml":                           "application/vnd.balsamiq.bmml+xml",
+	".bmp":                            "image/bmp",
+	".bmpr":                           "application/vnd.balsamiq.bmpr",
+	".boo":                            "text/x-boo",
+	".book":                           "application/x-maker",
+	".box":                            "application/vnd.previewsystems.box",
+	".boz":                            "application/x-bzip2",
+	".bpd":                            "application/vnd.hbci",
+	".bpk":                            "application/octet-stream",
+	".bps":                            "application/x-bps-patch",
+	".brf":                            "text/plain",
+	".bsd":                            "chemical/x-crossfire",
+	".bsdiff":                         "application/x-bsdiff",
+	".bsp":                            "model/vnd.valve.source.compiled-map",
+	".btf":                            "image/prs.btif",
+	".btif":                           "image/prs.btif",
+	".bz":                             "application/x-bzip",
+	".bz2":                            "application/x-bzip2",
+	".c":                              "text/plain",
+	".c++":                            "text/x-c++src",
+	".c11amc":                         "application/vnd.cluetrust.cartomobile-config",
+	".c11amz":                         "application/vnd.cluetrust.cartomobile-config-pkg",
+	".c3d":                            "chemical/x-chem3d",
+	".c3ex":                           "application/cccex",
+	".c4d":                            "application/vnd.clonk.c4group",
+	".c4f":                            "application/vnd.clonk.c4group",
+	".c4g":                            "application/vnd.clonk.c4group",
+	".c4p":                            "application/vnd.clonk.c4group",
+	".c4u":                            "application/vnd.clonk.c4group",
+	".c9r":                            "application/vnd.cryptomator.encrypted",
+	".c9s":                            "application/vnd.cryptomator.encrypted",
+	".cab":                            "application/vnd.ms-cab-compressed",
+	".cac":                            "chemical/x-cache",
+	".cache":                          "chemical/x-cache",
+	".caf":                            "audio/x-caf",
+	".cal":                            "image/x-cals",
+	".cap":                            "application/vnd.tcpdump.pcap",
+	".car":                            "application/vnd.ipld.car",
+	".carjson":                        "application/vnd.eu.kasparian.car+json",
+	".cascii":                         "chemical/x-cactvs-binary",
+	".cat":                            "application/vnd.ms-pkiseccat",
+	".cb7":                            "application/x-cb7",
+	".cba":                            "application/x-cbr",
+	".cbin":                           "chemical/x-cactvs-binary",
+	".cbl":                            "text/x-cobol",
+	".cbor":                           "application/cbor",
+	".cbr":                            "application/vnd.comicbook-rar",
+	".cbt":                            "application/x-cbt",
+	".cbz":                            "application/vnd.comicbook+zip",
+	".cc":                             "text/x-c++src",
+	".ccc":                            "text/vnd.net2phone.commcenter.command",
+	".cci":                            "application/x-nintendo-3ds-rom",
+	".ccmp":                           "application/ccmp+xml",
+	".ccmx":                           "application/x-ccmx",
+	".ccn":                            "application/x-cnc",
+	".cco":                            "application/x-cocoa",
+	".cct":                            "application/x-director",
+	".ccxml":                          "application/ccxml+xml",
+	".cda":                            "application/x-cdf",
+	".cdbcmsg":                        "application/vnd.contact.cmsg",
+	".cdf":                            "application/x-netcdf",
+	".cdfx":                           "application/CDFX+XML",
+	".cdi":                            "application/x-discjuggler-cd-image",
+	".cdkey":                          "application/vnd.mediastation.cdkey",
+	".cdmia":                          "application/cdmi-capability",
+	".cdmic":                          "application/cdmi-container",
+	".cdmid":                          "application/cdmi-domain",
+	".cdmio":                          "application/cdmi-object",
+	".cdmiq":                          "application/cdmi-queue",
+	".cdr":                            "image/x-coreldraw",
+	".cdt":                            "image/x-coreldrawtemplate",
+	".cdx":                            "chemical/x-cdx",
+	".cdxml":                          "application/vnd.chemdraw+xml",
+	".cdy":                            "application/vnd.cinderella",
+	".cea":                            "application/CEA",
+	".cef":                            "chemical/x-cxf",
+	".cellml":                         "application/cellml+xml",
+	".cer":                            "application/x-x509-ca-cert",
+	".cert":                           "application/x-x509-ca-cert",
+	".cfs":                            "application/x-cfs-compressed",
+	".cgb":                            "application/x-gameboy-color-rom",
+	".cgi":                            "magnus-internal/cgi",
+	".cgm":                            "image/cgm",
+	".chat":                           "application/x-chat",
+	".chd":                            "application/x-mame-chd",
+	".chm":                            "application/vnd.ms-htmlhelp",
+	".chrt":                           "application/x-kchart",
+	".cif":                            "application/vnd.multiad.creator.cif",
+	".cii":                            "application/vnd.anser-web-certificate-issue-initiation",
+	".cil":                            "application/vnd.ms-artgalry",
+	".cl":                             "application/simple-filter+xml",
+	".cla":                            "application/vnd.claymore",
+	".class":                          "application/octet-stream",
+	".cld":                            "model/vnd.cld",
+	".clkk":                           "application/vnd.crick.clicker.keyboard",
+	".clkp":                           "application/vnd.crick.clicker.palette",
+	".clkt":                           "application/vnd.crick.clicker.template",
+	".clkw":                           "application/vnd.crick.clicker.wordbank",
+	".clkx":                           "application/vnd.crick.clicker",
+	".clp":                            "application/x-msclip",
+	".clpi":                           "video/mp2t",
+	".cls":                            "text/x-tex",
+	".clue":                           "application/clue_info+xml",
+	".cmake":                          "text/x-cmake",
+	".cmc":                            "application/vnd.cosmocaller",
+	".cmd":                            "application/x-msdos-program",
+	".cmdf":                           "chemical/x-cmdf",
+	".cml":                            "application/cellml+xml",
+	".cmp":                            "application/vnd.yellowriver-custom-menu",
+	".cmsc":                           "application/cms",
+	".cmx":                            "image/x-cmx",
+	".cnd":                            "text/jcr-cnd",
+	".co":                             "application/x-cult3d-object",
+	".cob":                            "text/x-cobol",
+	".cod":                            "image/cis-cod",
+	".coffee":                         "application/vnd.coffeescript",
+	".com":                            "application/x-msdos-program",
+	".conf":                           "text/plain",
+	".copyright":                      "text/vnd.debian.copyright",
+	".coswid":                         "application/swid+cbor",
+	".cpa":                            "chemical/x-compass",
+	".cpi":                            "video/mp2t",
+	".cpio":                           "application/x-cpio",
+	".cpio.gz":                        "application/x-cpio-compressed",
+	".cpkg":                           "application/vnd.xmpie.cpkg",
+	".cpl":                            "application/cpl+xml",
+	".cpp":                            "text/plain",
+	".cpt":                            "application/mac-compactpro",
+	".cql":                            "text/cql",
+	".cr":                             "text/x-crystal",
+	".cr2":                            "image/x-canon-cr2",
+	".cr3":                            "image/x-canon-cr3",
+	".crd":                            "application/x-mscardfile",
+	".crdownload":                     "application/x-partial-download",
+	".crl":                            "application/pkix-crl",
+	".crt":                            "application/x-x509-ca-cert",
+	".crtr":                           "application/vnd.multiad.creator",
+	".crw":                            "image/x-canon-crw",
+	".cryptomator":                    "application/vnd.cryptomator.vault",
+	".cryptonote":                     "application/vnd.rig.cryptonote",
+	".cs":                             "text/x-csharp",
+	".csd":                            "audio/csound",
+	".csf":                            "chemical/x-cache-csf",
+	".csh":                            "application/x-csh",
+	".csl":                            "application/vnd.citationstyles.style+xml",
+	".csm":                            "chemical/x-csml",
+	".csml":                           "chemical/x-csml",
+	".cso":                            "application/x-compressed-iso",
+	".csp":                            "application/vnd.commonspace",
+	".csrattrs":                       "application/csrattrs",
+	".css":                            "text/css",
+	".cst":                            "application/vnd.commonspace",
+	".csv":                            "text/csv",
+	".csvs":                           "text/csv-schema",
+	".ctab":                           "chemical/x-cactvs-binary",
+	".ctx":                            "chemical/x-ctx",
+	".cu":                             "application/cu-seeme",
+	".cub":                            "chemical/x-gaussian-cube",
+	".cuc":                            "application/tamp-community-update-confirm",
+	".cue":                            "application/x-cue",
+	".cur":                            "application/octet-stream",
+	".curl":                           "text/vnd.curl",
+	".cw":                             "application/prs.cww",
+	".cwk":                            "application/x-appleworks-document",
+	".cwl":                            "application/cwl",
+	".cwl.json":                       "application/cwl+json",
+	".cww":                            "application/prs.cww",
+	".cxf":                            "chemical/x-cxf",
+	".cxt":                            "application/x-director",
+	".cxx":                            "text/x-c++src",
+	".d":                              "text/x-dsrc",
+	".dae":                            "model/vnd.collada+xml",
+	".daf":                            "application/vnd.Mobius.DAF",
+	".dar":                            "application/x-dar",
+	".dart":                           "application/vnd.dart",
+	".dataless":                       "application/vnd.fdsn.seed",
+	".davmount":                       "application/davmount+xml",
+	".dbf":                            "application/vnd.dbf",
+	".dbk":                            "application/x-docbook+xml",
+	".dcd":                            "application/DCD",
+	".dcl":                            "text/x-dcl",
+	".dcm":                            "x-lml/x-evm",
+	".dcr":                            "application/x-director",
+	".dcurl":                          "text/vnd.curl.dcurl",
+	".dcx":                            "image/x-dcx",
+	".dd2":                            "application/vnd.oma.dd2+xml",
+	".ddd":                            "application/vnd.fujixerox.ddd",
+	".ddeb":                           "application/vnd.debian.binary-package",
+	".ddf":                            "application/vnd.syncml.dmddf+xml",
+	".dds":                            "image/x-dds",
+	".deb":                            "application/vnd.debian.binary-package",
+	".def":                            "text/plain",
+	".deploy":                         "application/octet-stream",
+	".der":                            "application/x-x509-ca-cert",
+	".desktop":                        "application/x-desktop",
+	".device":                         "text/x-systemd-unit",
+	".dfac":                           "application/vnd.dreamfactory",
+	".dff":                            "audio/x-dff",
+	".dgc":                            "application/x-dgc-compressed",
+	".dhtml":                          "text/html",
+	".di":                             "text/x-dsrc",
+	".dia":                            "application/x-dia-diagram",
+	".dib":                            "image/bmp",
+	".dic":                            "text/x-c",
+	".dif":                            "video/x-dv",
+	".diff":                           "text/x-diff",
+	".dii":                            "application/DII",
+	".dim":                            "application/vnd.fastcopy-disk-image",
+	".dir":                            "application/x-director",
+	".dis":                            "application/vnd.Mobius.DIS",
+	".disposition-notification":       "message/disposition-notification",
+	".dist":                           "application/vnd.apple.installer+xml",
+	".distz":                          "application/vnd.apple.installer+xml",
+	".dit":                            "application/DIT",
+	".dive":                           "application/vnd.patentdive",
+	".divx":                           "video/x-msvideo",
+	".djv":                            "image/vnd.djvu",
+	".djvu":                           "image/vnd.djvu",
+	".dl":                             "application/vnd.datalog",
+	".dll":                            "application/vnd.microsoft.portable-executable",
+	".dls":                            "audio/dls",
+	".dmg":                            "application/octet-stream",
+	".dmp":                            "application/vnd.tcpdump.pcap",
+	".dms":                            "application/octet-stream",
+	".dna":                            "application/vnd.dna",
+	".dng":                            "image/x-adobe-dng",
+	".doc":                            "application/msword",
+	".docbook":                        "application/x-docbook+xml",
+	".docjson":                        "application/vnd.document+json",
+	".docm":                           "application/vnd.ms-word.document.macroEnabled.12",
+	".docx":                           "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".dor":                            "model/vnd.gdl",
+	".dot":                            "application/msword",
+	".dotm":                           "application/vnd.ms-word.template.macroEnabled.12",
+	".dotx":                           "application/vnd.openxmlformats-officedocument.wordprocessingml.template",
+	".dp":                             "application/vnd.osgi.dp",
+	".dpg":                            "application/vnd.dpgraph",
+	".dpgraph":                        "application/vnd.dpgraph",
+	".dpkg":                           "application/vnd.xmpie.dpkg",
+	".dpx":                            "image/dpx",
+	".dra":                            "audio/vnd.dra",
+	".drle":                           "image/dicom-rle",
+	".dsc":                            "text/prs.lines.tag",
+	".dsf":                            "audio/x-dsf",
+	".dsl":                            "text/x-dsl",
+	".dsm":                            "application/vnd.desmume.movie",
+	".dssc":                           "application/dssc+der",
+	".dtb":                            "application/x-dtbook+xml",
+	".dtd":                            "application/xml-dtd",
+	".dts":                            "audio/vnd.dts",
+	".dtshd":                          "audio/vnd.dts.hd",
+	".dtx":                            "text/x-tex",
+	".dump":                           "application/octet-stream",
+	".dv":                             "video/x-dv",
+	".dvb":                            "video/vnd.dvb.file",
+	".dvc":                            "application/dvcs",
+	".dvi":                            "application/x-dvi",
+	".dvi.bz2":                        "application/x-bzdvi",
+	".dvi.gz":                         "application/x-gzdvi",
+	".dwd":                            "application/atsc-dwd+xml",
+	".dwf":                            "drawing/x-dwf",
+	".dwg":                            "application/x-autocad",
+	".dx":                             "chemical/x-jcamp-dx",
+	".dxf":                            "application/x-autocad",
+	".dxp":                            "application/vnd.spotfire.dxp",
+	".dxr":                            "application/x-director",
+	".dzr":                            "application/vnd.dzr",
+	".e":                              "text/x-eiffel",
+	".ear":                            "application/java-archive",
+	".ebk":                            "application/x-expandedbook",
+	".ebuild":                         "application/vnd.gentoo.ebuild",
+	".ecelp4800":                      "audio/vnd.nuera.ecelp4800",
+	".ecelp7470":                      "audio/vnd.nuera.ecelp7470",
+	".ecelp9600":                      "audio/vnd.nuera.ecelp9600",
+	".ecig":                           "application/vnd.evolv.ecig.settings",
+	".ecigprofile":                    "application/vnd.evolv.ecig.profile",
+	".ecigtheme":                      "application/vnd.evolv.ecig.theme",
+	".eclass":                         "application/vnd.gentoo.eclass",
+	".ecma":                           "application/ecmascript",
+	".edm":                            "application/vnd.novadigm.EDM",
+	".edx":                            "application/vnd.novadigm.EDX",
+	".efi":                            "application/vnd.microsoft.portable-executable",
+	".efif":                           "application/vnd.picsel",
+	".egon":                           "application/x-egon",
+	".ei6":                            "application/vnd.pg.osasli",
+	".eif":                            "text/x-eiffel",
+	".el":                             "text/x-emacs-lisp",
+	".elc":                            "application/octet-stream",
+	".eln":                            "application/vnd.eln+zip",
+	".emb":                            "chemical/x-embl-dl-nucleotide",
+	".embl":                           "chemical/x-embl-dl-nucleotide",
+	".emf":                            "image/emf",
+	".eml":                            "message/rfc822",
+	".emm":                            "application/vnd.ibm.electronic-media",
+	".emma":                           "application/emma+xml",
+	".emotionml":                      "application/emotionml+xml",
+	".emp":                            "application/vnd.emusic-emusic_package",
+	".emz":                            "application/x-msmetafile",
+	".ent":                            "application/xml-external-parsed-entity",
+	".entity":                         "application/vnd.nervana",
+	".enw":                            "audio/EVRCNW",
+	".eol":                            "audio/vnd.digital-winds",
+	".eot":                            "application/vnd.ms-fontobject",
+	".ep":                             "application/vnd.bluetooth.ep.oob",
+	".eps":                            "application/postscript",
+	".eps.bz2":                        "image/x-bzeps",
+	".eps.gz":                         "image/x-gzeps",
+	".eps2":                           "application/postscript",
+	".eps3":                           "application/postscript",
+	".epsf":                           "application/postscript",
+	".epsf.bz2":                       "image/x-bzeps",
+	".epsf.gz":                        "image/x-gzeps",
+	".epsi":                           "application/postscript",
+	".epsi.bz2":                       "image/x-bzeps",
+	".epsi.gz":                        "image/x-gzeps",
+	".epub":                           "application/epub+zip",
+	".erf":                            "image/x-epson-erf",
+	".eri":                            "image/x-eri",
+	".erl":                            "text/x-erlang",
+	".es":                             "audio/echospeech",
+	".es3":                            "application/vnd.eszigno3+xml",
+	".esa":                            "application/vnd.osgi.subsystem",
+	".escn":                           "application/x-godot-scene",
+	".esf":                            "application/vnd.epson.esf",
+	".esl":                            "audio/echospeech",
+	".espass":                         "application/vnd.espass-espass+zip",
+	".et3":                            "application/vnd.eszigno3+xml",
+	".etc":                            "application/x-earthtime",
+	".etheme":                         "application/x-e-theme",
+	".etx":                            "text/x-setext",
+	".eva":                            "application/x-eva",
+	".evb":                            "audio/EVRCB",
+	".evc":                            "audio/EVRC",
+	".evm":                            "x-lml/x-evm",
+	".evw":                            "audio/EVRCWB",
+	".evy":                            "application/envoy",
+	".ex":                             "text/x-elixir",
+	".exe":                            "application/vnd.microsoft.portable-executable",
+	".exi":                            "application/exi",
+	".exp":                            "application/express",
+	".exr":                            "image/aces",
+	".exs":                            "text/x-elixir",
+	".ext":                            "application/vnd.novadigm.EXT",
+	".ez":                             "application/andrew-inset",
+	".ez2":                            "application/vnd.ezpix-album",
+	".ez3":                            "application/vnd.ezpix-package",
+	".f":                              "text/x-fortran",
+	".f4a":                            "audio/mp4",
+	".f4b":                            "audio/x-m4b",
+	".f4v":                            "video/mp4",
+	".f77":                            "text/x-fortran",
+	".f90":                            "text/x-fortran",
+	".f95":                            "text/x-fortran",
+	".fasl":                           "text/x-common-lisp",
+	".fb":                             "application/x-maker",
+	".fb2":                            "application/x-fictionbook+xml",
+	".fb2.zip":                        "application/x-zip-compressed-fb2",
+	".fbdoc":                          "application/x-maker",
+	".fbs":                            "image/vnd.fastbidsheet",
+	".fcdt":                           "application/vnd.adobe.formscentral.fcdt",
+	".fch":                            "chemical/x-gaussian-checkpoint",
+	".fchk":                           "chemical/x-gaussian-checkpoint",
+	".fcs":                            "application/vnd.isac.fcs",
+	".fd":                             "application/x-raw-floppy-disk-image",
+	".fdf":                            "application/fdf",
+	".fds":                            "application/x-fds-disk",
+	".fdt":                            "application/fdt+xml",
+	".fe_launch":                      "application/vnd.denovo.fcselayout-link",
+	".feature":                        "text/x-gherkin",
+	".fg5":                            "application/vnd.fujitsu.oasysgp",
+	".fgd":                            "application/x-director",
+	".fh":                             "image/x-freehand",
+	".fh4":                            "image/x-freehand",
+	".fh5":                            "image/x-freehand",
+	".fh7":                            "image/x-freehand",
+	".fhc":                            "image/x-freehand",
+	".fif":                            "application/fractals",
+	".fig":                            "application/x-xfig",
+	".finf":                           "application/fastinfoset",
+	".fit":                            "image/fits",
+	".fits":                           "image/fits",
+	".fl":                             "application/x-fluid",
+	".fla":                            "application/vnd.dtg.local.flash",
+	".flac":                           "audio/flac",
+	".flatpak":                        "application/vnd.flatpak",
+	".flatpakref":                     "application/vnd.flatpak.ref",
+	".flatpakrepo":                    "application/vnd.flatpak.repo",
+	".flb":                            "application/vnd.ficlab.flb+zip",
+	".flc":                            "video/x-flic",
+	".fli":                            "video/fli",
+	".flo":                            "application/vnd.micrografx.flo",
+	".flr":                            "x-world/x-vrml",
+	".flt":                            "text/vnd.ficlab.flt",
+	".flv":                            "video/x-flv",
+	".flw":                            "application/vnd.kde.kivio",
+	".flx":                            "text/vnd.fmi.flexstor",
+	".fly":                            "text/vnd.fly",
+	".fm":                             "application/x-maker",
+	".fnc":                            "application/vnd.frogans.fnc",
+	".fo":                             "application/vnd.software602.filler.form+xml",
+	".fodg":                           "application/vnd.oasis.opendocument.graphics-flat-xml",
+	".fodp":                           "application/vnd.oasis.opendocument.presentation-flat-xml",
+	".fods":                           "application/vnd.oasis.opendocument.spreadsheet-flat-xml",
+	".fodt":                           "application/vnd.oasis.opendocument.text-flat-xml",
+	".for":                            "text/x-fortran",
+	".fpx":                            "image/x-fpx",
+	".frame":                          "application/x-maker",
+	".frm":                            "application/vnd.ufdl",
+	".fsc":                            "application/vnd.fsc.weblaunch",
+	".fst":                            "image/vnd.fst",
+	".ftc":                            "application/vnd.fluxtime.clip",
+	".fti":                            "application/vnd.anser-web-funds-transfer-initiation",
+	".fts":                            "image/fits",
+	".fvi":                            "video/isivideo",
+	".fvt":                            "video/vnd.fvt",
+	".fxm":                            "video/x-javafx",
+	".fxp":                            "application/vnd.adobe.fxp",
+	".fxpl":                           "application/vnd.adobe.fxp",
+	".fzs":                            "application/vnd.fuzzysheet",
+	".g2w":                            "application/vnd.geoplan",
+	".g3":                             "image/g3fax",
+	".g3w":                            "application/vnd.geospace",
+	".gac":                            "application/vnd.groove-account",
+	".gal":                            "chemical/x-gaussian-log",
+	".gam":                            "chemical/x-gamess-input",
+	".gamin":                          "chemical/x-gamess-input",
+	".gan":                            "application/x-ganttproject",
+	".gau":                            "chemical/x-gaussian-input",
+	".gb":                             "application/x-gameboy-rom",
+	".gba":                            "application/x-gba-rom",
+	".gbc":                            "application/x-gameboy-color-rom",
+	".gbr":                            "application/rpki-ghostbusters",
+	".gca":                            "application/x-gca-compressed",
+	".gcd":                            "text/x-pcs-gcd",
+	".gcf":                            "application/x-graphing-calculator",
+	".gcg":                            "chemical/x-gcg8-sequence",
+	".gcode":                          "text/x.gcode",
+	".gcrd":                           "text/vcard",
+	".gd":                             "application/x-gdscript",
+	".gdb":                            "x-lml/x-gdb",
+	".gdi":                            "application/x-gd-rom-cue",
+	".gdl":                            "model/vnd.gdl",
+	".gdshader":                       "application/x-godot-shader",
+	".gdz":                            "application/vnd.familysearch.gedcom+zip",
+	".ged":                            "text/vnd.familysearch.gedcom",
+	".gedcom":                         "application/x-gedcom",
+	".gem":                            "application/x-tar",
+	".gen":                            "chemical/x-genbank",
+	".genozip":                        "application/vnd.genozip",
+	".geo":                            "application/vnd.dynageo",
+	".geo.json":                       "application/geo+json",
+	".geojson":                        "application/geo+json",
+	".gex":                            "application/vnd.geometry-explorer",
+	".gf":                             "application/x-tex-gf",
+	".gff3":                           "text/gff3",
+	".gg":                             "application/x-gamegear-rom",
+	".ggb":                            "application/vnd.geogebra.file",
+	".ggs":                            "application/vnd.geogebra.slides",
+	".ggt":                            "application/vnd.geogebra.tool",
+	".ghf":                            "application/vnd.groove-help",
+	".gif":                            "image/gif",
+	".gih":                            "image/x-gimp-gih",
+	".gim":                            "application/vnd.groove-identity-message",
+	".gjc":                            "chemical/x-gaussian-input",
+	".gjf":                            "chemical/x-gaussian-input",
+	".gl":                             "video/gl",
+	".glade":                          "application/x-glade",
+	".glb":                            "model/gltf-binary",
+	".glbin":                          "application/gltf-buffer",
+	".glbuf":                          "application/gltf-buffer",
+	".gltf":                           "model/gltf+json",
+	".gml":                            "application/gml+xml",
+	".gmo":                            "application/x-gettext-translation",
+	".gmx":                            "application/vnd.gmx",
+	".gnc":                            "application/x-gnucash",
+	".gnd":                            "application/gnunet-directory",
+	".gnucash":                        "application/x-gnucash",
+	".gnumeric":                       "application/x-gnumeric",
+	".gnuplot":                        "application/x-gnuplot",
+	".go":                             "text/x-go",
+	".gp":                             "application/x-gnuplot",
+	".gpg":                            "application/pgp-encrypted",
+	".gph":                            "application/vnd.FloGraphIt",
+	".gpkg":                           "application/geopackage+sqlite3",
+	".gpkg.tar":                       "application/vnd.gentoo.gpkg",
+	".gplt":                           "application/x-gnuplot",
+	".gps":                            "application/x-gps",
+	".gpt":                            "chemical/x-mopac-graph",
+	".gpx":                            "application/gpx+xml",
+	".gqf":                            "application/vnd.grafeq",
+	".gqs":                            "application/vnd.grafeq",
+	".gra":                            "application/x-graphite",
+	".gradle":                         "text/x-gradle",
+	".gram":                           "application/srgs",
+	".gramps":                         "application/x-gramps-xml",
+	".grd":                            "application/vnd.gentics.grd+json",
+	".gre":                            "application/vnd.geometry-explorer",
+	".groovy":                         "text/x-groovy",
+	".grv":                            "application/vnd.groove-injector",
+	".grxml":                          "application/srgs+xml",
+	".gsf":                            "application/x-font",
+	".gsh":                            "text/x-groovy",
+	".gsheet":                         "application/urc-grpsheet+xml",
+	".gsm":                            "audio/x-gsm",
+	".gtar":                           "application/x-gtar",
+	".gtm":                            "application/vnd.groove-tool-message",
+	".gtw":                            "model/vnd.gtw",
+	".gv":                             "text/vnd.graphviz",
+	".gvp":                            "text/x-google-video-pointer",
+	".gvy":                            "text/x-groovy",
+	".gx":                             "text/x-gcode-gx",
+	".gxf":                            "application/gxf",
+	".gxt":                            "application/vnd.geonext",
+	".gy":                             "text/x-groovy",
+	".gz":                             "application/x-gzip",
+	".h":                              "text/plain",
+	".h++":                            "text/x-c++hdr",
+	".h261":                           "video/h261",
+	".h263":                           "video/h263",
+	".h264":                           "video/h264",
+	".h4":                             "application/x-hdf",
+	".h5":                             "application/x-hdf",
+	".hal":                            "application/vnd.hal+xml",
+	".hans":                           "text/vnd.hans",
+	".hbc":                            "application/vnd.hbci",
+	".hbci":                           "application/vnd.hbci",
+	".hdf":                            "application/x-hdf",
+	".hdf4":                           "application/x-hdf",
+	".hdf5":                           "application/x-hdf",
+	".hdm":                            "text/x-hdml",
+	".hdml":                           "text/x-hdml",
+	".hdr":                            "image/vnd.radiance",
+	".hdt":                            "application/vnd.hdt",
+	".heic":                           "image/heic",
+	".heics":                          "image/heic-sequence",
+	".heif":                           "image/heif",
+	".heifs":                          "image/heif-sequence",
+	".hej2":                           "image/hej2k",
+	".held":                           "application/atsc-held+xml",
+	".hfe":                            "application/x-hfe-floppy-image",
+	".hgl":                            "text/vnd.hgl",
+	".hh":                             "text/x-c++hdr",
+	".hif":                            "image/avif",
+	".hin":                            "chemical/x-hin",
+	".hlp":                            "application/winhlp",
+	".hp":                             "text/x-c++hdr",
+	".hpgl":                           "application/vnd.hp-HPGL",
+	".hpi":                            "application/vnd.hp-hpid",
+	".hpid":                           "application/vnd.hp-hpid",
+	".hpp":                            "text/x-c++hdr",
+	".hps":                            "application/vnd.hp-hps",
+	".hpub":                           "application/prs.hpub+zip",
+	".hqx":                            "application/mac-binhex40",
+	".hs":                             "text/x-haskell",
+	".hsj2":                           "image/hsj2",
+	".hta":                            "application/hta",
+	".htc":                            "text/x-component",
+	".htke":                           "application/vnd.kenameaapp",
+	".htm":                            "text/html",
+	".html":                           "text/html",
+	".hts":                            "text/html",
+	".htt":                            "text/webviewhtml",
+	".hvd":                            "application/vnd.yamaha.hv-dic",
+	".hvp":                            "application/vnd.yamaha.hv-voice",
+	".hvs":                            "application/vnd.yamaha.hv-script",
+	".hwp":                            "application/x-hwp",
+	".hwt":                            "application/x-hwt",
+	".hxx":                            "text/x-c++hdr",
+	".i2g":                            "application/vnd.intergeo",
+	".ic0":                            "application/vnd.commerce-battelle",
+	".ic1":                            "application/vnd.commerce-battelle",
+	".ic2":                            "application/vnd.commerce-battelle",
+	".ic3":                            "application/vnd.commerce-battelle",
+	".ic4":                            "application/vnd.commerce-battelle",
+	".ic5":                            "application/vnd.commerce-battelle",
+	".ic6":                            "application/vnd.commerce-battelle",
+	".ic7":                            "application/vnd.commerce-battelle",
+	".ic8":                            "application/vnd.commerce-battelle",
+	".ica":                            "application/x-ica",
+	".icb":                            "image/x-tga",
+	".icc":                            "application/vnd.iccprofile",
+	".icd":                            "application/vnd.commerce-battelle",
+	".ice":                            "x-conference/x-cooltalk",
+	".icf":                            "application/vnd.commerce-battelle",
+	".icm":                            "application/vnd.iccprofile",
+	".icns":                           "image/x-icns",
+	".ico":                            "image/x-icon",
+	".ics":                            "text/calendar",
+	".idl":                            "text/x-idl",
+	".ief":                            "image/ief",
+	".ifb":                            "text/calendar",
+	".ifc":                            "application/p21",
+	".iff":                            "image/x-ilbm",
+	".ifm":                            "image/gif",
+	".ifs":                            "image/ifs",
+	".iges":                           "model/iges",
+	".igl":                            "application/vnd.igloader",
+	".igm":                            "application/vnd.insors.igm",
+	".ign":                            "application/vnd.coreos.ignition+json",
+	".ignition":                       "application/vnd.coreos.ignition+json",
+	".igs":                            "model/iges",
+	".igx":                            "application/vnd.micrografx.igx",
+	".iif":                            "application/vnd.shana.informed.interchange",
+	".iii":                            "application/x-iphone",
+	".ilbm":                           "image/x-ilbm",
+	".ime":                            "text/x-iMelody",
+	".imf":                            "application/vnd.imagemeter.folder+zip",
+	".img":                            "application/x-raw-disk-image",
+	".img.xz":                         "application/x-raw-disk-image-xz-compressed",
+	".imgcal":                         "application/vnd.3lightssoftware.imagescal",
+	".imi":                            "application/vnd.imagemeter.image+zip",
+	".imp":                            "application/vnd.accpac.simply.imp",
+	".ims":                            "application/vnd.ms-ims",
+	".imscc":                          "application/vnd.ims.imsccv1p1",
+	".imy":                            "audio/melody",
+	".in":                             "text/plain",
+	".info":                           "application/x-info",
+	".ini":                            "text/plain",
+	".ink":                            "application/inkml+xml",
+	".inkml":                          "application/inkml+xml",
+	".inp":                            "chemical/x-gamess-input",
+	".ins":                            "application/x-internet-signup",
+	".install":                        "application/x-install-instructions",
+	".iota":                           "application/vnd.astraea-software.iota",
+	".ipfix":                          "application/ipfix",
+	".ipk":                            "application/vnd.shana.informed.package",
+	".ips":                            "application/x-ipscript",
+	".iptables":                       "text/x-iptables",
+	".ipx":                            "application/x-ipix",
+	".ipynb":                          "application/x-ipynb+json",
+	".irm":                            "application/vnd.ibm.rights-management",
+	".irp":                            "application/vnd.irepository.package+xml",
+	".ism":                            "model/vnd.gdl",
+	".iso":                            "application/x-iso9660-image",
+	".iso9660":                        "application/x-cd-image",
+	".isp":                            "application/x-internet-signup",
+	".ist":                            "chemical/x-isostar",
+	".istc":                           "application/vnd.veryant.thin",
+	".istr":                           "chemical/x-isostar",
+	".isws":                           "application/vnd.veryant.thin",
+	".it":                             "audio/x-mod",
+	".it87":                           "application/x-it87",
+	".itp":                            "application/vnd.shana.informed.formtemplate",
+	".its":                            "application/its+xml",
+	".itz":                            "audio/x-mod",
+	".ivp":                            "application/vnd.immervision-ivp",
+	".ivr":                            "i-world/i-vrml",
+	".ivu":                            "application/vnd.immervision-ivu",
+	".j2c":                            "image/x-jp2-codestream",
+	".j2k":                            "image/j2k",
+	".jad":                            "text/vnd.sun.j2me.app-descriptor",
+	".jam":                            "application/x-jam",
+	".jar":                            "application/x-java-archive",
+	".java":                           "text/plain",
+	".jceks":                          "application/x-java-jce-keystore",
+	".jdx":                            "chemical/x-jcamp-dx",
+	".jfif":                           "image/pipeg",
+	".jhc":                            "image/jphc",
+	".jisp":                           "application/vnd.jisp",
+	".jks":                            "application/x-java-keystore",
+	".jls":                            "image/jls",
+	".jlt":                            "application/vnd.hp-jlyt",
+	".jmz":                            "application/x-jmol",
+	".jng":                            "image/x-jng",
+	".jnlp":                           "application/x-java-jnlp-file",
+	".joda":                           "application/vnd.joost.joda-archive",
+	".jp2":                            "image/jp2",
+	".jpc":                            "image/x-jp2-codestream",
+	".jpe":                            "image/jpeg",
+	".jpeg":                           "image/jpeg",
+	".jpf":                            "image/jpx",
+	".jpg":                            "image/jpeg",
+	".jpg2":                           "image/jp2",
+	".jpgm":                           "image/jpm",
+	".jpgv":                           "video/jpeg",
+	".jph":                            "image/jph",
+	".jphc":                           "image/jphc",
+	".jpm":                            "image/jpm",
+	".jpr":                            "application/x-jbuilder-project",
+	".jpx":                            "image/jpx",
+	".jpz":                            "image/jpeg",
+	".jrd":                            "application/jrd+json",
+	".js":                             "application/x-javascript",
+	".jsm":                            "application/javascript",
+	".json":                           "application/json",
+	".json-patch":                     "application/json-patch+json",
+	".jsonld":                         "application/ld+json",
+	".jsonml":                         "application/jsonml+json",
+	".jsontd":                         "application/td+json",
+	".jsontm":                         "application/tm+json",
+	".jt":                             "model/JT",
+	".jtd":                            "text/vnd.esmertec.theme-descriptor",
+	".jwc":                            "application/jwc",
+	".jxl":                            "image/jxl",
+	".jxr":                            "image/jxr",
+	".jxra":                           "image/jxrA",
+	".jxrs":                           "image/jxrS",
+	".jxs":                            "image/jxs",
+	".jxsc":                           "image/jxsc",
+	".jxsi":                           "image/jxsi",
+	".jxss":                           "image/jxss",
+	".k25":                            "image/x-kodak-k25",
+	".k7":                             "application/x-thomson-cassette",
+	".kar":                            "audio/midi",
+	".karbon":                         "application/vnd.kde.karbon",
+	".kcm":                            "application/vnd.nervana",
+	".kdc":                            "image/x-kodak-kdc",
+	".kdelnk":                         "application/x-desktop",
+	".kexi":                           "application/x-kexiproject-sqlite2",
+	".kexic":                          "application/x-kexi-connectiondata",
+	".kexis":                          "application/x-kexiproject-shortcut",
+	".key":                            "application/pgp-keys",
+	".keynote":                        "application/vnd.apple.keynote",
+	".kfo":                            "application/vnd.kde.kformula",
+	".kfx":                            "application/vnd.amazon.mobi8-ebook",
+	".kia":                            "application/vnd.kidspiration",
+	".kil":                            "application/x-killustrator",
+	".kin":                            "chemical/x-kinemage",
+	".kino":                           "application/smil+xml",
+	".kjx":                            "application/x-kjx",
+	".kml":                            "application/vnd.google-earth.kml+xml",
+	".kmz":                            "application/vnd.google-earth.kmz",
+	".kne":                            "application/vnd.Kinar",
+	".knp":                            "application/vnd.Kinar",
+	".kom":                            "application/vnd.hbci",
+	".kon":                            "application/vnd.kde.kontour",
+	".koz":                            "audio/vnd.audiokoz",
+	".kpm":                            "application/x-kpovmodeler",
+	".kpr":                            "application/vnd.kde.kpresenter",
+	".kpt":                            "application/vnd.kde.kpresenter",
+	".kpxx":                           "application/vnd.ds-keypoint",
+	".kra":                            "application/x-krita",
+	".krz":                            "application/x-krita",
+	".ks":                             "application/x-java-keystore",
+	".ksp":                            "application/x-kspread",
+	".ksy":                            "text/x-kaitai-struct",
+	".kt":                             "text/x-kotlin",
+	".ktr":                            "application/vnd.kahootz",
+	".ktx":                            "image/ktx",
+	".ktx2":                           "image/ktx2",
+	".ktz":                            "application/vnd.kahootz",
+	".kud":                            "application/x-kugar",
+	".kwd":                            "application/vnd.kde.kword",
+	".kwt":                            "application/vnd.kde.kword",
+	".l16":                            "audio/L16",
+	".la":                             "application/x-shared-library-la",
+	".lak":                            "x-lml/x-lak",
+	".las":                            "application/vnd.las",
+	".lasjson":                        "application/vnd.las.las+json",
+	".lasxml":                         "application/vnd.las.las+xml",
+	".latex":                          "application/x-latex",
+	".lbc":                            "audio/iLBC",
+	".lbd":                            "application/vnd.llamagraphics.life-balance.desktop",
+	".lbe":                            "application/vnd.llamagraphics.life-balance.exchange+xml",
+	".lbm":                            "image/x-ilbm",
+	".lca":                            "application/vnd.logipipe.circuit+zip",
+	".lcc":                            "application/fastman",
+	".lcl":                            "application/x-digitalloca",
+	".lcr":                            "application/x-digitalloca",
+	".lcs":                            "application/vnd.logipipe.circuit+zip",
+	".ldif":                           "text/x-ldif",
+	".le":                             "application/vnd.bluetooth.le.oob",
+	".les":                            "application/vnd.hhe.lesson-player",
+	".lgh":                            "application/lgh",
+	".lgr":                            "application/lgr+xml",
+	".lha":                            "application/octet-stream",
+	".lhs":                            "text/x-literate-haskell",
+	".lhz":                            "application/x-lhz",
+	".lhzd":                           "application/vnd.belightsoft.lhzd+zip",
+	".lhzl":                           "application/vnd.belightsoft.lhzl+zip",
+	".lin":                            "application/bbolin",
+	".line":                           "application/vnd.nebumind.line",
+	".link66":                         "application/vnd.route66.link66+xml",
+	".lisp":                           "text/x-common-lisp",
+	".list":                           "text/plain",
+	".list3820":                       "application/vnd.afpc.modca",
+	".listafp":                        "application/vnd.afpc.modca",
+	".lml":                            "x-lml/x-lml",
+	".lmlpack":                        "x-lml/x-lmlpack",
+	".lmp":                            "model/vnd.gdl",
+	".lnk":                            "application/x-ms-shortcut",
+	".lnx":                            "application/x-atari-lynx-rom",
+	".loas":                           "audio/usac",
+	".log":                            "text/plain",
+	".loom":                           "application/vnd.loom",
+	".lostsyncxml":                    "application/lostsync+xml",
+	".lostxml":                        "application/lost+xml",
+	".lpf":                            "application/lpf+zip",
+	".lrf":                            "application/octet-stream",
+	".lrm":                            "application/vnd.ms-lrm",
+	".lrv":                            "video/mp4",
+	".lrz":                            "application/x-lrzip",
+	".lsf":                            "video/x-la-asf",
+	".lsx":                            "video/x-la-asf",
+	".ltf":                            "application/vnd.frogans.ltf",
+	".ltx":                            "text/x-tex",
+	".lua":                            "text/x-lua",
+	".lvp":                            "audio/vnd.lucent.voice",
+	".lwo":                            "image/x-lwo",
+	".lwob":                           "image/x-lwo",
+	".lwp":                            "application/vnd.lotus-wordpro",
+	".lws":                            "image/x-lws",
+	".lxf":                            "application/LXF",
+	".ly":                             "text/x-lilypond",
+	".lyx":                            "application/x-lyx",
+	".lz":                             "application/x-lzip",
+	".lz4":                            "application/x-lz4",
+	".lzh":                            "application/octet-stream",
+	".lzma":                           "application/x-lzma",
+	".lzo":                            "application/x-lzop",
+	".lzx":                            "application/x-lzx",
+	".m":                              "application/vnd.wolfram.mathematica.package",
+	".m13":                            "application/x-msmediaview",
+	".m14":                            "application/x-msmediaview",
+	".m15":                            "audio/x-mod",
+	".m1u":                            "video/vnd.mpegurl",
+	".m1v":                            "video/mpeg",
+	".m21":                            "application/mp21",
+	".m2a":                            "audio/mpeg",
+	".m2t":                            "video/mp2t",
+	".m2ts":                           "video/mp2t",
+	".m2v":                            "video/mpeg",
+	".m3a":                            "audio/mpeg",
+	".m3g":                            "application/m3g",
+	".m3u":                            "audio/x-mpegurl",
+	".m3u8":                           "application/vnd.apple.mpegurl",
+	".m3url":                          "audio/x-mpegurl",
+	".m4":                             "application/x-m4",
+	".m4a":                            "audio/mp4a-latm",
+	".m4b":                            "audio/mp4a-latm",
+	".m4p":                            "audio/mp4a-latm",
+	".m4r":                            "audio/x-m4r",
+	".m4s":                            "video/iso.segment",
+	".m4u":                            "video/vnd.mpegurl",
+	".m4v":                            "video/x-m4v",
+	".m7":                             "application/x-thomson-cartridge-memo7",
+	".ma":                             "application/mathematica",
+	".ma1":                            "audio/ma1",
+	".ma2":                            "audio/ma2",
+	".ma3":                            "audio/ma3",
+	".ma5":                            "audio/ma5",
+	".mab":                            "application/x-markaby",
+	".mac":                            "image/x-macpaint",
+	".mads":                           "application/mads+xml",
+	".maei":                           "application/mmt-aei+xml",
+	".mag":                            "application/vnd.ecowin.chart",
+	".mail":                           "message/rfc822",
+	".mak":                            "text/x-makefile",
+	".maker":                          "application/x-maker",
+	".man":                            "application/x-troff-man",
+	".manifest":                       "text/cache-manifest",
+	".map":                            "magnus-internal/imagemap",
+	".mar":                            "application/octet-stream",
+	".markdown":                       "text/markdown",
+	".mathml":                         "application/mathml+xml",
+	".mb":                             "application/mathematica",
+	".mbd":                            "application/mbedlet",
+	".mbk":                            "application/vnd.Mobius.MBK",
+	".mbox":                           "application/mbox",
+	".mc1":                            "application/vnd.medcalcdata",
+	".mc2":                            "text/vnd.senx.warpscript",
+	".mcd":                            "application/vnd.mcd",
+	".mcif":                           "chemical/x-mmcif",
+	".mcm":                            "chemical/x-macmolecule",
+	".mct":                            "application/x-mascot",
+	".mcurl":                          "text/vnd.curl.mcurl",
+	".md":                             "text/markdown",
+	".mdb":                            "application/x-msaccess",
+	".mdc":                            "application/vnd.marlin.drm.mdcf",
+	".mdi":                            "image/vnd.ms-modi",
+	".mdx":                            "application/x-genesis-32x-rom",
+	".mdz":                            "audio/x-mod",
+	".me":                             "application/x-troff-me",
+	".med":                            "audio/x-mod",
+	".mel":                            "text/x-vmel",
+	".mesh":                           "model/mesh",
+	".meta4":                          "application/metalink4+xml",
+	".metalink":                       "application/metalink+xml",
+	".mets":                           "application/mets+xml",
+	".mf4":                            "application/MF4",
+	".mfm":                            "application/vnd.mfmp",
+	".mft":                            "application/rpki-manifest",
+	".mgp":                            "application/vnd.osgeo.mapguide.package",
+	".mgz":                            "application/vnd.proteus.magazine",
+	".mhas":                           "audio/mhas",
+	".mht":                            "message/rfc822",
+	".mhtml":                          "message/rfc822",
+	".mi":                             "application/x-mif",
+	".mid":                            "audio/midi",
+	".midi":                           "audio/midi",
+	".mie":                            "application/x-mie",
+	".mif":                            "application/vnd.mif",
+	".mil":                            "image/x-cals",
+	".mime":                           "message/rfc822",
+	".minipsf":                        "audio/x-minipsf",
+	".mio":                            "audio/x-mio",
+	".miz":                            "text/mizar",
+	".mj2":                            "video/mj2",
+	".mjp2":                           "video/mj2",
+	".mjpeg":                          "video/x-mjpeg",
+	".mjpg":                           "video/x-mjpeg",
+	".mjs":                            "text/javascript",
+	".mk":                             "text/x-makefile",
+	".mk3d":                           "video/x-matroska-3d",
+	".mka":                            "audio/x-matroska",
+	".mkd":                            "text/markdown",
+	".mks":                            "video/x-matroska",
+	".mkv":                            "video/x-matroska",
+	".ml":                             "text/x-ocaml",
+	".ml2":                            "application/vnd.sybyl.mol2",
+	".mli":                            "text/x-ocaml",
+	".mlp":                            "audio/vnd.dolby.mlp",
+	".mm":                             "application/x-freemind",
+	".mmd":                            "application/vnd.chipnuts.karaoke-mmd",
+	".mmdb":                           "application/vnd.maxmind.maxmind-db",
+	".mmf":                            "application/x-skt-lbs",
+	".mml":                            "application/mathml+xml",
+	".mmod":                           "chemical/x-macromodel-input",
+	".mmr":                            "image/vnd.fujixerox.edmics-mmr",
+	".mng":                            "video/x-mng",
+	".mny":                            "application/x-msmoney",
+	".mo":                             "application/x-gettext-translation",
+	".mo3":                            "audio/x-mo3",
+	".mobi":                           "application/x-mobipocket-ebook",
+	".moc":                            "application/x-mocha",
+	".mocha":                          "application/x-mocha",
+	".mod":                            "audio/x-mod",
+	".model-inter":                    "application/vnd.vd-study",
+	".mods":                           "application/mods+xml",
+	".mof":                            "application/x-yumekara",
+	".mol":                            "chemical/x-mdl-molfile",
+	".mol2":                           "application/vnd.sybyl.mol2",
+	".moml":                           "model/vnd.moml+xml",
+	".moo":                            "chemical/x-mopac-out",
+	".moov":                           "video/quicktime",
+	".mop":                            "chemical/x-mopac-input",
+	".mopcrt":                         "chemical/x-mopac-input",
+	".mount":                          "text/x-systemd-unit",
+	".mov":                            "video/quicktime",
+	".movie":                          "video/x-sgi-movie",
+	".mp+":                            "audio/x-musepack",
+	".mp1":                            "audio/mpeg",
+	".mp2":                            "audio/mpeg",
+	".mp21":                           "application/mp21",
+	".mp2a":                           "audio/mpeg",
+	".mp3":                            "audio/mpeg",
+	".mp4":                            "video/mp4",
+	".mp4a":                           "audio/mp4",
+	".mp4s":                           "application/mp4",
+	".mp4v":                           "video/mp4",
+	".mpa":                            "video/mpeg",
+	".mpc":                            "application/vnd.mpohun.certificate",
+	".mpd":                            "application/dash+xml",
+	".mpdd":                           "application/dashdelta",
+	".mpe":                            "video/mpeg",
+	".mpeg":                           "video/mpeg",
+	".mpega":                          "audio/mpeg",
+	".mpf":                            "text/vnd.ms-mediapackage",
+	".mpg":                            "video/mpeg",
+	".mpg4":                           "video/mp4",
+	".mpga":                           "audio/mpeg",
+	".mph":                            "application/x-comsol",
+	".mpkg":                           "application/vnd.apple.installer+xml",
+	".mpl":                            "text/x-mpl2",
+	".mpls":                           "video/mp2t",
+	".mpm":                            "application/vnd.blueice.multipass",
+	".mpn":                            "application/vnd.mophun.application",
+	".mpp":                            "application/vnd.ms-project",
+	".mps":                            "application/x-mapserver",
+	".mpt":                            "application/vnd.ms-project",
+	".mpv":                            "video/x-matroska",
+	".mpv2":                           "video/mpeg",
+	".mpw":                            "application/vnd.exstream-empower+zip",
+	".mpy":                            "application/vnd.ibm.MiniPay",
+	".mqy":                            "application/vnd.Mobius.MQY",
+	".mrc":                            "application/marc",
+	".mrcx":                           "application/marcxml+xml",
+	".mrl":                            "text/x-mrml",
+	".mrm":                            "application/x-mrm",
+	".mrml":                           "text/x-mrml",
+	".mrw":                            "image/x-minolta-mrw",
+	".ms":                             "application/x-troff-ms",
+	".msa":                            "application/vnd.msa-disk-image",
+	".mscml":                          "application/mediaservercontrol+xml",
+	".msd":                            "application/vnd.fdsn.mseed",
+	".mseed":                          "application/vnd.fdsn.mseed",
+	".mseq":                           "application/vnd.mseq",
+	".msf":                            "application/vnd.epson.msf",
+	".msg":                            "application/vnd.ms-outlook",
+	".msh":                            "model/mesh",
+	".msi":                            "application/x-msi",
+	".msl":                            "application/vnd.Mobius.MSL",
+	".msm":                            "model/vnd.gdl",
+	".msod":                           "image/x-msod",
+	".msp":                            "application/x-msi",
+	".msty":                           "application/vnd.muvee.style",
+	".msu":                            "application/octet-stream",
+	".msx":                            "application/x-msx-rom",
+	".mtl":                            "model/mtl",
+	".mtm":                            "audio/x-mod",
+	".mts":                            "application/metastream",
+	".mtx":                            "application/metastream",
+	".mtz":                            "application/metastream",
+	".multitrack":                     "audio/vnd.presonus.multitrack",
+	".mup":                            "text/x-mup",
+	".mus":                            "application/vnd.musician",
+	".musd":                           "application/mmt-usd+xml",
+	".musicxml":                       "application/vnd.recordare.musicxml+xml",
+	".mvb":                            "application/x-msmediaview",
+	".mvt":                            "application/vnd.mapbox-vector-tile",
+	".mwc":                            "application/vnd.dpgraph",
+	".mwf":                            "application/vnd.MFER",
+	".mxf":                            "application/mxf",
+	".mxi":                            "application/vnd.vd-study",
+	".mxl":                            "application/vnd.recordare.musicxml",
+	".mxmf":                           "audio/mobile-xmf",
+	".mxml":                           "application/xv+xml",
+	".mxs":                            "application/vnd.triscape.mxs",
+	".mxu":                            "video/vnd.mpegurl",
+	".mzv":                            "application/metastream",
+	".n-gage":                         "application/vnd.nokia.n-gage.symbian.install",
+	".n3":                             "text/n3",
+	".n64":                            "application/x-n64-rom",
+	".nar":                            "application/zip",
+	".nb":                             "application/vnd.wolfram.mathematica",
+	".nbmp":                           "image/nbmp",
+	".nbp":                            "application/vnd.wolfram.player",
+	".nc":                             "application/x-netcdf",
+	".ncx":                            "application/x-dtbncx+xml",
+	".ndb":                            "x-lml/x-ndb",
+	".ndc":                            "application/vnd.osa.netdeploy",
+	".ndl":                            "application/vnd.lotus-notes",
+	".nds":                            "application/vnd.nintendo.nitro.rom",
+	".ndwn":                           "application/ndwn",
+	".nebul":                          "application/vnd.nebumind.line",
+	".nef":                            "image/x-nikon-nef",
+	".nes":                            "application/x-nes-rom",
+	".nez":                            "application/x-nes-rom",
+	".nfo":                            "text/x-nfo",
+	".ngc":                            "application/x-neo-geo-pocket-color-rom",
+	".ngdat":                          "application/vnd.nokia.n-gage.data",
+	".ngp":                            "application/x-neo-geo-pocket-rom",
+	".nif":                            "application/x-nif",
+	".nim":                            "video/vnd.nokia.interleaved-multimedia",
+	".nimn":                           "application/vnd.nimn",
+	".nitf":                           "application/vnd.nitf",
+	".nlu":                            "application/vnd.neurolanguage.nlu",
+	".nml":                            "application/vnd.enliven",
+	".nmz":                            "application/x-scream",
+	".nnd":                            "application/vnd.noblenet-directory",
+	".nns":                            "application/vnd.noblenet-sealer",
+	".nnw":                            "application/vnd.noblenet-web",
+	".nokia-op-logo":                  "image/vnd.nok-oplogo-color",
+	".not":                            "text/x-mup",
+	".notebook":                       "application/vnd.smart.notebook",
+	".npx":                            "application/x-netfpx",
+	".nq":                             "application/n-quads",
+	".nrw":                            "image/x-nikon-nrw",
+	".ns2":                            "application/vnd.lotus-notes",
+	".ns3":                            "application/vnd.lotus-notes",
+	".ns4":                            "application/vnd.lotus-notes",
+	".nsc":                            "application/x-netshow-channel",
+	".nsf":                            "application/vnd.lotus-notes",
+	".nsg":                            "application/vnd.lotus-notes",
+	".nsh":                            "application/vnd.lotus-notes",
+	".nsnd":                           "audio/nsnd",
+	".nsv":                            "video/x-nsv",
+	".nt":                             "application/n-triples",
+	".ntf":                            "application/vnd.lotus-notes",
+	".numbers":                        "application/vnd.apple.numbers",
+	".nva":                            "application/x-neva1",
+	".nwc":                            "application/x-nwc",
+	".nws":                            "message/rfc822",
+	".nzb":                            "application/x-nzb",
+	".o":                              "application/x-object",
+	".oa2":                            "application/vnd.fujitsu.oasys2",
+	".oa3":                            "application/vnd.fujitsu.oasys3",
+	".oas":                            "application/vnd.fujitsu.oasys",
+	".obd":                            "application/x-msbinder",
+	".obg":                            "application/vnd.openblox.game-binary",
+	".obgx":                           "application/vnd.openblox.game+xml",
+	".obj":                            "model/obj",
+	".ocl":                            "text/x-ocl",
+	".ocx":                            "application/vnd.microsoft.portable-executable",
+	".oda":                            "application/oda",
+	".odb":                            "application/vnd.oasis.opendocument.database",
+	".odc":                            "application/vnd.oasis.opendocument.chart",
+	".odd":                            "application/tei+xml",
+	".odf":                            "application/vnd.oasis.opendocument.formula",
+	".odft":                           "application/vnd.oasis.opendocument.formula-template",
+	".odg":                            "application/vnd.oasis.opendocument.graphics",
+	".odi":                            "application/vnd.oasis.opendocument.image",
+	".odm":                            "application/vnd.oasis.opendocument.text-master",
+	".odp":                            "application/vnd.oasis.opendocument.presentation",
+	".ods":                            "application/vnd.oasis.opendocument.spreadsheet",
+	".odt":                            "application/vnd.oasis.opendocument.text",
+	".odx":                            "application/ODX",
+	".oeb":                            "application/vnd.openeye.oeb",
+	".oga":                            "audio/ogg",
+	".ogex":                           "model/vnd.opengex",
+	".ogg":                            "application/ogg",
+	".ogm":                            "video/x-ogm+ogg",
+	".ogv":                            "video/ogv",
+	".ogx":                            "application/ogg",
+	".old":                            "application/x-trash",
+	".oleo":                           "application/x-oleo",
+	".omdoc":                          "application/omdoc+xml",
+	".omg":                            "audio/ATRAC3",
+	".one":                            "application/onenote",
+	".onepkg":                         "application/onenote",
+	".onetmp":                         "application/onenote",
+	".onetoc":                         "application/onenote",
+	".onetoc2":                        "application/onenote",
+	".ooc":                            "text/x-ooc",
+	".oom":                            "application/x-AtlasMate-Plugin",
+	".opf":                            "application/oebps-package+xml",
+	".opml":                           "text/x-opml+xml",
+	".oprc":                           "application/vnd.palm",
+	".opus":                           "audio/ogg",
+	".or2":                            "application/vnd.lotus-organizer",
+	".or3":                            "application/vnd.lotus-organizer",
+	".ora":                            "image/openraster",
+	".orc":                            "audio/csound",
+	".orf":                            "image/x-olympus-orf",
+	".org":                            "application/vnd.lotus-organizer",
+	".orq":                            "application/ocsp-request",
+	".ors":                            "application/ocsp-response",
+	".osf":                            "application/vnd.yamaha.openscoreformat",
+	".osfpvg":                         "application/vnd.yamaha.openscoreformat.osfpvg+xml",
+	".osm":                            "application/vnd.openstreetmap.data+xml",
+	".ota":                            "application/vnd.android.ota",
+	".otc":                            "application/vnd.oasis.opendocument.chart-template",
+	".otf":                            "font/otf",
+	".otg":                            "application/vnd.oasis.opendocument.graphics-template",
+	".oth":                            "application/vnd.oasis.opendocument.text-web",
+	".oti":                            "application/vnd.oasis.opendocument.image-template",
+	".otp":                            "application/vnd.oasis.opendocument.presentation-template",
+	".ots":                            "application/vnd.oasis.opendocument.spreadsheet-template",
+	".ott":                            "application/vnd.oasis.opendocument.text-template",
+	".ova":                            "application/ovf",
+	".ovl":                            "application/vnd.afpc.modca-overlay",
+	".owl":                            "application/rdf+xml",
+	".owx":                            "application/owl+xml",
+	".oxlicg":                         "application/vnd.oxli.countgraph",
+	".oxps":                           "application/oxps",
+	".oxt":                            "application/vnd.openofficeorg.extension",
+	".oza":                            "application/x-oz-application",
+	".p":                              "text/x-pascal",
+	".p10":                            "application/pkcs10",
+	".p12":                            "application/x-pkcs12",
+	".p21":                            "application/p21",
+	".p2p":                            "application/vnd.wfa.p2p",
+	".p65":                            "application/x-pagemaker",
+	".p7b":                            "application/x-pkcs7-certificates",
+	".p7c":                            "application/x-pkcs7-mime",
+	".p7m":                            "application/x-pkcs7-mime",
+	".p7r":                            "application/x-pkcs7-certreqresp",
+	".p7s":                            "application/x-pkcs7-signature",
+	".p7z":                            "application/pkcs7-mime",
+	".p8":                             "application/pkcs8",
+	".p8e":                            "application/pkcs8-encrypted",
+	".pac":                            "audio/x-pac",
+	".pack":                           "application/x-java-pack200",
+	".package":                        "application/vnd.autopackage",
+	".pae":                            "audio/x-epac",
+	".pages":                          "application/vnd.apple.pages",
+	".pak":                            "application/x-pak",
+	".pan":                            "application/x-pan",
+	".par2":                           "application/x-par2",
+	".part":                           "application/x-partial-download",
+	".pas":                            "text/x-pascal",
+	".pat":                            "image/x-coreldrawpattern",
+	".patch":                          "text/x-diff",
+	".path":                           "text/x-systemd-unit",
+	".paw":                            "application/vnd.pawaafile",
+	".pbd":                            "application/vnd.powerbuilder6",
+	".pbm":                            "image/x-portable-bitmap",
+	".pcap":                           "application/vnd.tcpdump.pcap",
+	".pcd":                            "image/x-photo-cd",
+	".pce":                            "application/x-pc-engine-rom",
+	".pcf":                            "application/x-font-pcf",
+	".pcf.gz":                         "application/x-font-pcf",
+	".pcf.z":                          "application/x-font-pcf",
+	".pcl":                            "application/vnd.hp-PCL",
+	".pclxl":                          "application/vnd.hp-pclxl",
+	".pct":                            "image/pict",
+	".pcurl":                          "application/vnd.curl.pcurl",
+	".pcx":                            "image/x-pcx",
+	".pda":                            "image/x-pda",
+	".pdb":                            "chemical/x-pdb",
+	".pdc":                            "application/x-aportisdoc",
+	".pdf":                            "application/pdf",
+	".pdf.bz2":                        "application/x-bzpdf",
+	".pdf.gz":                         "application/x-gzpdf",
+	".pdf.lz":                         "application/x-lzpdf",
+	".pdf.xz":                         "application/x-xzpdf",
+	".pdx":                            "application/PDX",
+	".pef":                            "image/x-pentax-pef",
+	".pem":                            "application/pem-certificate-chain",
+	".perl":                           "application/x-perl",
+	".pfa":                            "application/x-font",
+	".pfb":                            "application/x-font",
+	".pfm":                            "application/x-font-type1",
+	".pfr":                            "application/font-tdpfr",
+	".pfx":                            "application/x-pkcs12",
+	".pgb":                            "image/vnd.globalgraphics.pgb",
+	".pgm":                            "image/x-portable-graymap",
+	".pgn":                            "application/x-chess-pgn",
+	".pgp":                            "application/pgp-encrypted",
+	".php":                            "application/x-php",
+	".php3":                           "application/x-php",
+	".php4":                           "application/x-php",
+	".php5":                           "application/x-php",
+	".phps":                           "application/x-php",
+	".pic":                            "image/pict",
+	".pict":                           "image/pict",
+	".pict1":                          "image/x-pict",
+	".pict2":                          "image/x-pict",
+	".pil":                            "application/vnd.piaccess.application-licence",
+	".pk":                             "application/x-tex-pk",
+	".pkd":                            "application/vnd.hbci",
+	".pkg":                            "application/vnd.apple.installer+xml",
+	".pki":                            "application/pkixcmp",
+	".pkipath":                        "application/pkix-pkipath",
+	".pko":                            "application/ynd.ms-pkipko",
+	".pkpass":                         "application/vnd.apple.pkpass",
+	".pkr":                            "application/pgp-keys",
+	".pl":                             "text/x-perl",
+	".pla":                            "audio/x-iriver-pla",
+	".plb":                            "application/vnd.3gpp.pic-bw-large",
+	".plc":                            "application/vnd.Mobius.PLC",
+	".plf":                            "application/vnd.pocketlearn",
+	".plj":                            "audio/vnd.everad.plj",
+	".pln":                            "application/x-planperfect",
+	".plp":                            "application/vnd.panoply",
+	".pls":                            "audio/x-scpls",
+	".pm":                             "application/x-perl",
+	".pm6":                            "application/x-pagemaker",
+	".pma":                            "application/x-perfmon",
+	".pmc":                            "application/x-perfmon",
+	".pmd":                            "application/x-pmd",
+	".pml":                            "application/x-perfmon",
+	".pmr":                            "application/x-perfmon",
+	".pmw":                            "application/x-perfmon",
+	".png":                            "image/png",
+	".pnm":                            "image/x-portable-anymap",
+	".pnt":                            "image/x-macpaint",
+	".pntg":                           "image/x-macpaint",
+	".pnz":                            "image/png",
+	".po":                             "text/x-gettext-translation",
+	".pod":                            "application/x-perl",
+	".por":                            "application/x-spss-por",
+	".portpkg":                        "application/vnd.macports.portpkg",
+	".pot":                            "application/vnd.ms-powerpoint",
+	".potm":                           "application/vnd.ms-powerpoint.template.macroEnabled.12",
+	".potx":                           "application/vnd.openxmlformats-officedocument.presentationml.template",
+	".ppam":                           "application/vnd.ms-powerpoint.addin.macroEnabled.12",
+	".ppd":                            "application/vnd.cups-ppd",
+	".ppkg":                           "application/vnd.xmpie.ppkg",
+	".ppm":                            "image/x-portable-pixmap",
+	".pps":                            "application/vnd.ms-powerpoint",
+	".ppsm":                           "application/vnd.ms-powerpoint.slideshow.macroEnabled.12",
+	".ppsx":                           "application/vnd.openxmlformats-officedocument.presentationml.slideshow",
+	".ppt":                            "application/vnd.ms-powerpoint",
+	".pptm":                           "application/vnd.ms-powerpoint.presentation.macroEnabled.12",
+	".ppttc":                          "application/vnd.think-cell.ppttc+json",
+	".pptx":                           "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".ppz":                            "application/vnd.ms-powerpoint",
+	".pqa":                            "application/vnd.palm",
+	".pqf":                            "application/x-cprplayer",
+	".pqi":                            "application/cprplayer",
+	".prc":                            "application/x-prc",
+	".pre":                            "application/vnd.lotus-freelance",
+	".preminet":                       "application/vnd.preminet",
+	".prf":                            "application/pics-rules",
+	".prop":                           "text/plain",
+	".provn":                          "text/provenance-notation",
+	".provx":                          "application/provenance+xml",
+	".proxy":                          "application/x-ns-proxy-autoconfig",
+	".prt":                            "chemical/x-ncbi-asn1-ascii",
+	".prz":                            "application/vnd.lotus-freelance",
+	".ps":                             "application/postscript",
+	".ps.bz2":                         "application/x-bzpostscript",
+	".ps.gz":                          "application/x-gzpostscript",
+	".psb":                            "application/vnd.3gpp.pic-bw-small",
+	".psd":                            "image/vnd.adobe.photoshop",
+	".pseg3820":                       "application/vnd.afpc.modca",
+	".psf":                            "application/x-font-linux-psf",
+	".psf.gz":                         "application/x-gz-font-linux-psf",
+	".psflib":                         "audio/x-psflib",
+	".psfs":                           "application/vnd.psfs",
+	".psg":                            "application/vnd.afpc.modca-pagesegment",
+	".psid":                           "audio/prs.sid",
+	".pskcxml":                        "application/pskc+xml",
+	".psw":                            "application/x-pocket-word",
+	".pt":                             "application/vnd.snesdev-page-table",
+	".pti":                            "image/prs.pti",
+	".ptid":                           "application/vnd.pvi.ptid1",
+	".ptlk":                           "application/listenup",
+	".ptrom":                          "application/vnd.snesdev-page-table",
+	".pub":                            "application/x-mspublisher",
+	".pvb":                            "application/vnd.3gpp.pic-bw-var",
+	".pvx":                            "video/x-pv-pvx",
+	".pw":                             "application/x-pw",
+	".pwn":                            "application/vnd.3M.Post-it-Notes",
+	".py":                             "text/x-python",
+	".pya":                            "audio/vnd.ms-playready.media.pya",
+	".pyc":                            "application/x-python-code",
+	".pyo":                            "application/x-python-code",
+	".pyox":                           "model/vnd.pytha.pyox",
+	".pys":                            "application/x-pyspread-bz-spreadsheet",
+	".pysu":                           "application/x-pyspread-spreadsheet",
+	".pyv":                            "video/vnd.ms-playready.media.pyv",
+	".qam":                            "application/vnd.epson.quickanime",
+	".qbo":                            "application/vnd.intu.qbo",
+	".qca":                            "application/vnd.ericsson.quickcall",
+	".qcall":                          "application/vnd.ericsson.quickcall",
+	".qcow":                           "application/x-qemu-disk",
+	".qcow2":                          "application/x-qemu-disk",
+	".qcp":                            "audio/vnd.qcelp",
+	".qd":                             "application/x-raw-floppy-disk-image",
+	".qed":                            "application/x-qed-disk",
+	".qfx":                            "application/vnd.intu.qfx",
+	".qgs":                            "application/x-qgis",
+	".qif":                            "application/x-qw",
+	".qml":                            "text/x-qml",
+	".qmlproject":                     "text/x-qml",
+	".qmltypes":                       "text/x-qml",
+	".qp":                             "application/x-qpress",
+	".qps":                            "application/vnd.publishare-delta-tree",
+	".qs":                             "application/sparql-query",
+	".qt":                             "video/quicktime",
+	".qti":                            "image/x-quicktime",
+	".qti.gz":                         "application/x-qtiplot",
+	".qtif":                           "image/x-quicktime",
+	".qtl":                            "application/x-quicktimeplayer",
+	".qtvr":                           "video/quicktime",
+	".quiz":                           "application/vnd.quobject-quoxdocument",
+	".quox":                           "application/vnd.quobject-quoxdocument",
+	".qvd":                            "application/vnd.theqvd",
+	".qwd":                            "application/vnd.Quark.QuarkXPress",
+	".qwt":                            "application/vnd.Quark.QuarkXPress",
+	".qxb":                            "application/vnd.Quark.QuarkXPress",
+	".qxd":                            "application/vnd.Quark.QuarkXPress",
+	".qxl":                            "application/vnd.Quark.QuarkXPress",
+	".qxt":                            "application/vnd.Quark.QuarkXPress",
+	".r3t":                            "text/vnd.rn-realtext3d",
+	".ra":                             "audio/x-pn-realaudio",
+	".raf":                            "image/x-fuji-raf",
+	".ram":                            "audio/x-pn-realaudio",
+	".raml":                           "application/raml+yaml",
+	".rapd":                           "application/route-apd+xml",
+	".rar":                            "application/octet-stream",
+	".ras":                            "image/x-cmu-raster",
+	".raw":                            "image/x-panasonic-rw",
+	".raw-disk-image":                 "application/x-raw-disk-image",
+	".raw-disk-image.xz":              "application/x-raw-disk-image-xz-compressed",
+	".rax":                            "audio/vnd.rn-realaudio",
+	".rb":                             "application/x-ruby",
+	".rc":                             "text/plain",
+	".rcprofile":                      "application/vnd.ipunplugged.rcprofile",
+	".rct":                            "application/prs.nprend",
+	".rd":                             "chemical/x-mdl-rdfile",
+	".rdf":                            "application/rdf+xml",
+	".rdf-crypt":                      "application/prs.rdf-xml-crypt",
+	".rdfs":                           "application/rdf+xml",
+	".rdp":                            "application/x-rdp",
+	".rdz":                            "application/vnd.data-vision.rdz",
+	".reg":                            "text/x-ms-regedit",
+	".rej":                            "text/x-reject",
+	".relo":                           "application/p2p-overlay+xml",
+	".reload":                         "application/vnd.resilient.logic",
+	".rep":                            "application/vnd.businessobjects",
+	".request":                        "application/vnd.nervana",
+	".res":                            "application/x-godot-resource",
+	".rf":                             "image/vnd.rn-realflash",
+	".rfcxml":                         "application/rfc+xml",
+	".rgb":                            "image/x-rgb",
+	".rgbe":                           "image/vnd.radiance",
+	".rif":                            "application/reginfo+xml",
+	".rip":                            "audio/vnd.rip",
+	".ris":                            "application/x-research-info-systems",
+	".rl":                             "application/resource-lists+xml",
+	".rlc":                            "image/vnd.fujixerox.edmics-rlc",
+	".rld":                            "application/resource-lists-diff+xml",
+	".rle":                            "image/rle",
+	".rlf":                            "application/x-richlink",
+	".rlm":                            "application/vnd.resilient.logic",
+	".rm":                             "application/vnd.rn-realmedia",
+	".rmf":                            "audio/x-rmf",
+	".rmi":                            "audio/mid",
+	".rmj":                            "application/vnd.rn-realmedia",
+	".rmm":                            "audio/x-pn-realaudio",
+	".rmp":                            "audio/x-pn-realaudio-plugin",
+	".rms":                            "application/vnd.jcp.javame.midlet-rms",
+	".rmvb":                           "audio/x-pn-realaudio",
+	".rmx":                            "application/vnd.rn-realmedia",
+	".rnc":                            "application/relax-ng-compact-syntax",
+	".rnd":                            "application/prs.nprend",
+	".rng":                            "application/xml",
+	".rnx":                            "application/vnd.rn-realplayer",
+	".roa":                            "application/rpki-roa",
+	".roff":                           "application/x-troff",
+	".ros":                            "chemical/x-rosdal",
+	".rp":                             "image/vnd.rn-realpix",
+	".rp9":                            "application/vnd.cloanto.rp9",
+	".rpm":                            "application/x-rpm",
+	".rpss":                           "application/vnd.nokia.radio-presets",
+	".rpst":                           "application/vnd.nokia.radio-preset",
+	".rq":                             "application/sparql-query",
+	".rs":                             "application/rls-services+xml",
+	".rsat":                           "application/atsc-rsat+xml",
+	".rsd":                            "application/rsd+xml",
+	".rsheet":                         "application/urc-ressheet+xml",
+	".rsm":                            "model/vnd.gdl",
+	".rss":                            "application/x-rss+xml",
+	".rst":                            "text/prs.fallenstein.rst",
+	".rt":                             "text/vnd.rn-realtext",
+	".rte":                            "x-lml/x-gps",
+	".rtf":                            "text/rtf",
+	".rtg":                            "application/metastream",
+	".rtx":                            "text/richtext",
+	".rusd":                           "application/route-usd+xml",
+	".rv":                             "video/vnd.rn-realvideo",
+	".rvx":                            "video/vnd.rn-realvideo",
+	".rw2":                            "image/x-panasonic-rw2",
+	".rwc":                            "application/x-rogerwilco",
+	".rxn":                            "chemical/x-mdl-rxnfile",
+	".rxt":                            "application/vnd.medicalholodeck.recordxr",
+	".s":                              "text/x-asm",
+	".s11":                            "video/vnd.sealed.mpeg1",
+	".s14":                            "video/vnd.sealed.mpeg4",
+	".s1a":                            "application/vnd.sealedmedia.softseal.pdf",
+	".s1e":                            "application/vnd.sealed.xls",
+	".s1g":                            "image/vnd.sealedmedia.softseal.gif",
+	".s1h":                            "application/vnd.sealedmedia.softseal.html",
+	".s1j":                            "image/vnd.sealedmedia.softseal.jpg",
+	".s1m":                            "audio/vnd.sealedmedia.softseal.mpeg",
+	".s1n":                            "image/vnd.sealed.png",
+	".s1p":                            "application/vnd.sealed.ppt",
+	".s1q":                            "video/vnd.sealedmedia.softseal.mov",
+	".s1w":                            "application/vnd.sealed.doc",
+	".s3df":                           "application/vnd.sealed.3df",
+	".s3m":                            "audio/x-mod",
+	".s3z":                            "audio/x-mod",
+	".sac":                            "application/tamp-sequence-adjust-confirm",
+	".saf":                            "application/vnd.yamaha.smaf-audio",
+	".sam":                            "application/vnd.lotus-wordpro",
+	".sami":                           "application/x-sami",
+	".sap":                            "application/x-thomson-sap-image",
+	".sar":                            "application/vnd.sar",
+	".sarif":                          "application/sarif+json",
+	".sarif-external-properties":      "application/sarif-external-properties+json",
+	".sarif-external-properties.json": "application/sarif-external-properties+json",
+	".sarif.json":                     "application/sarif+json",
+	".sass":                           "text/x-sass",
+	".sav":                            "application/x-spss-sav",
+	".sbml":                           "application/sbml+xml",
+	".sc":                             "application/vnd.ibm.secure-container",
+	".sca":                            "application/x-supercard",
+	".scala":                          "text/x-scala",
+	".scd":                            "application/x-msschedule",
+	".sce":                            "application/vnd.etsi.asic-e+zip",
+	".sci":                            "application/x-scilab",
+	".scim":                           "application/scim+json",
+	".scl":                            "application/vnd.sycle+xml",
+	".scld":                           "application/vnd.doremir.scorecloud-binary-document",
+	".scm":                            "application/vnd.lotus-screencam",
+	".scn":                            "application/x-godot-scene",
+	".sco":                            "audio/csound",
+	".scope":                          "text/x-systemd-unit",
+	".scq":                            "application/scvp-cv-request",
+	".scr":                            "application/vnd.microsoft.portable-executable",
+	".scs":                            "application/scvp-cv-response",
+	".scsf":                           "application/vnd.sealed.csf",
+	".scss":                           "text/x-scss",
+	".sct":                            "text/scriptlet",
+	".scurl":                          "text/vnd.curl.scurl",
+	".sd":                             "chemical/x-mdl-sdfile",
+	".sd2":                            "audio/x-sd2",
+	".sda":                            "application/vnd.stardivision.draw",
+	".sdc":                            "application/vnd.stardivision.calc",
+	".sdd":                            "application/vnd.stardivision.impress",
+	".sdf":                            "application/e-score",
+	".sdkd":                           "application/vnd.solent.sdkm+xml",
+	".sdkm":                           "application/vnd.solent.sdkm+xml",
+	".sdo":                            "application/vnd.sealed.doc",
+	".sdoc":                           "application/vnd.sealed.doc",
+	".sdp":                            "application/sdp",
+	".sds":                            "application/vnd.stardivision.chart",
+	".sdw":                            "application/vnd.stardivision.writer",
+	".sea":                            "application/x-stuffit",
+	".see":                            "application/vnd.seemail",
+	".seed":                           "application/vnd.fdsn.seed",
+	".sem":                            "application/vnd.sealed.eml",
+	".sema":                           "application/vnd.sema",
+	".semd":                           "application/vnd.semd",
+	".semf":                           "application/vnd.semf",
+	".seml":                           "application/vnd.sealed.eml",
+	".senml":                          "application/senml+json",
+	".senml-etchc":                    "application/senml-etch+cbor",
+	".senml-etchj":                    "application/senml-etch+json",
+	".senmlc":                         "application/senml+cbor",
+	".senmle":                         "application/senml-exi",
+	".senmlx":                         "application/senml+xml",
+	".sensml":                         "application/sensml+json",
+	".sensmlc":                        "application/sensml+cbor",
+	".sensmle":                        "application/sensml-exi",
+	".sensmlx":                        "application/sensml+xml",
+	".ser":                            "application/java-serialized-object",
+	".service":                        "text/x-dbus-service",
+	".setpay":                         "application/set-payment-initiation",
+	".setreg":                         "application/set-registration-initiation",
+	".sfc":                            "application/vnd.nintendo.snes.rom",
+	".sfd":                            "application/vnd.font-fontforge-sfd",
+	".sfd-hdstx":                      "application/vnd.hydrostatix.sof-data",
+	".sfs":                            "application/vnd.spotfire.sfs",
+	".sfv":                            "text/x-sfv",
+	".sg":                             "application/x-sg1000-rom",
+	".sgb":                            "application/x-gameboy-rom",
+	".sgd":                            "application/x-genesis-rom",
+	".sgf":                            "application/x-go-sgf",
+	".sgi":                            "image/vnd.sealedmedia.softseal.gif",
+	".sgif":                           "image/vnd.sealedmedia.softseal.gif",
+	".sgl":                            "application/vnd.stardivision.writer-global",
+	".sgm":                            "text/sgml",
+	".sgml":                           "text/sgml",
+	".sh":                             "application/x-sh",
+	".shaclc":                         "text/shaclc",
+	".shape":                          "application/x-dia-shape",
+	".shar":                           "application/x-shar",
+	".shc":                            "text/shaclc",
+	".shex":                           "text/shex",
+	".shf":                            "application/shf+xml",
+	".shn":                            "application/x-shorten",
+	".shp":                            "application/vnd.shp",
+	".shtm":                           "text/html",
+	".shtml":                          "text/html",
+	".shw":                            "application/presentations",
+	".shx":                            "application/vnd.shx",
+	".si":                             "text/vnd.wap.si",
+	".si6":                            "image/si6",
+	".si7":                            "image/vnd.stiwap.sis",
+	".si9":                            "image/vnd.lgtwap.sis",
+	".siag":                           "application/x-siag",
+	".sic":                            "application/vnd.wap.sic",
+	".sid":                            "audio/prs.sid",
+	".sieve":                          "application/sieve",
+	".sig":                            "application/pgp-signature",
+	".sik":                            "application/x-trash",
+	".sil":                            "audio/silk",
+	".silo":                           "model/mesh",
+	".sis":                            "application/vnd.symbian.install",
+	".sisx":                           "x-epoc/x-sisx-app",
+	".sit":                            "application/x-stuffit",
+	".sitx":                           "application/x-stuffit",
+	".siv":                            "application/sieve",
+	".sjp":                            "image/vnd.sealedmedia.softseal.jpg",
+	".sjpg":                           "image/vnd.sealedmedia.softseal.jpg",
+	".sk":                             "image/x-skencil",
+	".sk1":                            "image/x-skencil",
+	".skd":                            "application/x-koan",
+	".skm":                            "application/x-koan",
+	".skp":                            "application/x-koan",
+	".skr":                            "application/pgp-keys",
+	".skt":                            "application/x-koan",
+	".sl":                             "text/vnd.wap.sl",
+	".sla":                            "application/vnd.scribus",
+	".slaz":                           "application/vnd.scribus",
+	".slc":                            "application/x-salsa",
+	".sldm":                           "application/vnd.ms-powerpoint.slide.macroEnabled.12",
+	".sldx":                           "application/vnd.openxmlformats-officedocument.presentationml.slide",
+	".slice":                          "text/x-systemd-unit",
+	".slk":                            "text/spreadsheet",
+	".sls":                            "application/route-s-tsid+xml",
+	".slt":                            "application/vnd.epson.salt",
+	".sm":                             "application/vnd.stepmania.stepchart",
+	".smaf":                           "application/vnd.smaf",
+	".smc":                            "application/vnd.nintendo.snes.rom",
+	".smd":                            "audio/x-smd",
+	".smf":                            "application/vnd.stardivision.math",
+	".smh":                            "application/vnd.sealed.mht",
+	".smht":                           "application/vnd.sealed.mht",
+	".smi":                            "application/smil",
+	".smil":                           "application/smil",
+	".smk":                            "video/vnd.radgamettools.smacker",
+	".sml":                            "application/smil+xml",
+	".smo":                            "video/vnd.sealedmedia.softseal.mov",
+	".smov":                           "video/vnd.sealedmedia.softseal.mov",
+	".smp":                            "application/studiom",
+	".smp3":                           "audio/vnd.sealedmedia.softseal.mpeg",
+	".smpg":                           "video/vnd.sealed.mpeg1",
+	".sms":                            "application/vnd.3gpp2.sms",
+	".smv":                            "audio/SMV",
+	".smz":                            "audio/x-smd",
+	".smzip":                          "application/vnd.stepmania.package",
+	".snap":                           "application/vnd.snap",
+	".snd":                            "audio/basic",
+	".snf":                            "application/x-font-snf",
+	".so":                             "application/octet-stream",
+	".soa":                            "text/dns",
+	".soc":                            "application/sgml-open-catalog",
+	".socket":                         "text/x-systemd-unit",
+	".sofa":                           "audio/sofa",
+	".sos":                            "text/vnd.sosi",
+	".spc":                            "application/x-pkcs7-certificates",
+	".spd":                            "application/vnd.sealedmedia.softseal.pdf",
+	".spdf":                           "application/vnd.sealedmedia.softseal.pdf",
+	".spdx":                           "text/spdx",
+	".spdx.json":                      "application/spdx+json",
+	".spec":                           "text/x-rpm-spec",
+	".spf":                            "application/vnd.yamaha.smaf-phrase",
+	".spl":                            "application/x-futuresplash",
+	".spm":                            "application/x-source-rpm",
+	".spn":                            "image/vnd.sealed.png",
+	".spng":                           "image/vnd.sealed.png",
+	".spo":                            "text/vnd.in3d.spot",
+	".spot":                           "text/vnd.in3d.spot",
+	".spp":                            "application/scvp-vp-response",
+	".sppt":                           "application/vnd.sealed.ppt",
+	".spq":                            "application/scvp-vp-request",
+	".spr":                            "application/x-sprite",
+	".sprite":                         "application/x-sprite",
+	".spt":                            "application/x-spt",
+	".spx":                            "audio/ogg",
+	".sql":                            "application/sql",
+	".sqlite":                         "application/vnd.sqlite3",
+	".sqlite2":                        "application/x-sqlite2",
+	".sqlite3":                        "application/vnd.sqlite3",
+	".sqsh":                           "application/vnd.squashfs",
+	".sr":                             "application/vnd.sigrok.session",
+	".sr2":                            "image/x-sony-sr2",
+	".src":                            "application/x-wais-source",
+	".src.rpm":                        "application/x-source-rpm",
+	".srf":                            "image/x-sony-srf",
+	".srt":                            "text/plain",
+	".sru":                            "application/sru+xml",
+	".srx":                            "application/sparql-results+xml",
+	".ss":                             "text/x-scheme",
+	".ssa":                            "text/x-ssa",
+	".ssdl":                           "application/ssdl+xml",
+	".sse":                            "application/vnd.kodak-descriptor",
+	".ssf":                            "application/vnd.epson.ssf",
+	".ssml":                           "application/ssml+xml",
+	".sst":                            "application/vnd.ms-pkicertstore",
+	".ssv":                            "application/vnd.shade-save-file",
+	".ssvc":                           "application/vnd.crypto-shade-file",
+	".ssw":                            "video/vnd.sealed.swf",
+	".sswf":                           "video/vnd.sealed.swf",
+	".st":                             "application/vnd.sailingtracker.track",
+	".stc":                            "application/vnd.sun.xml.calc.template",
+	".std":                            "application/vnd.sun.xml.draw.template",
+	".step":                           "model/step",
+	".stf":                            "application/vnd.wt.stf",
+	".sti":                            "application/vnd.sun.xml.impress.template",
+	".stif":                           "application/vnd.sealed.tiff",
+	".stix":                           "application/stix+json",
+	".stk":                            "application/hyperstudio",
+	".stl":                            "application/vnd.ms-pkistl",
+	".stm":                            "text/html",
+	".stml":                           "application/vnd.sealedmedia.softseal.html",
+	".stp":                            "model/step",
+	".stpnc":                          "application/p21",
+	".stpx":                           "model/step+xml",
+	".stpxz":                          "model/step-xml+zip",
+	".stpz":                           "model/step+zip",
+	".str":                            "application/vnd.pg.format",
+	".study-inter":                    "application/vnd.vd-study",
+	".stw":                            "application/vnd.sun.xml.writer.template",
+	".sty":                            "text/x-tex",
+	".sub":                            "text/x-microdvd",
+	".sun":                            "image/x-sun-raster",
+	".sus":                            "application/vnd.sus-calendar",
+	".susp":                           "application/vnd.sus-calendar",
+	".sv":                             "text/x-svsrc",
+	".sv4cpio":                        "application/x-sv4cpio",
+	".sv4crc":                         "application/x-sv4crc",
+	".svc":                            "application/vnd.dvb.service",
+	".svd":                            "application/vnd.svd",
+	".svf":                            "image/vnd",
+	".svg":                            "image/svg+xml",
+	".svg.gz":                         "image/svg+xml-compressed",
+	".svgz":                           "image/svg+xml",
+	".svh":                            "image/svh",
+	".svr":                            "x-world/x-svr",
+	".sw":                             "chemical/x-swissprot",
+	".swa":                            "application/x-director",
+	".swap":                           "text/x-systemd-unit",
+	".swf":                            "application/x-shockwave-flash",
+	".swfl":                           "application/x-shockwave-flash",
+	".swi":                            "application/vnd.aristanetworks.swi",
+	".swidtag":                        "application/swid+xml",
+	".swm":                            "application/x-ms-wim",
+	".sxc":                            "application/vnd.sun.xml.calc",
+	".sxd":                            "application/vnd.sun.xml.draw",
+	".sxg":                            "application/vnd.sun.xml.writer.global",
+	".sxi":                            "application/vnd.sun.xml.impress",
+	".sxl":                            "application/vnd.sealed.xls",
+	".sxls":                           "application/vnd.sealed.xls",
+	".sxm":                            "application/vnd.sun.xml.math",
+	".sxw":                            "application/vnd.sun.xml.writer",
+	".sy2":                            "application/vnd.sybyl.mol2",
+	".syft.json":                      "application/vnd.syft+json",
+	".sylk":                           "text/spreadsheet",
+	".sys":                            "application/vnd.microsoft.portable-executable",
+	".t":                              "application/x-troff",
+	".t2t":                            "text/x-txt2tags",
+	".t3":                             "application/x-t3vm-image",
+	".tad":                            "application/octet-stream",
+	".tag":                            "text/prs.lines.tag",
+	".taglet":                         "application/vnd.mynfc",
+	".talk":                           "text/x-speech",
+	".tam":                            "application/vnd.onepager",
+	".tamp":                           "application/vnd.onepagertamp",
+	".tamx":                           "application/vnd.onepagertamx",
+	".tao":                            "application/vnd.tao.intent-module-archive",
+	".tap":                            "image/vnd.tencent.tap",
+	".tar":                            "application/x-tar",
+	".tar.bz":                         "application/x-bzip-compressed-tar",
+	".tar.bz2":                        "application/x-bzip2-compressed-tar",
+	".tar.gz":                         "application/x-compressed-tar",
+	".tar.lrz":                        "application/x-lrzip-compressed-tar",
+	".tar.lz":                         "application/x-lzip-compressed-tar",
+	".tar.lz4":                        "application/x-lz4-compressed-tar",
+	".tar.lzma":                       "application/x-lzma-compressed-tar",
+	".tar.lzo":                        "application/x-tzo",
+	".tar.xz":                         "application/x-xz-compressed-tar",
+	".tar.z":                          "application/x-tarz",
+	".tar.zst":                        "application/x-zstd-compressed-tar",
+	".target":                         "text/x-systemd-unit",
+	".tat":                            "application/vnd.onepagertat",
+	".tatp":                           "application/vnd.onepagertatp",
+	".tatx":                           "application/vnd.onepagertatx",
+	".tau":                            "application/tamp-apex-update",
+	".taz":                            "application/x-tar",
+	".tb2":                            "application/x-bzip-compressed-tar",
+	".tbp":                            "application/x-timbuktu",
+	".tbt":                            "application/x-timbuktu",
+	".tbz":                            "application/x-bzip-compressed-tar",
+	".tbz2":                           "application/x-bzip-compressed-tar",
+	".tcap":                           "application/vnd.3gpp2.tcap",
+	".tcl":                            "application/x-tcl",
+	".tcu":                            "application/tamp-community-update",
+	".td":                             "application/urc-targetdesc+xml",
+	".teacher":                        "application/vnd.smart.teacher",
+	".tei":                            "application/tei+xml",
+	".teicorpus":                      "application/tei+xml",
+	".ter":                            "application/tamp-error",
+	".tex":                            "application/x-tex",
+	".texi":                           "application/x-texinfo",
+	".texinfo":                        "application/x-texinfo",
+	".text":                           "text/plain",
+	".tfi":                            "application/thraud+xml",
+	".tfm":                            "application/x-tex-tfm",
+	".tfx":                            "image/tiff-fx",
+	".tga":                            "image/x-tga",
+	".tgf":                            "chemical/x-mdl-tgf",
+	".tgz":                            "application/x-compressed",
+	".theme":                          "application/x-theme",
+	".themepack":                      "application/x-windows-themepack",
+	".thm":                            "application/vnd.eri.thm",
+	".thmx":                           "application/vnd.ms-officetheme",
+	".tif":                            "image/tiff",
+	".tiff":                           "image/tiff",
+	".timer":                          "text/x-systemd-unit",
+	".tk":                             "text/x-tcl",
+	".tki":                            "application/x-tkined",
+	".tkined":                         "application/x-tkined",
+	".tlclient":                       "application/vnd.cendio.thinlinc.clientconf",
+	".tlrz":                           "application/x-lrzip-compressed-tar",
+	".tlz":                            "application/x-lzma-compressed-tar",
+	".tm":                             "text/texmacs",
+	".tm.json":                        "application/tm+json",
+	".tm.jsonld":                      "application/tm+json",
+	".tmo":                            "application/vnd.tmobile-livetv",
+	".tnef":                           "application/vnd.ms-tnef",
+	".tnf":                            "application/vnd.ms-tnef",
+	".toc":                            "application/toc",
+	".toml":                           "application/toml",
+	".torrent":                        "application/x-bittorrent",
+	".toy":                            "image/toy",
+	".tpic":                           "image/x-tga",
+	".tpl":                            "application/vnd.groove-tool-template",
+	".tpt":                            "application/vnd.trid.tpt",
+	".tr":                             "application/x-troff",
+	".tra":                            "application/vnd.trueapp",
+	".tree":                           "application/vnd.rainstor.data",
+	".tres":                           "application/x-godot-resource",
+	".trig":                           "application/trig",
+	".trk":                            "x-lml/x-gps",
+	".trm":                            "application/x-msterminal",
+	".ts":                             "text/vnd.trolltech.linguist",
+	".tsa":                            "application/tamp-sequence-adjust",
+	".tscn":                           "application/x-godot-scene",
+	".tsd":                            "application/timestamped-data",
+	".tsi":                            "audio/tsplayer",
+	".tsp":                            "application/dsptype",
+	".tsq":                            "application/timestamp-query",
+	".tsr":                            "application/timestamp-reply",
+	".tst":                            "application/vnd.etsi.timestamp-token",
+	".tsv":                            "text/tab-separated-values",
+	".tta":                            "audio/x-tta",
+	".ttc":                            "font/collection",
+	".ttf":                            "application/octet-stream",
+	".ttl":                            "text/turtle",
+	".ttml":                           "application/ttml+xml",
+	".ttx":                            "application/x-font-ttx",
+	".ttz":                            "application/t-time",
+	".tuc":                            "application/tamp-update-confirm",
+	".tur":                            "application/tamp-update",
+	".twd":                            "application/vnd.SimTech-MindMapper",
+	".twds":                           "application/vnd.SimTech-MindMapper",
+	".twig":                           "text/x-twig",
+	".txd":                            "application/vnd.genomatix.tuxedo",
+	".txf":                            "application/vnd.Mobius.TXF",
+	".txt":                            "text/plain",
+	".txz":                            "application/x-xz-compressed-tar",
+	".tzo":                            "application/x-tzo",
+	".tzst":                           "application/x-zstd-compressed-tar",
+	".u32":                            "application/x-authorware-bin",
+	".u3d":                            "model/u3d",
+	".u8dsn":                          "message/global-delivery-status",
+	".u8hdr":                          "message/global-headers",
+	".u8mdn":                          "message/global-disposition-notification",
+	".u8msg":                          "message/global",
+	".udeb":                           "application/vnd.debian.binary-package",
+	".ufd":                            "application/vnd.ufdl",
+	".ufdl":                           "application/vnd.ufdl",
+	".ufraw":                          "application/x-ufraw",
+	".ui":                             "application/x-designer",
+	".uil":                            "text/x-uil",
+	".uis":                            "application/urc-uisocketdesc+xml",
+	".uls":                            "text/iuls",
+	".ult":                            "audio/x-mod",
+	".ulx":                            "application/x-glulx",
+	".umj":                            "application/vnd.umajin",
+	".unf":                            "application/x-nes-rom",
+	".uni":                            "audio/x-mod",
+	".unif":                           "application/x-nes-rom",
+	".unityweb":                       "application/vnd.unity",
+	".uo":                             "application/vnd.uoml+xml",
+	".uoml":                           "application/vnd.uoml+xml",
+	".upa":                            "application/vnd.hbci",
+	".uri":                            "text/uri-list",
+	".urim":                           "application/vnd.uri-map",
+	".urimap":                         "application/vnd.uri-map",
+	".uris":                           "text/uri-list",
+	".url":                            "application/x-mswinurl",
+	".urls":                           "text/uri-list",
+	".usda":                           "model/vnd.usda",
+	".usdz":                           "model/vnd.usdz+zip",
+	".ustar":                          "application/x-ustar",
+	".utz":                            "application/vnd.uiq.theme",
+	".uu":                             "application/x-uuencode",
+	".uue":                            "application/x-uuencode",
+	".uva":                            "audio/vnd.dece.audio",
+	".uvd":                            "application/vnd.dece.data",
+	".uvf":                            "application/vnd.dece.data",
+	".uvg":                            "image/vnd.dece.graphic",
+	".uvh":                            "video/vnd.dece.hd",
+	".uvi":                            "image/vnd.dece.graphic",
+	".uvm":                            "video/vnd.dece.mobile",
+	".uvp":                            "video/vnd.dece.pd",
+	".uvs":                            "video/vnd.dece.sd",
+	".uvt":                            "application/vnd.dece.ttml+xml",
+	".uvu":                            "video/vnd.dece.mp4",
+	".uvv":                            "video/vnd.dece.video",
+	".uvva":                           "audio/vnd.dece.audio",
+	".uvvd":                           "application/vnd.dece.data",
+	".uvvf":                           "application/vnd.dece.data",
+	".uvvg":                           "image/vnd.dece.graphic",
+	".uvvh":                           "video/vnd.dece.hd",
+	".uvvi":                           "image/vnd.dece.graphic",
+	".uvvm":                           "video/vnd.dece.mobile",
+	".uvvp":                           "video/vnd.dece.pd",
+	".uvvs":                           "video/vnd.dece.sd",
+	".uvvt":                           "application/vnd.dece.ttml+xml",
+	".uvvu":                           "video/vnd.dece.mp4",
+	".uvvv":                           "video/vnd.dece.video",
+	".uvvx":                           "application/vnd.dece.unspecified",
+	".uvvz":                           "application/vnd.dece.zip",
+	".uvx":                            "application/vnd.dece.unspecified",
+	".uvz":                            "application/vnd.dece.zip",
+	".v":                              "text/x-verilog",
+	".v64":                            "application/x-n64-rom",
+	".val":                            "chemical/x-ncbi-asn1-binary",
+	".vala":                           "text/x-vala",
+	".vapi":                           "text/x-vala",
+	".vb":                             "application/x-virtual-boy-rom",
+	".vbe":                            "text/vbscript",
+	".vbk":                            "audio/vnd.nortel.vbk",
+	".vbox":                           "application/vnd.previewsystems.box",
+	".vbs":                            "text/vbscript",
+	".vcard":                          "text/vcard",
+	".vcd":                            "application/x-cdlink",
+	".vcf":                            "text/x-vcard",
+	".vcg":                            "application/vnd.groove-vcard",
+	".vcj":                            "application/voucher-cms+json",
+	".vcs":                            "text/x-vcalendar",
+	".vct":                            "text/vcard",
+	".vcx":                            "application/vnd.vcx",
+	".vda":                            "image/x-tga",
+	".vdi":                            "application/x-vdi-disk",
+	".vdo":                            "video/vdo",
+	".vds":                            "model/vnd.sap.vds",
+	".ves":                            "application/vnd.ves.encrypted",
+	".vew":                            "application/vnd.lotus-approach",
+	".vfk":                            "text/vnd.exchangeable",
+	".vfr":                            "application/vnd.tml",
+	".vhd":                            "text/x-vhdl",
+	".vhdl":                           "text/x-vhdl",
+	".vhdx":                           "application/x-vhdx-disk",
+	".viaframe":                       "application/vnd.tml",
+	".vib":                            "audio/vib",
+	".vis":                            "application/vnd.visionary",
+	".viv":                            "video/vivo",
+	".vivo":                           "video/vivo",
+	".vlc":                            "audio/x-mpegurl",
+	".vmd":                            "application/vocaltec-media-desc",
+	".vmdk":                           "application/x-vmdk-disk",
+	".vmf":                            "application/vocaltec-media-file",
+	".vmi":                            "application/x-dreamcast-vms-info",
+	".vms":                            "application/x-dreamcast-vms",
+	".vmt":                            "application/vnd.valve.source.material",
+	".vob":                            "video/mpeg",
+	".voc":                            "audio/x-voc",
+	".vor":                            "application/vnd.stardivision.writer",
+	".vox":                            "audio/voxware",
+	".vpc":                            "application/x-vhd-disk",
+	".vpm":                            "multipart/voice-message",
+	".vqe":                            "audio/x-twinvq-plugin",
+	".vqf":                            "audio/x-twinvq",
+	".vql":                            "audio/x-twinvq",
+	".vre":                            "x-world/x-vream",
+	".vrm":                            "model/vrml",
+	".vrml":                           "model/vrml",
+	".vrt":                            "x-world/x-vrt",
+	".vrw":                            "x-world/x-vream",
+	".vsc":                            "application/vnd.vidsoft.vidconference",
+	".vsd":                            "application/vnd.visio",
+	".vsdm":                           "application/vnd.ms-visio.drawing.macroEnabled.main+xml",
+	".vsdx":                           "application/vnd.ms-visio.drawing.main+xml",
+	".vsf":                            "application/vnd.vsf",
+	".vss":                            "application/vnd.visio",
+	".vssm":                           "application/vnd.ms-visio.stencil.macroEnabled.main+xml",
+	".vssx":                           "application/vnd.ms-visio.stencil.main+xml",
+	".vst":                            "application/vnd.visio",
+	".vstm":                           "application/vnd.ms-visio.template.macroEnabled.main+xml",
+	".vstx":                           "application/vnd.ms-visio.template.main+xml",
+	".vsw":                            "application/vnd.visio",
+	".vtf":                            "image/vnd.valve.source.texture",
+	".vtnstd":                         "application/vnd.veritone.aion+json",
+	".vts":                            "workbook/formulaone",
+	".vtt":                            "text/vtt",
+	".vtu":                            "model/vnd.vtu",
+	".vwx":                            "application/vnd.vectorworks",
+	".vxml":                           "application/voicexml+xml",
+	".w3d":                            "application/x-director",
+	".wad":                            "application/x-doom",
+	".wadl":                           "application/vnd.sun.wadl+xml",
+	".wafl":                           "application/vnd.wasmflow.wafl",
+	".war":                            "application/x-java-archive",
+	".wasm":                           "application/wasm",
+	".wav":                            "audio/x-wav",
+	".wax":                            "audio/x-ms-wax",
+	".wb1":                            "application/x-quattropro",
+	".wb2":                            "application/x-quattropro",
+	".wb3":                            "application/x-quattropro",
+	".wbmp":                           "image/vnd.wap.wbmp",
+	".wbs":                            "application/vnd.criticaltools.wbs+xml",
+	".wbxml":                          "application/vnd.wap.wbxml",
+	".wcm":                            "application/vnd.ms-works",
+	".wdb":                            "application/vnd.ms-works",
+	".wdp":                            "image/vnd.ms-photo",
+	".web":                            "application/vnd.xara",
+	".weba":                           "audio/webm",
+	".webm":                           "video/webm",
+	".webmanifest":                    "application/manifest+json",
+	".webp":                           "image/webp",
+	".wg":                             "application/vnd.pmi.widget",
+	".wgsl":                           "text/wgsl",
+	".wgt":                            "application/widget",
+	".wi":                             "image/wavelet",
+	".wif":                            "application/watcherinfo+xml",
+	".wim":                            "application/x-ms-wim",
+	".win":                            "model/vnd.gdl",
+	".wis":                            "application/x-InstallShield",
+	".wk":                             "application/x-123",
+	".wk1":                            "application/vnd.lotus-1-2-3",
+	".wk3":                            "application/vnd.lotus-1-2-3",
+	".wk4":                            "application/vnd.lotus-1-2-3",
+	".wkdownload":                     "application/x-partial-download",
+	".wks":                            "application/vnd.ms-works",
+	".wlnk":                           "application/link-format",
+	".wm":                             "video/x-ms-wm",
+	".wma":                            "audio/x-ms-wma",
+	".wmc":                            "application/vnd.wmc",
+	".wmd":                            "application/x-ms-wmd",
+	".wmf":                            "application/x-msmetafile",
+	".wml":                            "text/vnd.wap.wml",
+	".wmlc":                           "application/vnd.wap.wmlc",
+	".wmls":                           "text/vnd.wap.wmlscript",
+	".wmlsc":                          "application/vnd.wap.wmlscriptc",
+	".wmlscript":                      "text/vnd.wap.wmlscript",
+	".wmv":                            "video/x-ms-wmv",
+	".wmx":                            "video/x-ms-wmx",
+	".wmz":                            "application/x-ms-wmz",
+	".woff":                           "font/woff",
+	".woff2":                          "font/woff2",
+	".wp":                             "application/vnd.wordperfect",
+	".wp4":                            "application/vnd.wordperfect",
+	".wp5":                            "application/vnd.wordperfect",
+	".wp6":                            "application/vnd.wordperfect",
+	".wpd":                            "application/vnd.wordperfect",
+	".wpg":                            "application/x-wpg",
+	".wpl":                            "application/vnd.ms-wpl",
+	".wpng":                           "image/x-up-wpng",
+	".wpp":                            "application/vnd.wordperfect",
+	".wps":                            "application/vnd.ms-works",
+	".wpt":                            "x-lml/x-gps",
+	".wqd":                            "application/vnd.wqd",
+	".wri":                            "application/x-mswrite",
+	".wrl":                            "model/vrml",
+	".wrz":                            "x-world/x-vrml",
+	".ws":                             "text/vnd.wap.wmlscript",
+	".wsc":                            "application/vnd.wap.wmlscriptc",
+	".wsdl":                           "application/wsdl+xml",
+	".wsf":                            "application/x-ms-wsf",
+	".wspolicy":                       "application/wspolicy+xml",
+	".wtb":                            "application/vnd.webturbo",
+	".wv":                             "video/wavelet",
+	".wvc":                            "audio/x-wavpack-correction",
+	".wvp":                            "audio/x-wavpack",
+	".wvx":                            "video/x-ms-wvx",
+	".wwf":                            "application/x-wwf",
+	".wxl":                            "application/x-wxl",
+	".wz":                             "application/x-wingz",
+	".x-gzip":                         "application/x-gzip",
+	".x32":                            "application/x-authorware-bin",
+	".x3d":                            "model/x3d+xml",
+	".x3db":                           "model/x3d+fastinfoset",
+	".x3dbz":                          "model/x3d+binary",
+	".x3dv":                           "model/x3d-vrml",
+	".x3dvz":                          "model/x3d-vrml",
+	".x3dz":                           "model/x3d+xml",
+	".x3f":                            "image/x-sigma-x3f",
+	".x_b":                            "model/vnd.parasolid.transmit.binary",
+	".x_t":                            "model/vnd.parasolid.transmit.text",
+	".xac":                            "application/x-gnucash",
+	".xaf":                            "x-world/x-vrml",
+	".xaml":                           "application/xaml+xml",
+	".xap":                            "application/x-silverlight-app",
+	".xar":                            "application/vnd.xara",
+	".xav":                            "application/xcap-att+xml",
+	".xbap":                           "application/x-ms-xbap",
+	".xbd":                            "application/vnd.fujixerox.docuworks.binder",
+	".xbel":                           "application/x-xbel",
+	".xbl":                            "application/xml",
+	".xbm":                            "image/x-xbitmap",
+	".xca":                            "application/xcap-caps+xml",
+	".xcf":                            "image/x-xcf",
+	".xcf.bz2":                        "image/x-compressed-xcf",
+	".xcf.gz":                         "image/x-compressed-xcf",
+	".xcos":                           "application/x-scilab-xcos",
+	".xcs":                            "application/calendar+xml",
+	".xct":                            "application/vnd.fujixerox.docuworks.container",
+	".xdd":                            "application/bacnet-xdd+zip",
+	".xdf":                            "application/xcap-diff+xml",
+	".xdgapp":                         "application/vnd.flatpak",
+	".xdm":                            "application/x-xdma",
+	".xdma":                           "application/x-xdma",
+	".xdp":                            "application/vnd.adobe.xdp+xml",
+	".xdssc":                          "application/dssc+xml",
+	".xdw":                            "application/vnd.fujixerox.docuworks",
+	".xel":                            "application/xcap-el+xml",
+	".xenc":                           "application/xenc+xml",
+	".xer":                            "application/xcap-error+xml",
+	".xfd":                            "application/vnd.xfdl",
+	".xfdf":                           "application/xfdf",
+	".xfdl":                           "application/vnd.xfdl",
+	".xhe":                            "audio/usac",
+	".xht":                            "application/xhtml+xml",
+	".xhtm":                           "application/xhtml+xml",
+	".xhtml":                          "application/xhtml+xml",
+	".xhvml":                          "application/xv+xml",
+	".xi":                             "audio/x-xi",
+	".xif":                            "image/vnd.xiff",
+	".xla":                            "application/vnd.ms-excel",
+	".xlam":                           "application/vnd.ms-excel.addin.macroEnabled.12",
+	".xlc":                            "application/vnd.ms-excel",
+	".xld":                            "application/vnd.ms-excel",
+	".xlf":                            "application/xliff+xml",
+	".xliff":                          "application/xliff+xml",
+	".xlim":                           "application/vnd.xmpie.xlim",
+	".xll":                            "application/x-excel",
+	".xlm":                            "application/vnd.ms-excel",
+	".xlr":                            "application/vnd.ms-works",
+	".xls":                            "application/vnd.ms-excel",
+	".xlsb":                           "application/vnd.ms-excel.sheet.binary.macroEnabled.12",
+	".xlsm":                           "application/vnd.ms-excel.sheet.macroEnabled.12",
+	".xlsx":                           "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".xlt":                            "application/vnd.ms-excel",
+	".xltm":                           "application/vnd.ms-excel.template.macroEnabled.12",
+	".xltx":                           "application/vnd.openxmlformats-officedocument.spreadsheetml.template",
+	".xlw":                            "application/vnd.ms-excel",
+	".xm":                             "audio/x-mod",
+	".xmf":                            "audio/x-xmf",
+	".xmi":                            "text/x-xmi",
+	".xml":                            "application/xml",
+	".xmls":                           "application/dskpp+xml",
+	".xmt_bin":                        "model/vnd.parasolid.transmit.binary",
+	".xmt_txt":                        "model/vnd.parasolid.transmit.text",
+	".xmz":                            "audio/x-mod",
+	".xns":                            "application/xcap-ns+xml",
+	".xo":                             "application/vnd.olpc-sugar",
+	".xodp":                           "application/vnd.collabio.xodocuments.presentation",
+	".xods":                           "application/vnd.collabio.xodocuments.spreadsheet",
+	".xodt":                           "application/vnd.collabio.xodocuments.document",
+	".xof":                            "x-world/x-vrml",
+	".xop":                            "application/xop+xml",
+	".xotp":                           "application/vnd.collabio.xodocuments.presentation-template",
+	".xots":                           "application/vnd.collabio.xodocuments.spreadsheet-template",
+	".xott":                           "application/vnd.collabio.xodocuments.document-template",
+	".xpak":                           "application/vnd.gentoo.xpak",
+	".xpi":                            "application/x-xpinstall",
+	".xpl":                            "application/xproc+xml",
+	".xpm":                            "image/x-xpixmap",
+	".xpr":                            "application/vnd.is-xpr",
+	".xps":                            "application/vnd.ms-xpsdocument",
+	".xpw":                            "application/vnd.intercon.formnet",
+	".xpx":                            "application/vnd.intercon.formnet",
+	".xsd":                            "application/xml",
+	".xsf":                            "application/prs.xsf+xml",
+	".xsit":                           "text/xml",
+	".xsl":                            "application/xml",
+	".xslfo":                          "text/x-xslfo",
+	".xslt":                           "application/xslt+xml",
+	".xsm":                            "application/vnd.syncml+xml",
+	".xspf":                           "application/xspf+xml",
+	".xtel":                           "chemical/x-xtel",
+	".xul":                            "application/vnd.mozilla.xul+xml",
+	".xvm":                            "application/xv+xml",
+	".xvml":                           "application/xv+xml",
+	".xwd":                            "image/x-xwindowdump",
+	".xyz":                            "chemical/x-xyz",
+	".xyze":                           "image/vnd.radiance",
+	".xz":                             "application/x-xz",
+	".yaml":                           "application/x-yaml",
+	".yang":                           "application/yang",
+	".yin":                            "application/yin+xml",
+	".yme":                            "application/vnd.yaoweme",
+	".yml":                            "application/x-yaml",
+	".yt":                             "video/vnd.youtube.yt",
+	".yz1":                            "application/x-yz1",
+	".z":                              "application/x-compress",
+	".z1":                             "application/x-zmachine",
+	".z2":                             "application/x-zmachine",
+	".z3":                             "application/x-zmachine",
+	".z4":                             "application/x-zmachine",
+	".z5":                             "application/x-zmachine",
+	".z6":                             "application/x-zmachine",
+	".z64":                            "application/x-n64-rom",
+	".z7":                             "application/x-zmachine",
+	".z8":                             "application/x-zmachine",
+	".zabw":                           "application/x-abiword",
+	".zac":                            "application/x-zaurus-zac",
+	".zaz":                            "application/vnd.zzazz.deck+xml",
+	".zfc":                            "application/vnd.filmit.zfc",
+	".zfo":                            "application/vnd.software602.filler.form-xml-zip",
+	".zim":                            "application/x-openzim",
+	".zip":                            "application/zip",
+	".zipx":                           "application/zip",
+	".zir":                            "application/vnd.zul",
+	".zirz":                           "application/vnd.zul",
+	".zmm":                            "application/vnd.HandHeld-Entertainment+xml",
+	".zmt":                            "chemical/x-mopac-input",
+	".zone":                           "text/dns",
+	".zoo":                            "application/x-zoo",
+	".zsav":                           "application/x-spss-sav",
+	".zst":                            "application/zstd",
+	".zz":                             "application/zlib",
+	".~":                              "application/x-trash",
+}
+
+// extSource names the source each entry of extToMimeType was taken from.
+var extSource = map[string]string{
+	".123":                            "media-types",
+	".1905.1":                         "media-types",
+	".1clr":                           "media-types",
+	".1km":                            "media-types",
+	".210":                            "media-types",
+	".323":                            "legacy",
+	".32x":                            "shared-mime-info",
+	".3dm":                            "media-types",
+	".3dml":                           "media-types",
+	".3ds":                            "shared-mime-info",
+	".3dsx":                           "shared-mime-info",
+	".3g2":                            "shared-mime-info",
+	".3ga":                            "shared-mime-info",
+	".3gp":                            "legacy",
+	".3gp2":                           "shared-mime-info",
+	".3gpp":                           "shared-mime-info",
+	".3gpp2":                          "shared-mime-info",
+	".3mf":                            "media-types",
+	".3tz":                            "media-types",
+	".602":                            "shared-mime-info",
+	".669":                            "shared-mime-info",
+	".726":                            "media-types",
+	".7z":                             "media-types",
+	".7z.001":                         "shared-mime-info",
+	".a":                              "media-types",
+	".a26":                            "shared-mime-info",
+	".a2l":                            "media-types",
+	".a78":                            "shared-mime-info",
+	".aa":                             "shared-mime-info",
+	".aa3":                            "media-types",
+	".aab":                            "legacy",
+	".aac":                            "media-types",
+	".aal":                            "media-types",
+	".aam":                            "legacy",
+	".aas":                            "legacy",
+	".aax":                            "shared-mime-info",
+	".abc":                            "media-types",
+	".abw":                            "media-types",
+	".abw.crashed":                    "shared-mime-info",
+	".abw.gz":                         "shared-mime-info",
+	".ac":                             "media-types",
+	".ac2":                            "media-types",
+	".ac3":                            "media-types",
+	".acc":                            "media-types",
+	".ace":                            "shared-mime-info",
+	".acn":                            "media-types",
+	".acu":                            "media-types",
+	".acutc":                          "media-types",
+	".acx":                            "legacy",
+	".adb":                            "shared-mime-info",
+	".adf":                            "shared-mime-info",
+	".adp":                            "apache",
+	".ads":                            "shared-mime-info",
+	".adts":                           "media-types",
+	".aep":                            "media-types",
+	".afm":                            "shared-mime-info",
+	".afp":                            "media-types",
+	".ag":                             "shared-mime-info",
+	".agb":                            "shared-mime-info",
+	".age":                            "media-types",
+	".ahead":                          "media-types",
+	".ai":                             "legacy",
+	".aif":                            "legacy",
+	".aifc":                           "legacy",
+	".aiff":                           "legacy",
+	".aiffc":                          "shared-mime-info",
+	".aion":                           "media-types",
+	".air":                            "apache",
+	".ait":                            "media-types",
+	".al":                             "shared-mime-info",
+	".alc":                            "media-types",
+	".als":                            "legacy",
+	".alz":                            "shared-mime-info",
+	".amc":                            "legacy",
+	".ami":                            "media-types",
+	".aml":                            "media-types",
+	".amlx":                           "media-types",
+	".amr":                            "media-types",
+	".amz":                            "shared-mime-info",
+	".ani":                            "legacy",
+	".anx":                            "media-types",
+	".ape":                            "shared-mime-info",
+	".apex":                           "media-types",
+	".apexlang":                       "media-types",
+	".apk":                            "legacy",
+	".apkg":                           "media-types",
+	".apng":                           "media-types",
+	".appcache":                       "media-types",
+	".appimage":                       "shared-mime-info",
+	".application":                    "apache",
+	".apr":                            "media-types",
+	".apxml":                          "media-types",
+	".ar":                             "shared-mime-info",
+	".arc":                            "apache",
+	".arj":                            "shared-mime-info",
+	".arrow":                          "media-types",
+	".arrows":                         "media-types",
+	".art":                            "media-types",
+	".artisan":                        "media-types",
+	".arw":                            "shared-mime-info",
+	".as":                             "shared-mime-info",
+	".asar":                           "shared-mime-info",
+	".asc":                            "legacy",
+	".ascii":                          "media-types",
+	".asd":                            "legacy",
+	".asf":                            "legacy",
+	".asice":                          "media-types",
+	".asics":                          "media-types",
+	".asm":                            "apache",
+	".asn":                            "legacy",
+	".aso":                            "media-types",
+	".asp":                            "legacy",
+	".asr":                            "legacy",
+	".ass":                            "media-types",
+	".astc":                           "shared-mime-info",
+	".asx":                            "legacy",
+	".at3":                            "media-types",
+	".atc":                            "media-types",
+	".atf":                            "media-types",
+	".atfx":                           "media-types",
+	".atom":                           "legacy",
+	".atomcat":                        "media-types",
+	".atomdeleted":                    "media-types",
+	".atomsrv":                        "media-types",
+	".atomsvc":                        "media-types",
+	".atx":                            "media-types",
+	".atxml":                          "media-types",
+	".au":                             "legacy",
+	".auc":                            "media-types",
+	".automount":                      "shared-mime-info",
+	".avb":                            "legacy",
+	".avci":                           "media-types",
+	".avcs":                           "media-types",
+	".avf":                            "shared-mime-info",
+	".avi":                            "legacy",
+	".avif":                           "media-types",
+	".avifs":                          "shared-mime-info",
+	".aw":                             "shared-mime-info",
+	".awb":                            "legacy",
+	".awk":                            "shared-mime-info",
+	".axa":                            "media-types",
+	".axs":                            "legacy",
+	".axv":                            "media-types",
+	".azf":                            "media-types",
+	".azs":                            "media-types",
+	".azv":                            "media-types",
+	".azw":                            "apache",
+	".azw3":                           "media-types",
+	".b":                              "media-types",
+	".b16":                            "media-types",
+	".bak":                            "media-types",
+	".bar":                            "media-types",
+	".bas":                            "legacy",
+	".bat":                            "overrides",
+	".bcpio":                          "legacy",
+	".bdf":                            "shared-mime-info",
+	".bdm":                            "media-types",
+	".bdmv":                           "shared-mime-info",
+	".bed":                            "media-types",
+	".bh2":                            "media-types",
+	".bib":                            "media-types",
+	".bik":                            "media-types",
+	".bin":                            "legacy",
+	".bk2":                            "media-types",
+	".bkm":                            "media-types",
+	".blb":                            "apache",
+	".bld":                            "legacy",
+	".bld2":                           "legacy",
+	".blend":                          "shared-mime-info",
+	".blender":                        "shared-mime-info",
+	".blorb":                          "apache",
+	".bmed":                           "media-types",
+	".bmi":                            "media-types",
+	".bmml":                           "media-types",
+	".bmp":                            "legacy",
+	".bmpr":                           "media-types",
+	".boo":                            "media-types",
+	".book":                           "media-types",
+	".box":                            "media-types",
+	".boz":                            "apache",
+	".bpd":                            "media-types",
+	".bpk":                            "legacy",
+	".bps":                            "shared-mime-info",
+	".brf":                            "media-types",
+	".bsd":                            "media-types",
+	".bsdiff":                         "shared-mime-info",
+	".bsp":                            "media-types",
+	".btf":                            "media-types",
+	".btif":                           "media-types",
+	".bz":                             "shared-mime-info",
+	".bz2":                            "legacy",
+	".c":                              "legacy",
+	".c++":                            "media-types",
+	".c11amc":                         "media-types",
+	".c11amz":                         "media-types",
+	".c3d":                            "media-types",
+	".c3ex":                           "media-types",
+	".c4d":                            "media-types",
+	".c4f":                            "media-types",
+	".c4g":                            "media-types",
+	".c4p":                            "media-types",
+	".c4u":                            "media-types",
+	".c9r":                            "media-types",
+	".c9s":                            "media-types",
+	".cab":                            "media-types",
+	".cac":                            "media-types",
+	".cache":                          "media-types",
+	".caf":                            "apache",
+	".cal":                            "legacy",
+	".cap":                            "media-types",
+	".car":                            "media-types",
+	".carjson":                        "media-types",
+	".cascii":                         "media-types",
+	".cat":                            "legacy",
+	".cb7":                            "shared-mime-info",
+	".cba":                            "apache",
+	".cbin":                           "media-types",
+	".cbl":                            "shared-mime-info",
+	".cbor":                           "media-types",
+	".cbr":                            "media-types",
+	".cbt":                            "shared-mime-info",
+	".cbz":                            "media-types",
+	".cc":                             "media-types",
+	".ccc":                            "media-types",
+	".cci":                            "shared-mime-info",
+	".ccmp":                           "media-types",
+	".ccmx":                           "shared-mime-info",
+	".ccn":                            "legacy",
+	".cco":                            "legacy",
+	".cct":                            "apache",
+	".ccxml":                          "media-types",
+	".cda":                            "media-types",
+	".cdbcmsg":                        "media-types",
+	".cdf":                            "legacy",
+	".cdfx":                           "media-types",
+	".cdi":                            "shared-mime-info",
+	".cdkey":                          "media-types",
+	".cdmia":                          "media-types",
+	".cdmic":                          "media-types",
+	".cdmid":                          "media-types",
+	".cdmio":                          "media-types",
+	".cdmiq":                          "media-types",
+	".cdr":                            "media-types",
+	".cdt":                            "media-types",
+	".cdx":                            "media-types",
+	".cdxml":                          "media-types",
+	".cdy":                            "media-types",
+	".cea":                            "media-types",
+	".cef":                            "media-types",
+	".cellml":                         "media-types",
+	".cer":                            "legacy",
+	".cert":                           "shared-mime-info",
+	".cfs":                            "apache",
+	".cgb":                            "shared-mime-info",
+	".cgi":                            "legacy",
+	".cgm":                            "legacy",
+	".chat":                           "legacy",
+	".chd":                            "shared-mime-info",
+	".chm":                            "media-types",
+	".chrt":                           "legacy",
+	".cif":                            "media-types",
+	".cii":                            "media-types",
+	".cil":                            "media-types",
+	".cl":                             "media-types",
+	".cla":                            "media-types",
+	".class":                          "legacy",
+	".cld":                            "media-types",
+	".clkk":                           "media-types",
+	".clkp":                           "media-types",
+	".clkt":                           "media-types",
+	".clkw":                           "media-types",
+	".clkx":                           "media-types",
+	".clp":                            "legacy",
+	".clpi":                           "shared-mime-info",
+	".cls":                            "media-types",
+	".clue":                           "media-types",
+	".cmake":                          "shared-mime-info",
+	".cmc":                            "media-types",
+	".cmd":                            "overrides",
+	".cmdf":                           "media-types",
+	".cml":                            "media-types",
+	".cmp":                            "media-types",
+	".cmsc":                           "media-types",
+	".cmx":                            "legacy",
+	".cnd":                            "media-types",
+	".co":                             "legacy",
+	".cob":                            "shared-mime-info",
+	".cod":                            "legacy",
+	".coffee":                         "media-types",
+	".com":                            "overrides",
+	".conf":                           "legacy",
+	".copyright":                      "media-types",
+	".coswid":                         "media-types",
+	".cpa":                            "media-types",
+	".cpi":                            "shared-mime-info",
+	".cpio":                           "legacy",
+	".cpio.gz":                        "shared-mime-info",
+	".cpkg":                           "media-types",
+	".cpl":                            "media-types",
+	".cpp":                            "legacy",
+	".cpt":                            "legacy",
+	".cql":                            "media-types",
+	".cr":                             "shared-mime-info",
+	".cr2":                            "media-types",
+	".cr3":                            "shared-mime-info",
+	".crd":                            "legacy",
+	".crdownload":                     "shared-mime-info",
+	".crl":                            "legacy",
+	".crt":                            "legacy",
+	".crtr":                           "media-types",
+	".crw":                            "media-types",
+	".cryptomator":                    "media-types",
+	".cryptonote":                     "media-types",
+	".cs":                             "shared-mime-info",
+	".csd":                            "media-types",
+	".csf":                            "media-types",
+	".csh":                            "legacy",
+	".csl":                            "media-types",
+	".csm":                            "legacy",
+	".csml":                           "legacy",
+	".cso":                            "shared-mime-info",
+	".csp":                            "media-types",
+	".csrattrs":                       "media-types",
+	".css":                            "legacy",
+	".cst":                            "media-types",
+	".csv":                            "media-types",
+	".csvs":                           "media-types",
+	".ctab":                           "media-types",
+	".ctx":                            "media-types",
+	".cu":                             "media-types",
+	".cub":                            "media-types",
+	".cuc":                            "media-types",
+	".cue":                            "shared-mime-info",
+	".cur":                            "legacy",
+	".curl":                           "media-types",
+	".cw":                             "media-types",
+	".cwk":                            "shared-mime-info",
+	".cwl":                            "media-types",
+	".cwl.json":                       "media-types",
+	".cww":                            "media-types",
+	".cxf":                            "media-types",
+	".cxt":                            "apache",
+	".cxx":                            "media-types",
+	".d":                              "media-types",
+	".dae":                            "media-types",
+	".daf":                            "media-types",
+	".dar":                            "shared-mime-info",
+	".dart":                           "media-types",
+	".dataless":                       "media-types",
+	".davmount":                       "media-types",
+	".dbf":                            "media-types",
+	".dbk":                            "shared-mime-info",
+	".dcd":                            "media-types",
+	".dcl":                            "shared-mime-info",
+	".dcm":                            "legacy",
+	".dcr":                            "legacy",
+	".dcurl":                          "apache",
+	".dcx":                            "legacy",
+	".dd2":                            "media-types",
+	".ddd":                            "media-types",
+	".ddeb":                           "media-types",
+	".ddf":                            "media-types",
+	".dds":                            "shared-mime-info",
+	".deb":                            "media-types",
+	".def":                            "apache",
+	".deploy":                         "media-types",
+	".der":                            "legacy",
+	".desktop":                        "shared-mime-info",
+	".device":                         "shared-mime-info",
+	".dfac":                           "media-types",
+	".dff":                            "shared-mime-info",
+	".dgc":                            "apache",
+	".dhtml":                          "legacy",
+	".di":                             "shared-mime-info",
+	".dia":                            "shared-mime-info",
+	".dib":                            "shared-mime-info",
+	".dic":                            "apache",
+	".dif":                            "legacy",
+	".diff":                           "media-types",
+	".dii":                            "media-types",
+	".dim":                            "media-types",
+	".dir":                            "legacy",
+	".dis":                            "media-types",
+	".disposition-notification":       "apache",
+	".dist":                           "media-types",
+	".distz":                          "media-types",
+	".dit":                            "media-types",
+	".dive":                           "media-types",
+	".divx":                           "shared-mime-info",
+	".djv":                            "legacy",
+	".djvu":                           "legacy",
+	".dl":                             "media-types",
+	".dll":                            "overrides",
+	".dls":                            "media-types",
+	".dmg":                            "legacy",
+	".dmp":                            "media-types",
+	".dms":                            "legacy",
+	".dna":                            "media-types",
+	".dng":                            "shared-mime-info",
+	".doc":                            "legacy",
+	".docbook":                        "shared-mime-info",
+	".docjson":                        "media-types",
+	".docm":                           "legacy",
+	".docx":                           "legacy",
+	".dor":                            "media-types",
+	".dot":                            "legacy",
+	".dotm":                           "legacy",
+	".dotx":                           "legacy",
+	".dp":                             "media-types",
+	".dpg":                            "media-types",
+	".dpgraph":                        "media-types",
+	".dpkg":                           "media-types",
+	".dpx":                            "media-types",
+	".dra":                            "apache",
+	".drle":                           "media-types",
+	".dsc":                            "media-types",
+	".dsf":                            "shared-mime-info",
+	".dsl":                            "shared-mime-info",
+	".dsm":                            "media-types",
+	".dssc":                           "media-types",
+	".dtb":                            "apache",
+	".dtd":                            "legacy",
+	".dts":                            "media-types",
+	".dtshd":                          "media-types",
+	".dtx":                            "shared-mime-info",
+	".dump":                           "apache",
+	".dv":                             "legacy",
+	".dvb":                            "media-types",
+	".dvc":                            "media-types",
+	".dvi":                            "legacy",
+	".dvi.bz2":                        "shared-mime-info",
+	".dvi.gz":                         "shared-mime-info",
+	".dwd":                            "media-types",
+	".dwf":                            "legacy",
+	".dwg":                            "legacy",
+	".dx":                             "media-types",
+	".dxf":                            "legacy",
+	".dxp":                            "media-types",
+	".dxr":                            "legacy",
+	".dzr":                            "media-types",
+	".e":                              "shared-mime-info",
+	".ear":                            "apache",
+	".ebk":                            "legacy",
+	".ebuild":                         "media-types",
+	".ecelp4800":                      "media-types",
+	".ecelp7470":                      "media-types",
+	".ecelp9600":                      "media-types",
+	".ecig":                           "media-types",
+	".ecigprofile":                    "media-types",
+	".ecigtheme":                      "media-types",
+	".eclass":                         "media-types",
+	".ecma":                           "apache",
+	".edm":                            "media-types",
+	".edx":                            "media-types",
+	".efi":                            "overrides",
+	".efif":                           "media-types",
+	".egon":                           "shared-mime-info",
+	".ei6":                            "media-types",
+	".eif":                            "shared-mime-info",
+	".el":                             "shared-mime-info",
+	".elc":                            "apache",
+	".eln":                            "media-types",
+	".emb":                            "legacy",
+	".embl":                           "legacy",
+	".emf":                            "media-types",
+	".eml":                            "media-types",
+	".emm":                            "media-types",
+	".emma":                           "media-types",
+	".emotionml":                      "media-types",
+	".emp":                            "shared-mime-info",
+	".emz":                            "apache",
+	".ent":                            "media-types",
+	".entity":                         "media-types",
+	".enw":                            "media-types",
+	".eol":                            "media-types",
+	".eot":                            "media-types",
+	".ep":                             "media-types",
+	".eps":                            "legacy",
+	".eps.bz2":                        "shared-mime-info",
+	".eps.gz":                         "shared-mime-info",
+	".eps2":                           "media-types",
+	".eps3":                           "media-types",
+	".epsf":                           "media-types",
+	".epsf.bz2":                       "shared-mime-info",
+	".epsf.gz":                        "shared-mime-info",
+	".epsi":                           "media-types",
+	".epsi.bz2":                       "shared-mime-info",
+	".epsi.gz":                        "shared-mime-info",
+	".epub":                           "legacy",
+	".erf":                            "media-types",
+	".eri":                            "legacy",
+	".erl":                            "shared-mime-info",
+	".es":                             "legacy",
+	".es3":                            "media-types",
+	".esa":                            "media-types",
+	".escn":                           "shared-mime-info",
+	".esf":                            "media-types",
+	".esl":                            "legacy",
+	".espass":                         "media-types",
+	".et3":                            "media-types",
+	".etc":                            "legacy",
+	".etheme":                         "shared-mime-info",
+	".etx":                            "legacy",
+	".eva":                            "apache",
+	".evb":                            "media-types",
+	".evc":                            "media-types",
+	".evm":                            "legacy",
+	".evw":                            "media-types",
+	".evy":                            "legacy",
+	".ex":                             "shared-mime-info",
+	".exe":                            "overrides",
+	".exi":                            "media-types",
+	".exp":                            "media-types",
+	".exr":                            "media-types",
+	".exs":                            "shared-mime-info",
+	".ext":                            "media-types",
+	".ez":                             "legacy",
+	".ez2":                            "media-types",
+	".ez3":                            "media-types",
+	".f":                              "shared-mime-info",
+	".f4a":                            "shared-mime-info",
+	".f4b":                            "shared-mime-info",
+	".f4v":                            "shared-mime-info",
+	".f77":                            "apache",
+	".f90":                            "shared-mime-info",
+	".f95":                            "shared-mime-info",
+	".fasl":                           "shared-mime-info",
+	".fb":                             "media-types",
+	".fb2":                            "shared-mime-info",
+	".fb2.zip":                        "shared-mime-info",
+	".fbdoc":                          "media-types",
+	".fbs":                            "media-types",
+	".fcdt":                           "media-types",
+	".fch":                            "media-types",
+	".fchk":                           "media-types",
+	".fcs":                            "media-types",
+	".fd":                             "shared-mime-info",
+	".fdf":                            "media-types",
+	".fds":                            "shared-mime-info",
+	".fdt":                            "media-types",
+	".fe_launch":                      "media-types",
+	".feature":                        "shared-mime-info",
+	".fg5":                            "media-types",
+	".fgd":                            "apache",
+	".fh":                             "apache",
+	".fh4":                            "legacy",
+	".fh5":                            "legacy",
+	".fh7":                            "apache",
+	".fhc":                            "legacy",
+	".fif":                            "legacy",
+	".fig":                            "media-types",
+	".finf":                           "media-types",
+	".fit":                            "media-types",
+	".fits":                           "media-types",
+	".fl":                             "shared-mime-info",
+	".fla":                            "media-types",
+	".flac":                           "media-types",
+	".flatpak":                        "shared-mime-info",
+	".flatpakref":                     "shared-mime-info",
+	".flatpakrepo":                    "shared-mime-info",
+	".flb":                            "media-types",
+	".flc":                            "shared-mime-info",
+	".fli":                            "media-types",
+	".flo":                            "media-types",
+	".flr":                            "legacy",
+	".flt":                            "media-types",
+	".flv":                            "legacy",
+	".flw":                            "media-types",
+	".flx":                            "media-types",
+	".fly":                            "media-types",
+	".fm":                             "legacy",
+	".fnc":                            "apache",
+	".fo":                             "media-types",
+	".fodg":                           "shared-mime-info",
+	".fodp":                           "shared-mime-info",
+	".fods":                           "shared-mime-info",
+	".fodt":                           "shared-mime-info",
+	".for":                            "shared-mime-info",
+	".fpx":                            "legacy",
+	".frame":                          "media-types",
+	".frm":                            "media-types",
+	".fsc":                            "media-types",
+	".fst":                            "media-types",
+	".ftc":                            "media-types",
+	".fti":                            "media-types",
+	".fts":                            "media-types",
+	".fvi":                            "legacy",
+	".fvt":                            "media-types",
+	".fxm":                            "shared-mime-info",
+	".fxp":                            "media-types",
+	".fxpl":                           "media-types",
+	".fzs":                            "media-types",
+	".g2w":                            "media-types",
+	".g3":                             "shared-mime-info",
+	".g3w":                            "media-types",
+	".gac":                            "media-types",
+	".gal":                            "media-types",
+	".gam":                            "media-types",
+	".gamin":                          "media-types",
+	".gan":                            "media-types",
+	".gau":                            "legacy",
+	".gb":                             "shared-mime-info",
+	".gba":                            "shared-mime-info",
+	".gbc":                            "shared-mime-info",
+	".gbr":                            "media-types",
+	".gca":                            "legacy",
+	".gcd":                            "media-types",
+	".gcf":                            "media-types",
+	".gcg":                            "media-types",
+	".gcode":                          "shared-mime-info",
+	".gcrd":                           "shared-mime-info",
+	".gd":                             "shared-mime-info",
+	".gdb":                            "legacy",
+	".gdi":                            "shared-mime-info",
+	".gdl":                            "media-types",
+	".gdshader":                       "shared-mime-info",
+	".gdz":                            "media-types",
+	".ged":                            "media-types",
+	".gedcom":                         "shared-mime-info",
+	".gem":                            "shared-mime-info",
+	".gen":                            "media-types",
+	".genozip":                        "media-types",
+	".geo":                            "media-types",
+	".geo.json":                       "shared-mime-info",
+	".geojson":                        "media-types",
+	".gex":                            "media-types",
+	".gf":                             "media-types",
+	".gff3":                           "media-types",
+	".gg":                             "shared-mime-info",
+	".ggb":                            "media-types",
+	".ggs":                            "media-types",
+	".ggt":                            "media-types",
+	".ghf":                            "media-types",
+	".gif":                            "legacy",
+	".gih":                            "shared-mime-info",
+	".gim":                            "media-types",
+	".gjc":                            "media-types",
+	".gjf":                            "media-types",
+	".gl":                             "media-types",
+	".glade":                          "shared-mime-info",
+	".glb":                            "media-types",
+	".glbin":                          "media-types",
+	".glbuf":                          "media-types",
+	".gltf":                           "media-types",
+	".gml":                            "media-types",
+	".gmo":                            "shared-mime-info",
+	".gmx":                            "apache",
+	".gnc":                            "shared-mime-info",
+	".gnd":                            "shared-mime-info",
+	".gnucash":                        "shared-mime-info",
+	".gnumeric":                       "media-types",
+	".gnuplot":                        "shared-mime-info",
+	".go":                             "shared-mime-info",
+	".gp":                             "shared-mime-info",
+	".gpg":                            "shared-mime-info",
+	".gph":                            "media-types",
+	".gpkg":                           "media-types",
+	".gpkg.tar":                       "media-types",
+	".gplt":                           "shared-mime-info",
+	".gps":                            "legacy",
+	".gpt":                            "media-types",
+	".gpx":                            "shared-mime-info",
+	".gqf":                            "media-types",
+	".gqs":                            "media-types",
+	".gra":                            "shared-mime-info",
+	".gradle":                         "shared-mime-info",
+	".gram":                           "legacy",
+	".gramps":                         "apache",
+	".grd":                            "media-types",
+	".gre":                            "media-types",
+	".groovy":                         "shared-mime-info",
+	".grv":                            "media-types",
+	".grxml":                          "legacy",
+	".gsf":                            "media-types",
+	".gsh":                            "shared-mime-info",
+	".gsheet":                         "media-types",
+	".gsm":                            "media-types",
+	".gtar":                           "legacy",
+	".gtm":                            "media-types",
+	".gtw":                            "media-types",
+	".gv":                             "media-types",
+	".gvp":                            "shared-mime-info",
+	".gvy":                            "shared-mime-info",
+	".gx":                             "shared-mime-info",
+	".gxf":                            "apache",
+	".gxt":                            "media-types",
+	".gy":                             "shared-mime-info",
+	".gz":                             "legacy",
+	".h":                              "legacy",
+	".h++":                            "media-types",
+	".h261":                           "apache",
+	".h263":                           "apache",
+	".h264":                           "apache",
+	".h4":                             "shared-mime-info",
+	".h5":                             "shared-mime-info",
+	".hal":                            "media-types",
+	".hans":                           "media-types",
+	".hbc":                            "media-types",
+	".hbci":                           "media-types",
+	".hdf":                            "legacy",
+	".hdf4":                           "shared-mime-info",
+	".hdf5":                           "shared-mime-info",
+	".hdm":                            "legacy",
+	".hdml":                           "legacy",
+	".hdr":                            "media-types",
+	".hdt":                            "media-types",
+	".heic":                           "media-types",
+	".heics":                          "media-types",
+	".heif":                           "media-types",
+	".heifs":                          "media-types",
+	".hej2":                           "media-types",
+	".held":                           "media-types",
+	".hfe":                            "shared-mime-info",
+	".hgl":                            "media-types",
+	".hh":                             "media-types",
+	".hif":                            "media-types",
+	".hin":                            "media-types",
+	".hlp":                            "legacy",
+	".hp":                             "shared-mime-info",
+	".hpgl":                           "media-types",
+	".hpi":                            "media-types",
+	".hpid":                           "media-types",
+	".hpp":                            "media-types",
+	".hps":                            "media-types",
+	".hpub":                           "media-types",
+	".hqx":                            "legacy",
+	".hs":                             "media-types",
+	".hsj2":                           "media-types",
+	".hta":                            "legacy",
+	".htc":                            "legacy",
+	".htke":                           "media-types",
+	".htm":                            "legacy",
+	".html":                           "legacy",
+	".hts":                            "legacy",
+	".htt":                            "legacy",
+	".hvd":                            "media-types",
+	".hvp":                            "media-types",
+	".hvs":                            "media-types",
+	".hwp":                            "media-types",
+	".hwt":                            "shared-mime-info",
+	".hxx":                            "media-types",
+	".i2g":                            "media-types",
+	".ic0":                            "media-types",
+	".ic1":                            "media-types",
+	".ic2":                            "media-types",
+	".ic3":                            "media-types",
+	".ic4":                            "media-types",
+	".ic5":                            "media-types",
+	".ic6":                            "media-types",
+	".ic7":                            "media-types",
+	".ic8":                            "media-types",
+	".ica":                            "media-types",
+	".icb":                            "shared-mime-info",
+	".icc":                            "media-types",
+	".icd":                            "media-types",
+	".ice":                            "legacy",
+	".icf":                            "media-types",
+	".icm":                            "media-types",
+	".icns":                           "shared-mime-info",
+	".ico":                            "legacy",
+	".ics":                            "legacy",
+	".idl":                            "shared-mime-info",
+	".ief":                            "legacy",
+	".ifb":                            "legacy",
+	".ifc":                            "media-types",
+	".iff":                            "shared-mime-info",
+	".ifm":                            "legacy",
+	".ifs":                            "legacy",
+	".iges":                           "legacy",
+	".igl":                            "media-types",
+	".igm":                            "media-types",
+	".ign":                            "media-types",
+	".ignition":                       "media-types",
+	".igs":                            "legacy",
+	".igx":                            "media-types",
+	".iif":                            "media-types",
+	".iii":                            "legacy",
+	".ilbm":                           "shared-mime-info",
+	".ime":                            "shared-mime-info",
+	".imf":                            "media-types",
+	".img":                            "shared-mime-info",
+	".img.xz":                         "shared-mime-info",
+	".imgcal":                         "media-types",
+	".imi":                            "media-types",
+	".imp":                            "media-types",
+	".ims":                            "media-types",
+	".imscc":                          "media-types",
+	".imy":                            "legacy",
+	".in":                             "apache",
+	".info":                           "media-types",
+	".ini":                            "apache",
+	".ink":                            "media-types",
+	".inkml":                          "media-types",
+	".inp":                            "media-types",
+	".ins":                            "legacy",
+	".install":                        "apache",
+	".iota":                           "media-types",
+	".ipfix":                          "media-types",
+	".ipk":                            "media-types",
+	".ips":                            "legacy",
+	".iptables":                       "shared-mime-info",
+	".ipx":                            "legacy",
+	".ipynb":                          "shared-mime-info",
+	".irm":                            "media-types",
+	".irp":                            "media-types",
+	".ism":                            "media-types",
+	".iso":                            "media-types",
+	".iso9660":                        "shared-mime-info",
+	".isp":                            "legacy",
+	".ist":                            "media-types",
+	".istc":                           "media-types",
+	".istr":                           "media-types",
+	".isws":                           "media-types",
+	".it":                             "legacy",
+	".it87":                           "shared-mime-info",
+	".itp":                            "media-types",
+	".its":                            "media-types",
+	".itz":                            "legacy",
+	".ivp":                            "media-types",
+	".ivr":                            "legacy",
+	".ivu":                            "media-types",
+	".j2c":                            "shared-mime-info",
+	".j2k":                            "legacy",
+	".jad":                            "legacy",
+	".jam":                            "legacy",
+	".jar":                            "legacy",
+	".java":                           "legacy",
+	".jceks":                          "shared-mime-info",
+	".jdx":                            "media-types",
+	".jfif":                           "legacy",
+	".jhc":                            "media-types",
+	".jisp":                           "media-types",
+	".jks":                            "shared-mime-info",
+	".jls":                            "media-types",
+	".jlt":                            "media-types",
+	".jmz":                            "media-types",
+	".jng":                            "media-types",
+	".jnlp":                           "legacy",
+	".joda":                           "media-types",
+	".jp2":                            "legacy",
+	".jpc":                            "shared-mime-info",
+	".jpe":                            "legacy",
+	".jpeg":                           "legacy",
+	".jpf":                            "media-types",
+	".jpg":                            "legacy",
+	".jpg2":                           "media-types",
+	".jpgm":                           "media-types",
+	".jpgv":                           "apache",
+	".jph":                            "media-types",
+	".jphc":                           "media-types",
+	".jpm":                            "media-types",
+	".jpr":                            "shared-mime-info",
+	".jpx":                            "media-types",
+	".jpz":                            "legacy",
+	".jrd":                            "media-types",
+	".js":                             "legacy",
+	".jsm":                            "shared-mime-info",
+	".json":                           "legacy",
+	".json-patch":                     "media-types",
+	".jsonld":                         "media-types",
+	".jsonml":                         "apache",
+	".jsontd":                         "media-types",
+	".jsontm":                         "media-types",
+	".jt":                             "media-types",
+	".jtd":                            "media-types",
+	".jwc":                            "legacy",
+	".jxl":                            "media-types",
+	".jxr":                            "media-types",
+	".jxra":                           "media-types",
+	".jxrs":                           "media-types",
+	".jxs":                            "media-types",
+	".jxsc":                           "media-types",
+	".jxsi":                           "media-types",
+	".jxss":                           "media-types",
+	".k25":                            "shared-mime-info",
+	".k7":                             "shared-mime-info",
+	".kar":                            "legacy",
+	".karbon":                         "media-types",
+	".kcm":                            "media-types",
+	".kdc":                            "shared-mime-info",
+	".kdelnk":                         "shared-mime-info",
+	".kexi":                           "shared-mime-info",
+	".kexic":                          "shared-mime-info",
+	".kexis":                          "shared-mime-info",
+	".key":                            "media-types",
+	".keynote":                        "media-types",
+	".kfo":                            "media-types",
+	".kfx":                            "shared-mime-info",
+	".kia":                            "media-types",
+	".kil":                            "legacy",
+	".kin":                            "media-types",
+	".kino":                           "shared-mime-info",
+	".kjx":                            "legacy",
+	".kml":                            "media-types",
+	".kmz":                            "legacy",
+	".kne":                            "media-types",
+	".knp":                            "media-types",
+	".kom":                            "media-types",
+	".kon":                            "media-types",
+	".koz":                            "media-types",
+	".kpm":                            "shared-mime-info",
+	".kpr":                            "media-types",
+	".kpt":                            "media-types",
+	".kpxx":                           "apache",
+	".kra":                            "shared-mime-info",
+	".krz":                            "shared-mime-info",
+	".ks":                             "shared-mime-info",
+	".ksp":                            "legacy",
+	".ksy":                            "shared-mime-info",
+	".kt":                             "shared-mime-info",
+	".ktr":                            "media-types",
+	".ktx":                            "media-types",
+	".ktx2":                           "media-types",
+	".ktz":                            "media-types",
+	".kud":                            "shared-mime-info",
+	".kwd":                            "media-types",
+	".kwt":                            "media-types",
+	".l16":                            "media-types",
+	".la":                             "shared-mime-info",
+	".lak":                            "legacy",
+	".las":                            "media-types",
+	".lasjson":                        "media-types",
+	".lasxml":                         "media-types",
+	".latex":                          "legacy",
+	".lbc":                            "media-types",
+	".lbd":                            "media-types",
+	".lbe":                            "media-types",
+	".lbm":                            "shared-mime-info",
+	".lca":                            "media-types",
+	".lcc":                            "legacy",
+	".lcl":                            "legacy",
+	".lcr":                            "legacy",
+	".lcs":                            "media-types",
+	".ldif":                           "shared-mime-info",
+	".le":                             "media-types",
+	".les":                            "media-types",
+	".lgh":                            "legacy",
+	".lgr":                            "media-types",
+	".lha":                            "legacy",
+	".lhs":                            "media-types",
+	".lhz":                            "shared-mime-info",
+	".lhzd":                           "media-types",
+	".lhzl":                           "media-types",
+	".lin":                            "media-types",
+	".line":                           "media-types",
+	".link66":                         "media-types",
+	".lisp":                           "shared-mime-info",
+	".list":                           "apache",
+	".list3820":                       "media-types",
+	".listafp":                        "media-types",
+	".lml":                            "legacy",
+	".lmlpack":                        "legacy",
+	".lmp":                            "media-types",
+	".lnk":                            "apache",
+	".lnx":                            "shared-mime-info",
+	".loas":                           "media-types",
+	".log":                            "legacy",
+	".loom":                           "media-types",
+	".lostsyncxml":                    "media-types",
+	".lostxml":                        "media-types",
+	".lpf":                            "media-types",
+	".lrf":                            "apache",
+	".lrm":                            "media-types",
+	".lrv":                            "shared-mime-info",
+	".lrz":                            "shared-mime-info",
+	".lsf":                            "legacy",
+	".lsx":                            "legacy",
+	".ltf":                            "apache",
+	".ltx":                            "media-types",
+	".lua":                            "shared-mime-info",
+	".lvp":                            "media-types",
+	".lwo":                            "shared-mime-info",
+	".lwob":                           "shared-mime-info",
+	".lwp":                            "media-types",
+	".lws":                            "shared-mime-info",
+	".lxf":                            "media-types",
+	".ly":                             "media-types",
+	".lyx":                            "media-types",
+	".lz":                             "shared-mime-info",
+	".lz4":                            "shared-mime-info",
+	".lzh":                            "legacy",
+	".lzma":                           "shared-mime-info",
+	".lzo":                            "shared-mime-info",
+	".lzx":                            "media-types",
+	".m":                              "media-types",
+	".m13":                            "legacy",
+	".m14":                            "legacy",
+	".m15":                            "legacy",
+	".m1u":                            "shared-mime-info",
+	".m1v":                            "media-types",
+	".m21":                            "media-types",
+	".m2a":                            "apache",
+	".m2t":                            "shared-mime-info",
+	".m2ts":                           "shared-mime-info",
+	".m2v":                            "media-types",
+	".m3a":                            "apache",
+	".m3g":                            "media-types",
+	".m3u":                            "legacy",
+	".m3u8":                           "media-types",
+	".m3url":                          "legacy",
+	".m4":                             "shared-mime-info",
+	".m4a":                            "legacy",
+	".m4b":                            "legacy",
+	".m4p":                            "legacy",
+	".m4r":                            "shared-mime-info",
+	".m4s":                            "media-types",
+	".m4u":                            "legacy",
+	".m4v":                            "legacy",
+	".m7":                             "shared-mime-info",
+	".ma":                             "media-types",
+	".ma1":                            "legacy",
+	".ma2":                            "legacy",
+	".ma3":                            "legacy",
+	".ma5":                            "legacy",
+	".mab":                            "shared-mime-info",
+	".mac":                            "legacy",
+	".mads":                           "media-types",
+	".maei":                           "media-types",
+	".mag":                            "media-types",
+	".mail":                           "media-types",
+	".mak":                            "shared-mime-info",
+	".maker":                          "media-types",
+	".man":                            "legacy",
+	".manifest":                       "media-types",
+	".map":                            "legacy",
+	".mar":                            "apache",
+	".markdown":                       "media-types",
+	".mathml":                         "legacy",
+	".mb":                             "media-types",
+	".mbd":                            "legacy",
+	".mbk":                            "media-types",
+	".mbox":                           "media-types",
+	".mc1":                            "media-types",
+	".mc2":                            "media-types",
+	".mcd":                            "media-types",
+	".mcif":                           "media-types",
+	".mcm":                            "media-types",
+	".mct":                            "legacy",
+	".mcurl":                          "apache",
+	".md":                             "media-types",
+	".mdb":                            "legacy",
+	".mdc":                            "media-types",
+	".mdi":                            "media-types",
+	".mdx":                            "shared-mime-info",
+	".mdz":                            "legacy",
+	".me":                             "legacy",
+	".med":                            "shared-mime-info",
+	".mel":                            "legacy",
+	".mesh":                           "legacy",
+	".meta4":                          "media-types",
+	".metalink":                       "shared-mime-info",
+	".mets":                           "media-types",
+	".mf4":                            "media-types",
+	".mfm":                            "media-types",
+	".mft":                            "media-types",
+	".mgp":                            "media-types",
+	".mgz":                            "media-types",
+	".mhas":                           "media-types",
+	".mht":                            "legacy",
+	".mhtml":                          "legacy",
+	".mi":                             "legacy",
+	".mid":                            "legacy",
+	".midi":                           "legacy",
+	".mie":                            "apache",
+	".mif":                            "legacy",
+	".mil":                            "legacy",
+	".mime":                           "apache",
+	".minipsf":                        "shared-mime-info",
+	".mio":                            "legacy",
+	".miz":                            "media-types",
+	".mj2":                            "media-types",
+	".mjp2":                           "media-types",
+	".mjpeg":                          "shared-mime-info",
+	".mjpg":                           "shared-mime-info",
+	".mjs":                            "media-types",
+	".mk":                             "shared-mime-info",
+	".mk3d":                           "shared-mime-info",
+	".mka":                            "shared-mime-info",
+	".mkd":                            "shared-mime-info",
+	".mks":                            "apache",
+	".mkv":                            "media-types",
+	".ml":                             "shared-mime-info",
+	".ml2":                            "media-types",
+	".mli":                            "shared-mime-info",
+	".mlp":                            "media-types",
+	".mm":                             "media-types",
+	".mmd":                            "media-types",
+	".mmdb":                           "media-types",
+	".mmf":                            "legacy",
+	".mml":                            "media-types",
+	".mmod":                           "media-types",
+	".mmr":                            "media-types",
+	".mng":                            "legacy",
+	".mny":                            "legacy",
+	".mo":                             "shared-mime-info",
+	".mo3":                            "shared-mime-info",
+	".mobi":                           "shared-mime-info",
+	".moc":                            "legacy",
+	".mocha":                          "legacy",
+	".mod":                            "legacy",
+	".model-inter":                    "media-types",
+	".mods":                           "media-types",
+	".mof":                            "legacy",
+	".mol":                            "legacy",
+	".mol2":                           "media-types",
+	".moml":                           "media-types",
+	".moo":                            "media-types",
+	".moov":                           "shared-mime-info",
+	".mop":                            "legacy",
+	".mopcrt":                         "media-types",
+	".mount":                          "shared-mime-info",
+	".mov":                            "legacy",
+	".movie":                          "legacy",
+	".mp+":                            "shared-mime-info",
+	".mp1":                            "media-types",
+	".mp2":                            "legacy",
+	".mp21":                           "media-types",
+	".mp2a":                           "apache",
+	".mp3":                            "legacy",
+	".mp4":                            "legacy",
+	".mp4a":                           "apache",
+	".mp4s":                           "apache",
+	".mp4v":                           "apache",
+	".mpa":                            "legacy",
+	".mpc":                            "legacy",
+	".mpd":                            "media-types",
+	".mpdd":                           "media-types",
+	".mpe":                            "legacy",
+	".mpeg":                           "legacy",
+	".mpega":                          "media-types",
+	".mpf":                            "media-types",
+	".mpg":                            "legacy",
+	".mpg4":                           "legacy",
+	".mpga":                           "legacy",
+	".mph":                            "media-types",
+	".mpkg":                           "media-types",
+	".mpl":                            "shared-mime-info",
+	".mpls":                           "shared-mime-info",
+	".mpm":                            "media-types",
+	".mpn":                            "legacy",
+	".mpp":                            "legacy",
+	".mps":                            "legacy",
+	".mpt":                            "media-types",
+	".mpv":                            "media-types",
+	".mpv2":                           "legacy",
+	".mpw":                            "media-types",
+	".mpy":                            "media-types",
+	".mqy":                            "media-types",
+	".mrc":                            "media-types",
+	".mrcx":                           "media-types",
+	".mrl":                            "legacy",
+	".mrm":                            "legacy",
+	".mrml":                           "shared-mime-info",
+	".mrw":                            "shared-mime-info",
+	".ms":                             "legacy",
+	".msa":                            "media-types",
+	".mscml":                          "apache",
+	".msd":                            "media-types",
+	".mseed":                          "media-types",
+	".mseq":                           "media-types",
+	".msf":                            "media-types",
+	".msg":                            "legacy",
+	".msh":                            "legacy",
+	".msi":                            "overrides",
+	".msl":                            "media-types",
+	".msm":                            "media-types",
+	".msod":                           "shared-mime-info",
+	".msp":                            "overrides",
+	".msty":                           "media-types",
+	".msu":                            "media-types",
+	".msx":                            "shared-mime-info",
+	".mtl":                            "media-types",
+	".mtm":                            "shared-mime-info",
+	".mts":                            "legacy",
+	".mtx":                            "legacy",
+	".mtz":                            "legacy",
+	".multitrack":                     "media-types",
+	".mup":                            "shared-mime-info",
+	".mus":                            "media-types",
+	".musd":                           "media-types",
+	".musicxml":                       "apache",
+	".mvb":                            "legacy",
+	".mvt":                            "media-types",
+	".mwc":                            "media-types",
+	".mwf":                            "media-types",
+	".mxf":                            "media-types",
+	".mxi":                            "media-types",
+	".mxl":                            "media-types",
+	".mxmf":                           "media-types",
+	".mxml":                           "media-types",
+	".mxs":                            "media-types",
+	".mxu":                            "legacy",
+	".mzv":                            "legacy",
+	".n-gage":                         "apache",
+	".n3":                             "media-types",
+	".n64":                            "shared-mime-info",
+	".nar":                            "legacy",
+	".nb":                             "media-types",
+	".nbmp":                           "legacy",
+	".nbp":                            "media-types",
+	".nc":                             "legacy",
+	".ncx":                            "apache",
+	".ndb":                            "legacy",
+	".ndc":                            "media-types",
+	".ndl":                            "media-types",
+	".nds":                            "media-types",
+	".ndwn":                           "legacy",
+	".nebul":                          "media-types",
+	".nef":                            "media-types",
+	".nes":                            "shared-mime-info",
+	".nez":                            "shared-mime-info",
+	".nfo":                            "shared-mime-info",
+	".ngc":                            "shared-mime-info",
+	".ngdat":                          "media-types",
+	".ngp":                            "shared-mime-info",
+	".nif":                            "legacy",
+	".nim":                            "media-types",
+	".nimn":                           "media-types",
+	".nitf":                           "media-types",
+	".nlu":                            "media-types",
+	".nml":                            "media-types",
+	".nmz":                            "legacy",
+	".nnd":                            "media-types",
+	".nns":                            "media-types",
+	".nnw":                            "media-types",
+	".nokia-op-logo":                  "legacy",
+	".not":                            "shared-mime-info",
+	".notebook":                       "media-types",
+	".npx":                            "legacy",
+	".nq":                             "media-types",
+	".nrw":                            "shared-mime-info",
+	".ns2":                            "media-types",
+	".ns3":                            "media-types",
+	".ns4":                            "media-types",
+	".nsc":                            "shared-mime-info",
+	".nsf":                            "media-types",
+	".nsg":                            "media-types",
+	".nsh":                            "media-types",
+	".nsnd":                           "legacy",
+	".nsv":                            "shared-mime-info",
+	".nt":                             "media-types",
+	".ntf":                            "media-types",
+	".numbers":                        "media-types",
+	".nva":                            "legacy",
+	".nwc":                            "media-types",
+	".nws":                            "legacy",
+	".nzb":                            "shared-mime-info",
+	".o":                              "media-types",
+	".oa2":                            "media-types",
+	".oa3":                            "media-types",
+	".oas":                            "media-types",
+	".obd":                            "apache",
+	".obg":                            "media-types",
+	".obgx":                           "media-types",
+	".obj":                            "media-types",
+	".ocl":                            "shared-mime-info",
+	".ocx":                            "overrides",
+	".oda":                            "legacy",
+	".odb":                            "legacy",
+	".odc":                            "legacy",
+	".odd":                            "media-types",
+	".odf":                            "legacy",
+	".odft":                           "apache",
+	".odg":                            "legacy",
+	".odi":                            "legacy",
+	".odm":                            "legacy",
+	".odp":                            "legacy",
+	".ods":                            "legacy",
+	".odt":                            "legacy",
+	".odx":                            "media-types",
+	".oeb":                            "media-types",
+	".oga":                            "media-types",
+	".ogex":                           "media-types",
+	".ogg":                            "legacy",
+	".ogm":                            "shared-mime-info",
+	".ogv":                            "legacy",
+	".ogx":                            "media-types",
+	".old":                            "media-types",
+	".oleo":                           "shared-mime-info",
+	".omdoc":                          "apache",
+	".omg":                            "media-types",
+	".one":                            "media-types",
+	".onepkg":                         "media-types",
+	".onetmp":                         "media-types",
+	".onetoc":                         "apache",
+	".onetoc2":                        "media-types",
+	".ooc":                            "shared-mime-info",
+	".oom":                            "legacy",
+	".opf":                            "media-types",
+	".opml":                           "shared-mime-info",
+	".oprc":                           "media-types",
+	".opus":                           "media-types",
+	".or2":                            "media-types",
+	".or3":                            "media-types",
+	".ora":                            "shared-mime-info",
+	".orc":                            "media-types",
+	".orf":                            "media-types",
+	".org":                            "media-types",
+	".orq":                            "media-types",
+	".ors":                            "media-types",
+	".osf":                            "media-types",
+	".osfpvg":                         "apache",
+	".osm":                            "media-types",
+	".ota":                            "media-types",
+	".otc":                            "media-types",
+	".otf":                            "media-types",
+	".otg":                            "legacy",
+	".oth":                            "legacy",
+	".oti":                            "media-types",
+	".otp":                            "legacy",
+	".ots":                            "legacy",
+	".ott":                            "legacy",
+	".ova":                            "shared-mime-info",
+	".ovl":                            "media-types",
+	".owl":                            "shared-mime-info",
+	".owx":                            "shared-mime-info",
+	".oxlicg":                         "media-types",
+	".oxps":                           "media-types",
+	".oxt":                            "media-types",
+	".oza":                            "media-types",
+	".p":                              "media-types",
+	".p10":                            "legacy",
+	".p12":                            "legacy",
+	".p21":                            "media-types",
+	".p2p":                            "media-types",
+	".p65":                            "shared-mime-info",
+	".p7b":                            "legacy",
+	".p7c":                            "legacy",
+	".p7m":                            "legacy",
+	".p7r":                            "legacy",
+	".p7s":                            "legacy",
+	".p7z":                            "media-types",
+	".p8":                             "media-types",
+	".p8e":                            "media-types",
+	".pac":                            "legacy",
+	".pack":                           "shared-mime-info",
+	".package":                        "media-types",
+	".pae":                            "legacy",
+	".pages":                          "media-types",
+	".pak":                            "shared-mime-info",
+	".pan":                            "legacy",
+	".par2":                           "shared-mime-info",
+	".part":                           "shared-mime-info",
+	".pas":                            "media-types",
+	".pat":                            "media-types",
+	".patch":                          "media-types",
+	".path":                           "shared-mime-info",
+	".paw":                            "media-types",
+	".pbd":                            "media-types",
+	".pbm":                            "legacy",
+	".pcap":                           "media-types",
+	".pcd":                            "shared-mime-info",
+	".pce":                            "shared-mime-info",
+	".pcf":                            "media-types",
+	".pcf.gz":                         "shared-mime-info",
+	".pcf.z":                          "media-types",
+	".pcl":                            "media-types",
+	".pclxl":                          "apache",
+	".pct":                            "legacy",
+	".pcurl":                          "apache",
+	".pcx":                            "legacy",
+	".pda":                            "legacy",
+	".pdb":                            "legacy",
+	".pdc":                            "shared-mime-info",
+	".pdf":                            "legacy",
+	".pdf.bz2":                        "shared-mime-info",
+	".pdf.gz":                         "shared-mime-info",
+	".pdf.lz":                         "shared-mime-info",
+	".pdf.xz":                         "shared-mime-info",
+	".pdx":                            "media-types",
+	".pef":                            "shared-mime-info",
+	".pem":                            "media-types",
+	".perl":                           "shared-mime-info",
+	".pfa":                            "media-types",
+	".pfb":                            "media-types",
+	".pfm":                            "apache",
+	".pfr":                            "legacy",
+	".pfx":                            "legacy",
+	".pgb":                            "media-types",
+	".pgm":                            "legacy",
+	".pgn":                            "legacy",
+	".pgp":                            "media-types",
+	".php":                            "shared-mime-info",
+	".php3":                           "shared-mime-info",
+	".php4":                           "shared-mime-info",
+	".php5":                           "shared-mime-info",
+	".phps":                           "shared-mime-info",
+	".pic":                            "legacy",
+	".pict":                           "legacy",
+	".pict1":                          "shared-mime-info",
+	".pict2":                          "shared-mime-info",
+	".pil":                            "media-types",
+	".pk":                             "media-types",
+	".pkd":                            "media-types",
+	".pkg":                            "media-types",
+	".pki":                            "media-types",
+	".pkipath":                        "media-types",
+	".pko":                            "legacy",
+	".pkpass":                         "shared-mime-info",
+	".pkr":                            "shared-mime-info",
+	".pl":                             "media-types",
+	".pla":                            "shared-mime-info",
+	".plb":                            "media-types",
+	".plc":                            "media-types",
+	".plf":                            "media-types",
+	".plj":                            "media-types",
+	".pln":                            "shared-mime-info",
+	".plp":                            "media-types",
+	".pls":                            "media-types",
+	".pm":                             "legacy",
+	".pm6":                            "shared-mime-info",
+	".pma":                            "legacy",
+	".pmc":                            "legacy",
+	".pmd":                            "legacy",
+	".pml":                            "legacy",
+	".pmr":                            "legacy",
+	".pmw":                            "legacy",
+	".png":                            "legacy",
+	".pnm":                            "legacy",
+	".pnt":                            "legacy",
+	".pntg":                           "legacy",
+	".pnz":                            "legacy",
+	".po":                             "shared-mime-info",
+	".pod":                            "shared-mime-info",
+	".por":                            "shared-mime-info",
+	".portpkg":                        "media-types",
+	".pot":                            "legacy",
+	".potm":                           "legacy",
+	".potx":                           "legacy",
+	".ppam":                           "legacy",
+	".ppd":                            "media-types",
+	".ppkg":                           "media-types",
+	".ppm":                            "legacy",
+	".pps":                            "legacy",
+	".ppsm":                           "legacy",
+	".ppsx":                           "legacy",
+	".ppt":                            "legacy",
+	".pptm":                           "legacy",
+	".ppttc":                          "media-types",
+	".pptx":                           "legacy",
+	".ppz":                            "shared-mime-info",
+	".pqa":                            "media-types",
+	".pqf":                            "legacy",
+	".pqi":                            "legacy",
+	".prc":                            "legacy",
+	".pre":                            "media-types",
+	".preminet":                       "media-types",
+	".prf":                            "legacy",
+	".prop":                           "legacy",
+	".provn":                          "media-types",
+	".provx":                          "media-types",
+	".proxy":                          "legacy",
+	".prt":                            "media-types",
+	".prz":                            "media-types",
+	".ps":                             "legacy",
+	".ps.bz2":                         "shared-mime-info",
+	".ps.gz":                          "shared-mime-info",
+	".psb":                            "media-types",
+	".psd":                            "media-types",
+	".pseg3820":                       "media-types",
+	".psf":                            "shared-mime-info",
+	".psf.gz":                         "shared-mime-info",
+	".psflib":                         "shared-mime-info",
+	".psfs":                           "media-types",
+	".psg":                            "media-types",
+	".psid":                           "media-types",
+	".pskcxml":                        "media-types",
+	".psw":                            "shared-mime-info",
+	".pt":                             "media-types",
+	".pti":                            "media-types",
+	".ptid":                           "media-types",
+	".ptlk":                           "legacy",
+	".ptrom":                          "media-types",
+	".pub":                            "legacy",
+	".pvb":                            "media-types",
+	".pvx":                            "legacy",
+	".pw":                             "shared-mime-info",
+	".pwn":                            "media-types",
+	".py":                             "media-types",
+	".pya":                            "media-types",
+	".pyc":                            "media-types",
+	".pyo":                            "media-types",
+	".pyox":                           "media-types",
+	".pys":                            "shared-mime-info",
+	".pysu":                           "shared-mime-info",
+	".pyv":                            "media-types",
+	".qam":                            "media-types",
+	".qbo":                            "media-types",
+	".qca":                            "media-types",
+	".qcall":                          "media-types",
+	".qcow":                           "shared-mime-info",
+	".qcow2":                          "shared-mime-info",
+	".qcp":                            "legacy",
+	".qd":                             "shared-mime-info",
+	".qed":                            "shared-mime-info",
+	".qfx":                            "media-types",
+	".qgs":                            "media-types",
+	".qif":                            "shared-mime-info",
+	".qml":                            "shared-mime-info",
+	".qmlproject":                     "shared-mime-info",
+	".qmltypes":                       "shared-mime-info",
+	".qp":                             "shared-mime-info",
+	".qps":                            "media-types",
+	".qs":                             "shared-mime-info",
+	".qt":                             "legacy",
+	".qti":                            "legacy",
+	".qti.gz":                         "shared-mime-info",
+	".qtif":                           "legacy",
+	".qtl":                            "media-types",
+	".qtvr":                           "shared-mime-info",
+	".quiz":                           "media-types",
+	".quox":                           "media-types",
+	".qvd":                            "media-types",
+	".qwd":                            "media-types",
+	".qwt":                            "media-types",
+	".qxb":                            "media-types",
+	".qxd":                            "media-types",
+	".qxl":                            "media-types",
+	".qxt":                            "media-types",
+	".r3t":                            "legacy",
+	".ra":                             "legacy",
+	".raf":                            "shared-mime-info",
+	".ram":                            "legacy",
+	".raml":                           "shared-mime-info",
+	".rapd":                           "media-types",
+	".rar":                            "legacy",
+	".ras":                            "legacy",
+	".raw":                            "shared-mime-info",
+	".raw-disk-image":                 "shared-mime-info",
+	".raw-disk-image.xz":              "shared-mime-info",
+	".rax":                            "shared-mime-info",
+	".rb":                             "media-types",
+	".rc":                             "legacy",
+	".rcprofile":                      "media-types",
+	".rct":                            "media-types",
+	".rd":                             "media-types",
+	".rdf":                            "legacy",
+	".rdf-crypt":                      "media-types",
+	".rdfs":                           "shared-mime-info",
+	".rdp":                            "media-types",
+	".rdz":                            "media-types",
+	".reg":                            "shared-mime-info",
+	".rej":                            "shared-mime-info",
+	".relo":                           "media-types",
+	".reload":                         "media-types",
+	".rep":                            "media-types",
+	".request":                        "media-types",
+	".res":                            "shared-mime-info",
+	".rf":                             "legacy",
+	".rfcxml":                         "media-types",
+	".rgb":                            "legacy",
+	".rgbe":                           "media-types",
+	".rif":                            "media-types",
+	".rip":                            "media-types",
+	".ris":                            "apache",
+	".rl":                             "media-types",
+	".rlc":                            "media-types",
+	".rld":                            "media-types",
+	".rle":                            "shared-mime-info",
+	".rlf":                            "legacy",
+	".rlm":                            "media-types",
+	".rm":                             "legacy",
+	".rmf":                            "legacy",
+	".rmi":                            "legacy",
+	".rmj":                            "shared-mime-info",
+	".rmm":                            "legacy",
+	".rmp":                            "apache",
+	".rms":                            "media-types",
+	".rmvb":                           "legacy",
+	".rmx":                            "shared-mime-info",
+	".rnc":                            "media-types",
+	".rnd":                            "media-types",
+	".rng":                            "shared-mime-info",
+	".rnx":                            "legacy",
+	".roa":                            "media-types",
+	".roff":                           "legacy",
+	".ros":                            "media-types",
+	".rp":                             "legacy",
+	".rp9":                            "media-types",
+	".rpm":                            "legacy",
+	".rpss":                           "media-types",
+	".rpst":                           "media-types",
+	".rq":                             "media-types",
+	".rs":                             "media-types",
+	".rsat":                           "media-types",
+	".rsd":                            "apache",
+	".rsheet":                         "media-types",
+	".rsm":                            "media-types",
+	".rss":                            "media-types",
+	".rst":                            "media-types",
+	".rt":                             "legacy",
+	".rte":                            "legacy",
+	".rtf":                            "legacy",
+	".rtg":                            "legacy",
+	".rtx":                            "legacy",
+	".rusd":                           "media-types",
+	".rv":                             "legacy",
+	".rvx":                            "shared-mime-info",
+	".rw2":                            "shared-mime-info",
+	".rwc":                            "legacy",
+	".rxn":                            "media-types",
+	".rxt":                            "media-types",
+	".s":                              "apache",
+	".s11":                            "media-types",
+	".s14":                            "media-types",
+	".s1a":                            "media-types",
+	".s1e":                            "media-types",
+	".s1g":                            "media-types",
+	".s1h":                            "media-types",
+	".s1j":                            "media-types",
+	".s1m":                            "media-types",
+	".s1n":                            "media-types",
+	".s1p":                            "media-types",
+	".s1q":                            "media-types",
+	".s1w":                            "media-types",
+	".s3df":                           "media-types",
+	".s3m":                            "legacy",
+	".s3z":                            "legacy",
+	".sac":                            "media-types",
+	".saf":                            "media-types",
+	".sam":                            "media-types",
+	".sami":                           "shared-mime-info",
+	".sap":                            "shared-mime-info",
+	".sar":                            "media-types",
+	".sarif":                          "media-types",
+	".sarif-external-properties":      "media-types",
+	".sarif-external-properties.json": "media-types",
+	".sarif.json":                     "media-types",
+	".sass":                           "shared-mime-info",
+	".sav":                            "shared-mime-info",
+	".sbml":                           "apache",
+	".sc":                             "media-types",
+	".sca":                            "legacy",
+	".scala":                          "media-types",
+	".scd":                            "legacy",
+	".sce":                            "media-types",
+	".sci":                            "media-types",
+	".scim":                           "media-types",
+	".scl":                            "media-types",
+	".scld":                           "media-types",
+	".scm":                            "media-types",
+	".scn":                            "shared-mime-info",
+	".sco":                            "media-types",
+	".scope":                          "shared-mime-info",
+	".scq":                            "media-types",
+	".scr":                            "overrides",
+	".scs":                            "media-types",
+	".scsf":                           "media-types",
+	".scss":                           "shared-mime-info",
+	".sct":                            "legacy",
+	".scurl":                          "apache",
+	".sd":                             "media-types",
+	".sd2":                            "media-types",
+	".sda":                            "media-types",
+	".sdc":                            "media-types",
+	".sdd":                            "media-types",
+	".sdf":                            "legacy",
+	".sdkd":                           "media-types",
+	".sdkm":                           "media-types",
+	".sdo":                            "media-types",
+	".sdoc":                           "media-types",
+	".sdp":                            "legacy",
+	".sds":                            "media-types",
+	".sdw":                            "media-types",
+	".sea":                            "legacy",
+	".see":                            "media-types",
+	".seed":                           "media-types",
+	".sem":                            "media-types",
+	".sema":                           "media-types",
+	".semd":                           "media-types",
+	".semf":                           "media-types",
+	".seml":                           "media-types",
+	".senml":                          "media-types",
+	".senml-etchc":                    "media-types",
+	".senml-etchj":                    "media-types",
+	".senmlc":                         "media-types",
+	".senmle":                         "media-types",
+	".senmlx":                         "media-types",
+	".sensml":                         "media-types",
+	".sensmlc":                        "media-types",
+	".sensmle":                        "media-types",
+	".sensmlx":                        "media-types",
+	".ser":                            "media-types",
+	".service":                        "shared-mime-info",
+	".setpay":                         "legacy",
+	".setreg":                         "legacy",
+	".sfc":                            "media-types",
+	".sfd":                            "media-types",
+	".sfd-hdstx":                      "media-types",
+	".sfs":                            "media-types",
+	".sfv":                            "media-types",
+	".sg":                             "shared-mime-info",
+	".sgb":                            "shared-mime-info",
+	".sgd":                            "shared-mime-info",
+	".sgf":                            "media-types",
+	".sgi":                            "media-types",
+	".sgif":                           "media-types",
+	".sgl":                            "media-types",
+	".sgm":                            "legacy",
+	".sgml":                           "legacy",
+	".sh":                             "legacy",
+	".shaclc":                         "media-types",
+	".shape":                          "shared-mime-info",
+	".shar":                           "legacy",
+	".shc":                            "media-types",
+	".shex":                           "media-types",
+	".shf":                            "media-types",
+	".shn":                            "shared-mime-info",
+	".shp":                            "media-types",
+	".shtm":                           "legacy",
+	".shtml":                          "legacy",
+	".shw":                            "legacy",
+	".shx":                            "media-types",
+	".si":                             "media-types",
+	".si6":                            "legacy",
+	".si7":                            "legacy",
+	".si9":                            "legacy",
+	".siag":                           "shared-mime-info",
+	".sic":                            "media-types",
+	".sid":                            "media-types",
+	".sieve":                          "media-types",
+	".sig":                            "media-types",
+	".sik":                            "media-types",
+	".sil":                            "apache",
+	".silo":                           "legacy",
+	".sis":                            "legacy",
+	".sisx":                           "shared-mime-info",
+	".sit":                            "legacy",
+	".sitx":                           "media-types",
+	".siv":                            "media-types",
+	".sjp":                            "media-types",
+	".sjpg":                           "media-types",
+	".sk":                             "shared-mime-info",
+	".sk1":                            "shared-mime-info",
+	".skd":                            "legacy",
+	".skm":                            "legacy",
+	".skp":                            "legacy",
+	".skr":                            "shared-mime-info",
+	".skt":                            "legacy",
+	".sl":                             "media-types",
+	".sla":                            "media-types",
+	".slaz":                           "media-types",
+	".slc":                            "legacy",
+	".sldm":                           "media-types",
+	".sldx":                           "legacy",
+	".slice":                          "shared-mime-info",
+	".slk":                            "shared-mime-info",
+	".sls":                            "media-types",
+	".slt":                            "media-types",
+	".sm":                             "media-types",
+	".smaf":                           "shared-mime-info",
+	".smc":                            "media-types",
+	".smd":                            "legacy",
+	".smf":                            "media-types",
+	".smh":                            "media-types",
+	".smht":                           "media-types",
+	".smi":                            "legacy",
+	".smil":                           "legacy",
+	".smk":                            "media-types",
+	".sml":                            "media-types",
+	".smo":                            "media-types",
+	".smov":                           "media-types",
+	".smp":                            "legacy",
+	".smp3":                           "media-types",
+	".smpg":                           "media-types",
+	".sms":                            "media-types",
+	".smv":                            "media-types",
+	".smz":                            "legacy",
+	".smzip":                          "media-types",
+	".snap":                           "shared-mime-info",
+	".snd":                            "legacy",
+	".snf":                            "apache",
+	".so":                             "legacy",
+	".soa":                            "media-types",
+	".soc":                            "media-types",
+	".socket":                         "shared-mime-info",
+	".sofa":                           "media-types",
+	".sos":                            "media-types",
+	".spc":                            "legacy",
+	".spd":                            "media-types",
+	".spdf":                           "media-types",
+	".spdx":                           "media-types",
+	".spdx.json":                      "media-types",
+	".spec":                           "shared-mime-info",
+	".spf":                            "media-types",
+	".spl":                            "legacy",
+	".spm":                            "shared-mime-info",
+	".spn":                            "media-types",
+	".spng":                           "media-types",
+	".spo":                            "media-types",
+	".spot":                           "media-types",
+	".spp":                            "media-types",
+	".sppt":                           "media-types",
+	".spq":                            "media-types",
+	".spr":                            "legacy",
+	".sprite":                         "legacy",
+	".spt":                            "legacy",
+	".spx":                            "media-types",
+	".sql":                            "media-types",
+	".sqlite":                         "media-types",
+	".sqlite2":                        "shared-mime-info",
+	".sqlite3":                        "media-types",
+	".sqsh":                           "shared-mime-info",
+	".sr":                             "media-types",
+	".sr2":                            "shared-mime-info",
+	".src":                            "legacy",
+	".src.rpm":                        "shared-mime-info",
+	".srf":                            "shared-mime-info",
+	".srt":                            "media-types",
+	".sru":                            "media-types",
+	".srx":                            "media-types",
+	".ss":                             "shared-mime-info",
+	".ssa":                            "shared-mime-info",
+	".ssdl":                           "apache",
+	".sse":                            "media-types",
+	".ssf":                            "media-types",
+	".ssml":                           "media-types",
+	".sst":                            "legacy",
+	".ssv":                            "media-types",
+	".ssvc":                           "media-types",
+	".ssw":                            "media-types",
+	".sswf":                           "media-types",
+	".st":                             "media-types",
+	".stc":                            "legacy",
+	".std":                            "legacy",
+	".step":                           "media-types",
+	".stf":                            "media-types",
+	".sti":                            "legacy",
+	".stif":                           "media-types",
+	".stix":                           "media-types",
+	".stk":                            "legacy",
+	".stl":                            "legacy",
+	".stm":                            "legacy",
+	".stml":                           "media-types",
+	".stp":                            "media-types",
+	".stpnc":                          "media-types",
+	".stpx":                           "media-types",
+	".stpxz":                          "media-types",
+	".stpz":                           "media-types",
+	".str":                            "media-types",
+	".study-inter":                    "media-types",
+	".stw":                            "legacy",
+	".sty":                            "media-types",
+	".sub":                            "shared-mime-info",
+	".sun":                            "shared-mime-info",
+	".sus":                            "media-types",
+	".susp":                           "media-types",
+	".sv":                             "shared-mime-info",
+	".sv4cpio":                        "legacy",
+	".sv4crc":                         "legacy",
+	".svc":                            "media-types",
+	".svd":                            "apache",
+	".svf":                            "legacy",
+	".svg":                            "legacy",
+	".svg.gz":                         "shared-mime-info",
+	".svgz":                           "media-types",
+	".svh":                            "legacy",
+	".svr":                            "legacy",
+	".sw":                             "media-types",
+	".swa":                            "apache",
+	".swap":                           "shared-mime-info",
+	".swf":                            "legacy",
+	".swfl":                           "legacy",
+	".swi":                            "media-types",
+	".swidtag":                        "media-types",
+	".swm":                            "shared-mime-info",
+	".sxc":                            "legacy",
+	".sxd":                            "legacy",
+	".sxg":                            "legacy",
+	".sxi":                            "legacy",
+	".sxl":                            "media-types",
+	".sxls":                           "media-types",
+	".sxm":                            "legacy",
+	".sxw":                            "legacy",
+	".sy2":                            "media-types",
+	".syft.json":                      "media-types",
+	".sylk":                           "shared-mime-info",
+	".sys":                            "overrides",
+	".t":                              "legacy",
+	".t2t":                            "shared-mime-info",
+	".t3":                             "apache",
+	".tad":                            "legacy",
+	".tag":                            "media-types",
+	".taglet":                         "media-types",
+	".talk":                           "legacy",
+	".tam":                            "media-types",
+	".tamp":                           "media-types",
+	".tamx":                           "media-types",
+	".tao":                            "media-types",
+	".tap":                            "media-types",
+	".tar":                            "legacy",
+	".tar.bz":                         "shared-mime-info",
+	".tar.bz2":                        "legacy",
+	".tar.gz":                         "legacy",
+	".tar.lrz":                        "shared-mime-info",
+	".tar.lz":                         "shared-mime-info",
+	".tar.lz4":                        "shared-mime-info",
+	".tar.lzma":                       "shared-mime-info",
+	".tar.lzo":                        "shared-mime-info",
+	".tar.xz":                         "legacy",
+	".tar.z":                          "legacy",
+	".tar.zst":                        "shared-mime-info",
+	".target":                         "shared-mime-info",
+	".tat":                            "media-types",
+	".tatp":                           "media-types",
+	".tatx":                           "media-types",
+	".tau":                            "media-types",
+	".taz":                            "legacy",
+	".tb2":                            "shared-mime-info",
+	".tbp":                            "legacy",
+	".tbt":                            "legacy",
+	".tbz":                            "shared-mime-info",
+	".tbz2":                           "shared-mime-info",
+	".tcap":                           "media-types",
+	".tcl":                            "legacy",
+	".tcu":                            "media-types",
+	".td":                             "media-types",
+	".teacher":                        "media-types",
+	".tei":                            "media-types",
+	".teicorpus":                      "media-types",
+	".ter":                            "media-types",
+	".tex":                            "legacy",
+	".texi":                           "legacy",
+	".texinfo":                        "legacy",
+	".text":                           "media-types",
+	".tfi":                            "media-types",
+	".tfm":                            "apache",
+	".tfx":                            "media-types",
+	".tga":                            "shared-mime-info",
+	".tgf":                            "media-types",
+	".tgz":                            "legacy",
+	".theme":                          "shared-mime-info",
+	".themepack":                      "shared-mime-info",
+	".thm":                            "legacy",
+	".thmx":                           "media-types",
+	".tif":                            "legacy",
+	".tiff":                           "legacy",
+	".timer":                          "shared-mime-info",
+	".tk":                             "media-types",
+	".tki":                            "legacy",
+	".tkined":                         "legacy",
+	".tlclient":                       "media-types",
+	".tlrz":                           "shared-mime-info",
+	".tlz":                            "shared-mime-info",
+	".tm":                             "media-types",
+	".tm.json":                        "media-types",
+	".tm.jsonld":                      "media-types",
+	".tmo":                            "media-types",
+	".tnef":                           "media-types",
+	".tnf":                            "media-types",
+	".toc":                            "legacy",
+	".toml":                           "shared-mime-info",
+	".torrent":                        "legacy",
+	".toy":                            "legacy",
+	".tpic":                           "shared-mime-info",
+	".tpl":                            "media-types",
+	".tpt":                            "media-types",
+	".tr":                             "legacy",
+	".tra":                            "media-types",
+	".tree":                           "media-types",
+	".tres":                           "shared-mime-info",
+	".trig":                           "media-types",
+	".trk":                            "legacy",
+	".trm":                            "legacy",
+	".ts":                             "media-types",
+	".tsa":                            "media-types",
+	".tscn":                           "shared-mime-info",
+	".tsd":                            "media-types",
+	".tsi":                            "legacy",
+	".tsp":                            "legacy",
+	".tsq":                            "media-types",
+	".tsr":                            "media-types",
+	".tst":                            "media-types",
+	".tsv":                            "legacy",
+	".tta":                            "shared-mime-info",
+	".ttc":                            "media-types",
+	".ttf":                            "legacy",
+	".ttl":                            "media-types",
+	".ttml":                           "media-types",
+	".ttx":                            "shared-mime-info",
+	".ttz":                            "legacy",
+	".tuc":                            "media-types",
+	".tur":                            "media-types",
+	".twd":                            "media-types",
+	".twds":                           "media-types",
+	".twig":                           "shared-mime-info",
+	".txd":                            "media-types",
+	".txf":                            "media-types",
+	".txt":                            "legacy",
+	".txz":                            "shared-mime-info",
+	".tzo":                            "shared-mime-info",
+	".tzst":                           "shared-mime-info",
+	".u32":                            "apache",
+	".u3d":                            "media-types",
+	".u8dsn":                          "media-types",
+	".u8hdr":                          "media-types",
+	".u8mdn":                          "media-types",
+	".u8msg":                          "media-types",
+	".udeb":                           "media-types",
+	".ufd":                            "media-types",
+	".ufdl":                           "media-types",
+	".ufraw":                          "shared-mime-info",
+	".ui":                             "shared-mime-info",
+	".uil":                            "shared-mime-info",
+	".uis":                            "media-types",
+	".uls":                            "legacy",
+	".ult":                            "legacy",
+	".ulx":                            "apache",
+	".umj":                            "media-types",
+	".unf":                            "shared-mime-info",
+	".uni":                            "shared-mime-info",
+	".unif":                           "shared-mime-info",
+	".unityweb":                       "media-types",
+	".uo":                             "media-types",
+	".uoml":                           "media-types",
+	".upa":                            "media-types",
+	".uri":                            "media-types",
+	".urim":                           "media-types",
+	".urimap":                         "media-types",
+	".uris":                           "media-types",
+	".url":                            "shared-mime-info",
+	".urls":                           "apache",
+	".usda":                           "media-types",
+	".usdz":                           "media-types",
+	".ustar":                          "legacy",
+	".utz":                            "media-types",
+	".uu":                             "legacy",
+	".uue":                            "legacy",
+	".uva":                            "media-types",
+	".uvd":                            "media-types",
+	".uvf":                            "media-types",
+	".uvg":                            "media-types",
+	".uvh":                            "media-types",
+	".uvi":                            "media-types",
+	".uvm":                            "media-types",
+	".uvp":                            "media-types",
+	".uvs":                            "media-types",
+	".uvt":                            "media-types",
+	".uvu":                            "media-types",
+	".uvv":                            "media-types",
+	".uvva":                           "media-types",
+	".uvvd":                           "media-types",
+	".uvvf":                           "media-types",
+	".uvvg":                           "media-types",
+	".uvvh":                           "media-types",
+	".uvvi":                           "media-types",
+	".uvvm":                           "media-types",
+	".uvvp":                           "media-types",
+	".uvvs":                           "media-types",
+	".uvvt":                           "media-types",
+	".uvvu":                           "media-types",
+	".uvvv":                           "media-types",
+	".uvvx":                           "media-types",
+	".uvvz":                           "media-types",
+	".uvx":                            "media-types",
+	".uvz":                            "media-types",
+	".v":                              "shared-mime-info",
+	".v64":                            "shared-mime-info",
+	".val":                            "media-types",
+	".vala":                           "shared-mime-info",
+	".vapi":                           "shared-mime-info",
+	".vb":                             "shared-mime-info",
+	".vbe":                            "overrides",
+	".vbk":                            "media-types",
+	".vbox":                           "media-types",
+	".vbs":                            "overrides",
+	".vcard":                          "media-types",
+	".vcd":                            "legacy",
+	".vcf":                            "legacy",
+	".vcg":                            "media-types",
+	".vcj":                            "media-types",
+	".vcs":                            "media-types",
+	".vct":                            "shared-mime-info",
+	".vcx":                            "media-types",
+	".vda":                            "shared-mime-info",
+	".vdi":                            "shared-mime-info",
+	".vdo":                            "legacy",
+	".vds":                            "media-types",
+	".ves":                            "media-types",
+	".vew":                            "media-types",
+	".vfk":                            "media-types",
+	".vfr":                            "media-types",
+	".vhd":                            "shared-mime-info",
+	".vhdl":                           "shared-mime-info",
+	".vhdx":                           "shared-mime-info",
+	".viaframe":                       "media-types",
+	".vib":                            "legacy",
+	".vis":                            "media-types",
+	".viv":                            "legacy",
+	".vivo":                           "legacy",
+	".vlc":                            "shared-mime-info",
+	".vmd":                            "legacy",
+	".vmdk":                           "shared-mime-info",
+	".vmf":                            "legacy",
+	".vmi":                            "legacy",
+	".vms":                            "legacy",
+	".vmt":                            "media-types",
+	".vob":                            "shared-mime-info",
+	".voc":                            "shared-mime-info",
+	".vor":                            "shared-mime-info",
+	".vox":                            "legacy",
+	".vpc":                            "shared-mime-info",
+	".vpm":                            "media-types",
+	".vqe":                            "legacy",
+	".vqf":                            "legacy",
+	".vql":                            "legacy",
+	".vre":                            "legacy",
+	".vrm":                            "media-types",
+	".vrml":                           "legacy",
+	".vrt":                            "legacy",
+	".vrw":                            "legacy",
+	".vsc":                            "media-types",
+	".vsd":                            "media-types",
+	".vsdm":                           "shared-mime-info",
+	".vsdx":                           "shared-mime-info",
+	".vsf":                            "media-types",
+	".vss":                            "media-types",
+	".vssm":                           "shared-mime-info",
+	".vssx":                           "shared-mime-info",
+	".vst":                            "media-types",
+	".vstm":                           "shared-mime-info",
+	".vstx":                           "shared-mime-info",
+	".vsw":                            "media-types",
+	".vtf":                            "media-types",
+	".vtnstd":                         "media-types",
+	".vts":                            "legacy",
+	".vtt":                            "media-types",
+	".vtu":                            "media-types",
+	".vwx":                            "media-types",
+	".vxml":                           "legacy",
+	".w3d":                            "apache",
+	".wad":                            "media-types",
+	".wadl":                           "media-types",
+	".wafl":                           "media-types",
+	".war":                            "legacy",
+	".wasm":                           "media-types",
+	".wav":                            "legacy",
+	".wax":                            "legacy",
+	".wb1":                            "shared-mime-info",
+	".wb2":                            "shared-mime-info",
+	".wb3":                            "shared-mime-info",
+	".wbmp":                           "legacy",
+	".wbs":                            "media-types",
+	".wbxml":                          "legacy",
+	".wcm":                            "legacy",
+	".wdb":                            "legacy",
+	".wdp":                            "apache",
+	".web":                            "legacy",
+	".weba":                           "apache",
+	".webm":                           "legacy",
+	".webmanifest":                    "media-types",
+	".webp":                           "legacy",
+	".wg":                             "media-types",
+	".wgsl":                           "media-types",
+	".wgt":                            "media-types",
+	".wi":                             "legacy",
+	".wif":                            "media-types",
+	".wim":                            "shared-mime-info",
+	".win":                            "media-types",
+	".wis":                            "legacy",
+	".wk":                             "media-types",
+	".wk1":                            "media-types",
+	".wk3":                            "media-types",
+	".wk4":                            "media-types",
+	".wkdownload":                     "shared-mime-info",
+	".wks":                            "legacy",
+	".wlnk":                           "media-types",
+	".wm":                             "legacy",
+	".wma":                            "legacy",
+	".wmc":                            "media-types",
+	".wmd":                            "legacy",
+	".wmf":                            "legacy",
+	".wml":                            "legacy",
+	".wmlc":                           "legacy",
+	".wmls":                           "legacy",
+	".wmlsc":                          "legacy",
+	".wmlscript":                      "legacy",
+	".wmv":                            "legacy",
+	".wmx":                            "legacy",
+	".wmz":                            "legacy",
+	".woff":                           "media-types",
+	".woff2":                          "media-types",
+	".wp":                             "shared-mime-info",
+	".wp4":                            "shared-mime-info",
+	".wp5":                            "shared-mime-info",
+	".wp6":                            "shared-mime-info",
+	".wpd":                            "media-types",
+	".wpg":                            "shared-mime-info",
+	".wpl":                            "media-types",
+	".wpng":                           "legacy",
+	".wpp":                            "shared-mime-info",
+	".wps":                            "legacy",
+	".wpt":                            "legacy",
+	".wqd":                            "media-types",
+	".wri":                            "legacy",
+	".wrl":                            "legacy",
+	".wrz":                            "legacy",
+	".ws":                             "legacy",
+	".wsc":                            "legacy",
+	".wsdl":                           "media-types",
+	".wsf":                            "overrides",
+	".wspolicy":                       "media-types",
+	".wtb":                            "media-types",
+	".wv":                             "legacy",
+	".wvc":                            "shared-mime-info",
+	".wvp":                            "shared-mime-info",
+	".wvx":                            "legacy",
+	".wwf":                            "shared-mime-info",
+	".wxl":                            "legacy",
+	".wz":                             "media-types",
+	".x-gzip":                         "legacy",
+	".x32":                            "apache",
+	".x3d":                            "media-types",
+	".x3db":                           "media-types",
+	".x3dbz":                          "apache",
+	".x3dv":                           "media-types",
+	".x3dvz":                          "media-types",
+	".x3dz":                           "media-types",
+	".x3f":                            "shared-mime-info",
+	".x_b":                            "media-types",
+	".x_t":                            "media-types",
+	".xac":                            "shared-mime-info",
+	".xaf":                            "legacy",
+	".xaml":                           "apache",
+	".xap":                            "apache",
+	".xar":                            "legacy",
+	".xav":                            "media-types",
+	".xbap":                           "apache",
+	".xbd":                            "media-types",
+	".xbel":                           "shared-mime-info",
+	".xbl":                            "shared-mime-info",
+	".xbm":                            "legacy",
+	".xca":                            "media-types",
+	".xcf":                            "media-types",
+	".xcf.bz2":                        "shared-mime-info",
+	".xcf.gz":                         "shared-mime-info",
+	".xcos":                           "media-types",
+	".xcs":                            "media-types",
+	".xct":                            "media-types",
+	".xdd":                            "media-types",
+	".xdf":                            "media-types",
+	".xdgapp":                         "shared-mime-info",
+	".xdm":                            "legacy",
+	".xdma":                           "legacy",
+	".xdp":                            "media-types",
+	".xdssc":                          "media-types",
+	".xdw":                            "legacy",
+	".xel":                            "media-types",
+	".xenc":                           "apache",
+	".xer":                            "media-types",
+	".xfd":                            "media-types",
+	".xfdf":                           "media-types",
+	".xfdl":                           "media-types",
+	".xhe":                            "media-types",
+	".xht":                            "legacy",
+	".xhtm":                           "legacy",
+	".xhtml":                          "legacy",
+	".xhvml":                          "media-types",
+	".xi":                             "shared-mime-info",
+	".xif":                            "media-types",
+	".xla":                            "legacy",
+	".xlam":                           "legacy",
+	".xlc":                            "legacy",
+	".xld":                            "shared-mime-info",
+	".xlf":                            "media-types",
+	".xliff":                          "shared-mime-info",
+	".xlim":                           "media-types",
+	".xll":                            "legacy",
+	".xlm":                            "legacy",
+	".xlr":                            "shared-mime-info",
+	".xls":                            "legacy",
+	".xlsb":                           "legacy",
+	".xlsm":                           "legacy",
+	".xlsx":                           "legacy",
+	".xlt":                            "legacy",
+	".xltm":                           "legacy",
+	".xltx":                           "legacy",
+	".xlw":                            "legacy",
+	".xm":                             "legacy",
+	".xmf":                            "shared-mime-info",
+	".xmi":                            "shared-mime-info",
+	".xml":                            "legacy",
+	".xmls":                           "media-types",
+	".xmt_bin":                        "media-types",
+	".xmt_txt":                        "media-types",
+	".xmz":                            "legacy",
+	".xns":                            "media-types",
+	".xo":                             "media-types",
+	".xodp":                           "media-types",
+	".xods":                           "media-types",
+	".xodt":                           "media-types",
+	".xof":                            "legacy",
+	".xop":                            "media-types",
+	".xotp":                           "media-types",
+	".xots":                           "media-types",
+	".xott":                           "media-types",
+	".xpak":                           "media-types",
+	".xpi":                            "legacy",
+	".xpl":                            "apache",
+	".xpm":                            "legacy",
+	".xpr":                            "media-types",
+	".xps":                            "media-types",
+	".xpw":                            "media-types",
+	".xpx":                            "media-types",
+	".xsd":                            "shared-mime-info",
+	".xsf":                            "media-types",
+	".xsit":                           "legacy",
+	".xsl":                            "legacy",
+	".xslfo":                          "shared-mime-info",
+	".xslt":                           "legacy",
+	".xsm":                            "media-types",
+	".xspf":                           "media-types",
+	".xtel":                           "media-types",
+	".xul":                            "legacy",
+	".xvm":                            "media-types",
+	".xvml":                           "media-types",
+	".xwd":                            "legacy",
+	".xyz":                            "legacy",
+	".xyze":                           "media-types",
+	".xz":                             "media-types",
+	".yaml":                           "shared-mime-info",
+	".yang":                           "media-types",
+	".yin":                            "media-types",
+	".yme":                            "media-types",
+	".yml":                            "shared-mime-info",
+	".yt":                             "media-types",
+	".yz1":                            "legacy",
+	".z":                              "legacy",
+	".z1":                             "apache",
+	".z2":                             "apache",
+	".z3":                             "apache",
+	".z4":                             "apache",
+	".z5":                             "apache",
+	".z6":                             "apache",
+	".z64":                            "shared-mime-info",
+	".z7":                             "apache",
+	".z8":                             "apache",
+	".zabw":                           "shared-mime-info",
+	".zac":                            "legacy",
+	".zaz":                            "media-types",
+	".zfc":                            "media-types",
+	".zfo":                            "media-types",
+	".zim":                            "shared-mime-info",
+	".zip":                            "legacy",
+	".zipx":                           "shared-mime-info",
+	".zir":                            "media-types",
+	".zirz":                           "media-types",
+	".zmm":                            "media-types",
+	".zmt":                            "media-types",
+	".zone":                           "media-types",
+	".zoo":                            "shared-mime-info",
+	".zsav":                           "shared-mime-info",
+	".zst":                            "media-types",
+	".zz":                             "shared-mime-info",
+	".~":                              "media-types",
+}
+
+var typeDescs = map[string]typeDesc{
+	"application/acrobat":                                        {comment: "PDF document", acronym: "PDF", expanded: "Portable Document Format", genericIcon: "x-office-document"},
+	"application/andrew-inset":                                   {comment: "ATK inset", acronym: "ATK", expanded: "Andrew Toolkit", genericIcon: "x-office-document"},
+	"application/annodex":                                        {comment: "Annodex exchange format", genericIcon: "video-x-generic"},
+	"application/atom+xml":                                       {comment: "Atom syndication feed", genericIcon: "text-html"},
+	"application/bzip2":                                          {comment: "Bzip archive", genericIcon: "package-x-generic"},
+	"application/cdr":                                            {comment: "Corel Draw drawing", genericIcon: "image-x-generic"},
+	"application/coreldraw":                                      {comment: "Corel Draw drawing", genericIcon: "image-x-generic"},
+	"application/dbase":                                          {comment: "Xbase document", genericIcon: "x-office-document"},
+	"application/dbf":                                            {comment: "Xbase document", genericIcon: "x-office-document"},
+	"application/dicom":                                          {comment: "DICOM image", acronym: "DICOM", expanded: "Digital Imaging and Communications in Medicine", genericIcon: "image-x-generic"},
+	"application/docbook+xml":                                    {comment: "DocBook document", genericIcon: "x-office-document"},
+	"application/ecmascript":                                     {comment: "ECMAScript program", genericIcon: "text-x-script"},
+	"application/emf":                                            {comment: "EMF image", acronym: "EMF", expanded: "Enhanced MetaFile"},
+	"application/epub+zip":                                       {comment: "electronic book document", genericIcon: "x-office-document"},
+	"application/fits":                                           {comment: "FITS document", acronym: "FITS", expanded: "Flexible Image Transport System"},
+	"application/font-woff":                                      {comment: "WOFF font", acronym: "WOFF", expanded: "Web Open Font Format", genericIcon: "font-x-generic"},
+	"application/futuresplash":                                   {comment: "Shockwave Flash file", genericIcon: "video-x-generic"},
+	"application/geo+json":                                       {comment: "GeoJSON geospatial data"},
+	"application/gml+xml":                                        {comment: "GML document", acronym: "GML", expanded: "Geography Markup Language"},
+	"application/gnunet-directory":                               {comment: "GNUnet search file"},
+	"application/gpx":                                            {comment: "GPX geographic data", acronym: "GPX", expanded: "GPS Exchange Format"},
+	"application/gpx+xml":                                        {comment: "GPX geographic data", acronym: "GPX", expanded: "GPS Exchange Format"},
+	"application/gzip":                                           {comment: "Gzip archive", genericIcon: "package-x-generic"},
+	"application/ico":                                            {comment: "Windows icon"},
+	"application/ics":                                            {comment: "VCS/ICS calendar", acronym: "VCS/ICS", expanded: "vCalendar/iCalendar"},
+	"application/illustrator":                                    {comment: "Adobe Illustrator document", genericIcon: "image-x-generic"},
+	"application/java":                                           {comment: "Java class"},
+	"application/java-archive":                                   {comment: "Java archive", genericIcon: "package-x-generic"},
+	"application/java-byte-code":                                 {comment: "Java class"},
+	"application/java-vm":                                        {comment: "Java class"},
+	"application/javascript":                                     {comment: "JavaScript program", genericIcon: "text-x-script"},
+	"application/jrd+json":                                       {comment: "JRD document", acronym: "JRD", expanded: "JSON Resource Descriptor", genericIcon: "text-x-script"},
+	"application/json":                                           {comment: "JSON document", acronym: "JSON", expanded: "JavaScript Object Notation", genericIcon: "text-x-script"},
+	"application/json-patch+json":                                {comment: "JSON patch", acronym: "JSON", expanded: "JavaScript Object Notation", genericIcon: "text-x-script"},
+	"application/ld+json":                                        {comment: "JSON-LD document", acronym: "JSON-LD", expanded: "JavaScript Object Notation for Linked Data", genericIcon: "text-x-script"},
+	"application/lotus123":                                       {comment: "Lotus 1-2-3 spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/m3u":                                            {comment: "Media playlist"},
+	"application/mac-binhex40":                                   {comment: "Macintosh BinHex-encoded file", genericIcon: "package-x-generic"},
+	"application/mathematica":                                    {comment: "Mathematica Notebook file", genericIcon: "x-office-document"},
+	"application/mathml+xml":                                     {comment: "MathML document", acronym: "MathML", expanded: "Mathematical Markup Language"},
+	"application/mbox":                                           {comment: "mailbox file", genericIcon: "text-x-generic"},
+	"application/mdb":                                            {comment: "JET database", acronym: "JET", expanded: "Joint Engine Technology", genericIcon: "x-office-document"},
+	"application/metalink+xml":                                   {comment: "Metalink file"},
+	"application/metalink4+xml":                                  {comment: "Metalink file"},
+	"application/ms-tnef":                                        {comment: "TNEF message", acronym: "TNEF", expanded: "Transport Neutral Encapsulation Format"},
+	"application/msaccess":                                       {comment: "JET database", acronym: "JET", expanded: "Joint Engine Technology", genericIcon: "x-office-document"},
+	"application/msexcel":                                        {comment: "Excel spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/mspowerpoint":                                   {comment: "PowerPoint presentation", genericIcon: "x-office-presentation"},
+	"application/msword":                                         {comment: "Word document", genericIcon: "x-office-document"},
+	"application/msword-template":                                {comment: "Word template", genericIcon: "x-office-document"},
+	"application/mxf":                                            {comment: "MXF video", acronym: "MXF", expanded: "Material Exchange Format", genericIcon: "video-x-generic"},
+	"application/nappdf":                                         {comment: "PDF document", acronym: "PDF", expanded: "Portable Document Format", genericIcon: "x-office-document"},
+	"application/octet-stream":                                   {comment: "unknown"},
+	"application/oda":                                            {comment: "ODA document", acronym: "ODA", expanded: "Office Document Architecture", genericIcon: "x-office-document"},
+	"application/ogg":                                            {comment: "Ogg multimedia file", genericIcon: "video-x-generic"},
+	"application/ovf":                                            {comment: "OVF disk image", acronym: "OVF", expanded: "Open Virtualization Format"},
+	"application/owl+xml":                                        {comment: "OWL XML file", acronym: "OWL", expanded: "Web Ontology Language"},
+	"application/oxps":                                           {comment: "OpenXPS document", acronym: "OpenXPS", expanded: "Open XML Paper Specification", genericIcon: "x-office-document"},
+	"application/pcap":                                           {comment: "network packet capture"},
+	"application/pdf":                                            {comment: "PDF document", acronym: "PDF", expanded: "Portable Document Format", genericIcon: "x-office-document"},
+	"application/pgp":                                            {comment: "PGP/MIME-encrypted message header", genericIcon: "text-x-generic"},
+	"application/pgp-encrypted":                                  {comment: "PGP/MIME-encrypted message header", genericIcon: "text-x-generic"},
+	"application/pgp-keys":                                       {comment: "PGP keys", acronym: "PGP", expanded: "Pretty Good Privacy", genericIcon: "text-x-generic"},
+	"application/pgp-signature":                                  {comment: "detached OpenPGP signature", genericIcon: "text-x-generic"},
+	"application/photoshop":                                      {comment: "Photoshop image"},
+	"application/pkcs10":                                         {comment: "PKCS#10 certification request", acronym: "PKCS", expanded: "Public-Key Cryptography Standards", genericIcon: "text-x-generic"},
+	"application/pkcs12":                                         {comment: "PKCS#12 certificate bundle", acronym: "PKCS", expanded: "Public-Key Cryptography Standards"},
+	"application/pkcs7-mime":                                     {comment: "PKCS#7 file", acronym: "PKCS", expanded: "Public-Key Cryptography Standards", genericIcon: "text-x-generic"},
+	"application/pkcs7-signature":                                {comment: "detached S/MIME signature", acronym: "S/MIME", expanded: "Secure/Multipurpose Internet Mail Extensions", genericIcon: "text-x-generic"},
+	"application/pkcs8":                                          {comment: "PKCS#8 private key", acronym: "PKCS", expanded: "Public-Key Cryptography Standards"},
+	"application/pkcs8-encrypted":                                {comment: "PKCS#8 private key (encrypted)", acronym: "PKCS", expanded: "Public-Key Cryptography Standards"},
+	"application/pkix-cert":                                      {comment: "X.509 certificate"},
+	"application/pkix-crl":                                       {comment: "certificate revocation list"},
+	"application/pkix-pkipath":                                   {comment: "PkiPath certification path"},
+	"application/pls":                                            {comment: "MP3 ShoutCast playlist"},
+	"application/postscript":                                     {comment: "PostScript document", genericIcon: "x-office-document"},
+	"application/powerpoint":                                     {comment: "PowerPoint presentation", genericIcon: "x-office-presentation"},
+	"application/prs.plucker":                                    {comment: "Plucker document", genericIcon: "x-office-document"},
+	"application/ram":                                            {comment: "RealMedia playlist"},
+	"application/raml+yaml":                                      {comment: "RAML document", acronym: "RAML", expanded: "RESTful API Modeling Language"},
+	"application/rdf+xml":                                        {comment: "RDF file", acronym: "RDF", expanded: "Resource Description Framework"},
+	"application/relax-ng-compact-syntax":                        {comment: "RELAX NG XML schema", acronym: "RELAX NG", expanded: "REgular LAnguage for XML Next Generation", genericIcon: "text-x-generic"},
+	"application/rss+xml":                                        {comment: "RSS summary", acronym: "RSS", expanded: "RDF Site Summary", genericIcon: "text-html"},
+	"application/rtf":                                            {comment: "RTF document", acronym: "RTF", expanded: "Rich Text Format", genericIcon: "x-office-document"},
+	"application/schema+json":                                    {comment: "JSON schema", genericIcon: "text-x-script"},
+	"application/sdp":                                            {comment: "SDP multicast stream file", acronym: "SDP", expanded: "Session Description Protocol", genericIcon: "video-x-generic"},
+	"application/sieve":                                          {comment: "Sieve mail filter script", genericIcon: "text-x-script"},
+	"application/smil":                                           {comment: "SMIL document", acronym: "SMIL", expanded: "Synchronized Multimedia Integration Language", genericIcon: "video-x-generic"},
+	"application/smil+xml":                                       {comment: "SMIL document", acronym: "SMIL", expanded: "Synchronized Multimedia Integration Language", genericIcon: "video-x-generic"},
+	"application/sparql-query":                                   {comment: "SPARQL query", acronym: "SPARQL", expanded: "SPARQL Protocol and RDF Query Language"},
+	"application/sparql-results+xml":                             {comment: "SPARQL query results", acronym: "SPARQL", expanded: "SPARQL Protocol and RDF Query Language"},
+	"application/sql":                                            {comment: "SQL code"},
+	"application/stuffit":                                        {comment: "StuffIt archive", genericIcon: "package-x-generic"},
+	"application/tga":                                            {comment: "TGA image", acronym: "TGA", expanded: "Truevision Graphics Adapter"},
+	"application/toml":                                           {comment: "TOML document", acronym: "TOML", expanded: "Tom's Obvious Minimal Language", genericIcon: "text-x-generic"},
+	"application/trig":                                           {comment: "TriG RDF document", acronym: "TriG", expanded: "TriG RDF Graph Triple Language"},
+	"application/vnd.adobe.flash.movie":                          {comment: "Shockwave Flash file", genericIcon: "video-x-generic"},
+	"application/vnd.adobe.illustrator":                          {comment: "Adobe Illustrator document", genericIcon: "image-x-generic"},
+	"application/vnd.amazon.mobi8-ebook":                         {comment: "Kindle book document"},
+	"application/vnd.android.package-archive":                    {comment: "Android package"},
+	"application/vnd.appimage":                                   {comment: "AppImage application bundle", genericIcon: "application-x-executable"},
+	"application/vnd.apple.keynote":                              {comment: "Apple Keynote 5 presentation", genericIcon: "x-office-presentation"},
+	"application/vnd.apple.mpegurl":                              {comment: "Media playlist"},
+	"application/vnd.apple.numbers":                              {comment: "Apple Numbers spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.apple.pages":                                {comment: "Apple Pages document", genericIcon: "x-office-document"},
+	"application/vnd.apple.pkpass":                               {comment: "Apple Wallet pass"},
+	"application/vnd.chess-pgn":                                  {comment: "PGN chess game notation", acronym: "PGN", expanded: "Portable Game Notation", genericIcon: "text-x-generic"},
+	"application/vnd.coffeescript":                               {comment: "CoffeeScript document", genericIcon: "text-x-script"},
+	"application/vnd.comicbook+zip":                              {comment: "comic book archive (zip container)", genericIcon: "x-office-document"},
+	"application/vnd.comicbook-rar":                              {comment: "comic book archive (rar container)", genericIcon: "x-office-document"},
+	"application/vnd.corel-draw":                                 {comment: "Corel Draw drawing", genericIcon: "image-x-generic"},
+	"application/vnd.debian.binary-package":                      {comment: "Debian package", genericIcon: "package-x-generic"},
+	"application/vnd.emusic-emusic_package":                      {comment: "eMusic download package", genericIcon: "package-x-generic"},
+	"application/vnd.flatpak":                                    {comment: "Flatpak application bundle", genericIcon: "package-x-generic"},
+	"application/vnd.flatpak.ref":                                {comment: "Flatpak repository reference", genericIcon: "package-x-generic"},
+	"application/vnd.flatpak.repo":                               {comment: "Flatpak repository description", genericIcon: "package-x-generic"},
+	"application/vnd.framemaker":                                 {comment: "Adobe FrameMaker document", genericIcon: "x-office-document"},
+	"application/vnd.geo+json":                                   {comment: "GeoJSON geospatial data"},
+	"application/vnd.google-earth.kml+xml":                       {comment: "KML geographic data", acronym: "KML", expanded: "Keyhole Markup Language"},
+	"application/vnd.google-earth.kmz":                           {comment: "KML geographic compressed data", acronym: "KML", expanded: "Keyhole Markup Language"},
+	"application/vnd.haansoft-hwp":                               {comment: "Haansoft Hangul document", genericIcon: "x-office-document"},
+	"application/vnd.haansoft-hwt":                               {comment: "Haansoft Hangul document template", genericIcon: "x-office-document"},
+	"application/vnd.hp-hpgl":                                    {comment: "HPGL file", acronym: "HPGL", expanded: "HP Graphics Language", genericIcon: "image-x-generic"},
+	"application/vnd.hp-pcl":                                     {comment: "PCL file", acronym: "PCL", expanded: "HP Printer Control Language", genericIcon: "image-x-generic"},
+	"application/vnd.iccprofile":                                 {comment: "ICC profile", acronym: "ICC", expanded: "International Color Consortium"},
+	"application/vnd.lotus-1-2-3":                                {comment: "Lotus 1-2-3 spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.lotus-wordpro":                              {comment: "Lotus Word Pro document", genericIcon: "x-office-document"},
+	"application/vnd.mozilla.xul+xml":                            {comment: "XUL interface document", acronym: "XUL", expanded: "XML User interface markup Language", genericIcon: "x-office-document"},
+	"application/vnd.ms-3mfdocument":                             {comment: "3MF document", acronym: "3MF", expanded: "3D Manufacturing Format"},
+	"application/vnd.ms-access":                                  {comment: "JET database", acronym: "JET", expanded: "Joint Engine Technology", genericIcon: "x-office-document"},
+	"application/vnd.ms-asf":                                     {comment: "ASF video", acronym: "ASF", expanded: "Advanced Streaming Format"},
+	"application/vnd.ms-cab-compressed":                          {comment: "Microsoft Cabinet archive", genericIcon: "package-x-generic"},
+	"application/vnd.ms-excel":                                   {comment: "Excel spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.ms-excel.addin.macroenabled.12":             {comment: "Excel add-in", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.ms-excel.sheet.binary.macroenabled.12":      {comment: "Excel 2007 binary spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.ms-excel.sheet.macroenabled.12":             {comment: "Excel spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.ms-excel.template.macroenabled.12":          {comment: "Excel spreadsheet template", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.ms-htmlhelp":                                {comment: "CHM document", acronym: "CHM", expanded: "Compiled Help Modules", genericIcon: "x-office-document"},
+	"application/vnd.ms-powerpoint":                              {comment: "PowerPoint presentation", genericIcon: "x-office-presentation"},
+	"application/vnd.ms-powerpoint.addin.macroenabled.12":        {comment: "PowerPoint add-in", genericIcon: "x-office-presentation"},
+	"application/vnd.ms-powerpoint.presentation.macroenabled.12": {comment: "PowerPoint presentation", genericIcon: "x-office-presentation"},
+	"application/vnd.ms-powerpoint.slide.macroenabled.12":        {comment: "PowerPoint slide", genericIcon: "x-office-presentation"},
+	"application/vnd.ms-powerpoint.slideshow.macroenabled.12":    {comment: "PowerPoint presentation", genericIcon: "x-office-presentation"},
+	"application/vnd.ms-powerpoint.template.macroenabled.12":     {comment: "PowerPoint presentation template", genericIcon: "x-office-presentation"},
+	"application/vnd.ms-publisher":                               {comment: "Microsoft Publisher document"},
+	"application/vnd.ms-tnef":                                    {comment: "TNEF message", acronym: "TNEF", expanded: "Transport Neutral Encapsulation Format"},
+	"application/vnd.ms-visio.drawing.macroenabled.main+xml":     {comment: "Office Open XML Visio drawing", genericIcon: "image-x-generic"},
+	"application/vnd.ms-visio.drawing.main+xml":                  {comment: "Office Open XML Visio drawing", genericIcon: "image-x-generic"},
+	"application/vnd.ms-visio.stencil.macroenabled.main+xml":     {comment: "Office Open XML Visio stencil", genericIcon: "image-x-generic"},
+	"application/vnd.ms-visio.stencil.main+xml":                  {comment: "Office Open XML Visio stencil", genericIcon: "image-x-generic"},
+	"application/vnd.ms-visio.template.macroenabled.main+xml":    {comment: "Office Open XML Visio template", genericIcon: "image-x-generic"},
+	"application/vnd.ms-visio.template.main+xml":                 {comment: "Office Open XML Visio template", genericIcon: "image-x-generic"},
+	"application/vnd.ms-word":                                    {comment: "Word document", genericIcon: "x-office-document"},
+	"application/vnd.ms-word.document.macroenabled.12":           {comment: "Word document", genericIcon: "x-office-document"},
+	"application/vnd.ms-word.template.macroenabled.12":           {comment: "Word document template", genericIcon: "x-office-document"},
+	"application/vnd.ms-works":                                   {comment: "Microsoft Works document", genericIcon: "x-office-document"},
+	"application/vnd.ms-wpl":                                     {comment: "WPL playlist", acronym: "WPL", expanded: "Windows Media Player Playlist", genericIcon: "video-x-generic"},
+	"application/vnd.ms-xpsdocument":                             {comment: "XPS document", acronym: "XPS", expanded: "XML Paper Specification", genericIcon: "x-office-document"},
+	"application/vnd.msaccess":                                   {comment: "JET database", acronym: "JET", expanded: "Joint Engine Technology", genericIcon: "x-office-document"},
+	"application/vnd.nintendo.snes.rom":                          {comment: "Super NES ROM", genericIcon: "application-x-executable"},
+	"application/vnd.oasis.docbook+xml":                          {comment: "DocBook document", genericIcon: "x-office-document"},
+	"application/vnd.oasis.opendocument.chart":                   {comment: "ODC chart", acronym: "ODC", expanded: "OpenDocument Chart", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.oasis.opendocument.chart-template":          {comment: "ODC template", acronym: "ODC", expanded: "OpenDocument Chart", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.oasis.opendocument.database":                {comment: "ODB database", acronym: "ODB", expanded: "OpenDocument Database", genericIcon: "x-office-document"},
+	"application/vnd.oasis.opendocument.formula":                 {comment: "ODF formula", acronym: "ODF", expanded: "OpenDocument Formula", genericIcon: "x-office-document"},
+	"application/vnd.oasis.opendocument.formula-template":        {comment: "ODF template", acronym: "ODF", expanded: "OpenDocument Formula", genericIcon: "x-office-document"},
+	"application/vnd.oasis.opendocument.graphics":                {comment: "ODG drawing", acronym: "ODG", expanded: "OpenDocument Drawing", genericIcon: "image-x-generic"},
+	"application/vnd.oasis.opendocument.graphics-flat-xml":       {comment: "ODG drawing (Flat XML)", acronym: "FODG", expanded: "OpenDocument Drawing (Flat XML)", genericIcon: "image-x-generic"},
+	"application/vnd.oasis.opendocument.graphics-template":       {comment: "ODG template", acronym: "ODG", expanded: "OpenDocument Drawing", genericIcon: "image-x-generic"},
+	"application/vnd.oasis.opendocument.image":                   {comment: "ODI image", acronym: "ODI", expanded: "OpenDocument Image", genericIcon: "image-x-generic"},
+	"application/vnd.oasis.opendocument.presentation":            {comment: "ODP presentation", acronym: "ODP", expanded: "OpenDocument Presentation", genericIcon: "x-office-presentation"},
+	"application/vnd.oasis.opendocument.presentation-flat-xml":   {comment: "ODP presentation (Flat XML)", acronym: "FODP", expanded: "OpenDocument Presentation (Flat XML)", genericIcon: "x-office-presentation"},
+	"application/vnd.oasis.opendocument.presentation-template":   {comment: "ODP template", acronym: "ODP", expanded: "OpenDocument Presentation", genericIcon: "x-office-presentation"},
+	"application/vnd.oasis.opendocument.spreadsheet":             {comment: "ODS spreadsheet", acronym: "ODS", expanded: "OpenDocument Spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.oasis.opendocument.spreadsheet-flat-xml":    {comment: "ODS spreadsheet (Flat XML)", acronym: "FODS", expanded: "OpenDocument Spreadsheet (Flat XML)", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.oasis.opendocument.spreadsheet-template":    {comment: "ODS template", acronym: "ODS", expanded: "OpenDocument Spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.oasis.opendocument.text":                    {comment: "ODT document", acronym: "ODT", expanded: "OpenDocument Text", genericIcon: "x-office-document"},
+	"application/vnd.oasis.opendocument.text-flat-xml":           {comment: "ODT document (Flat XML)", acronym: "FODT", expanded: "OpenDocument Text (Flat XML)", genericIcon: "x-office-document"},
+	"application/vnd.oasis.opendocument.text-master":             {comment: "ODM document", acronym: "ODM", expanded: "OpenDocument Master", genericIcon: "x-office-document"},
+	"application/vnd.oasis.opendocument.text-template":           {comment: "ODT template", acronym: "ODT", expanded: "OpenDocument Text", genericIcon: "x-office-document"},
+	"application/vnd.oasis.opendocument.text-web":                {comment: "OTH template", acronym: "OTH", expanded: "OpenDocument HTML", genericIcon: "text-html"},
+	"application/vnd.openofficeorg.extension":                    {comment: "OpenOffice.org extension", genericIcon: "x-office-document"},
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": {comment: "PowerPoint 2007 presentation", genericIcon: "x-office-presentation"},
+	"application/vnd.openxmlformats-officedocument.presentationml.slide":        {comment: "PowerPoint 2007 slide", genericIcon: "x-office-presentation"},
+	"application/vnd.openxmlformats-officedocument.presentationml.slideshow":    {comment: "PowerPoint 2007 show", genericIcon: "x-office-presentation"},
+	"application/vnd.openxmlformats-officedocument.presentationml.template":     {comment: "PowerPoint 2007 presentation template", genericIcon: "x-office-presentation"},
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         {comment: "Excel 2007 spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.template":      {comment: "Excel 2007 spreadsheet template", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   {comment: "Word 2007 document", genericIcon: "x-office-document"},
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.template":   {comment: "Word 2007 document template", genericIcon: "x-office-document"},
+	"application/vnd.palm":                       {comment: "Palm OS database"},
+	"application/vnd.rar":                        {comment: "RAR archive", acronym: "RAR", expanded: "Roshal ARchive", genericIcon: "package-x-generic"},
+	"application/vnd.rn-realmedia":               {comment: "RealMedia document", genericIcon: "video-x-generic"},
+	"application/vnd.rn-realmedia-vbr":           {comment: "RealMedia document", genericIcon: "video-x-generic"},
+	"application/vnd.sdp":                        {comment: "SDP multicast stream file", acronym: "SDP", expanded: "Session Description Protocol", genericIcon: "video-x-generic"},
+	"application/vnd.smaf":                       {comment: "SMAF audio", acronym: "SMAF", expanded: "Synthetic music Mobile Application Format", genericIcon: "audio-x-generic"},
+	"application/vnd.snap":                       {comment: "Snap package"},
+	"application/vnd.sqlite3":                    {comment: "SQLite3 database"},
+	"application/vnd.squashfs":                   {comment: "Squashfs filesystem image"},
+	"application/vnd.stardivision.calc":          {comment: "StarCalc spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.stardivision.chart":         {comment: "StarChart chart", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.stardivision.draw":          {comment: "StarDraw drawing", genericIcon: "image-x-generic"},
+	"application/vnd.stardivision.impress":       {comment: "StarImpress presentation", genericIcon: "x-office-presentation"},
+	"application/vnd.stardivision.mail":          {comment: "StarMail email"},
+	"application/vnd.stardivision.math":          {comment: "StarMath formula", genericIcon: "x-office-document"},
+	"application/vnd.stardivision.writer":        {comment: "StarWriter document", genericIcon: "x-office-document"},
+	"application/vnd.stardivision.writer-global": {comment: "StarWriter document", genericIcon: "x-office-document"},
+	"application/vnd.sun.xml.base":               {comment: "ODB database", acronym: "ODB", expanded: "OpenDocument Database", genericIcon: "x-office-document"},
+	"application/vnd.sun.xml.calc":               {comment: "OpenOffice Calc spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.sun.xml.calc.template":      {comment: "OpenOffice Calc template", genericIcon: "x-office-spreadsheet"},
+	"application/vnd.sun.xml.draw":               {comment: "OpenOffice Draw drawing", genericIcon: "image-x-generic"},
+	"application/vnd.sun.xml.draw.template":      {comment: "OpenOffice Draw template", genericIcon: "image-x-generic"},
+	"application/vnd.sun.xml.impress":            {comment: "OpenOffice Impress presentation", genericIcon: "x-office-presentation"},
+	"application/vnd.sun.xml.impress.template":   {comment: "OpenOffice Impress template", genericIcon: "x-office-presentation"},
+	"application/vnd.sun.xml.math":               {comment: "OpenOffice Math formula", genericIcon: "x-office-document"},
+	"application/vnd.sun.xml.writer":             {comment: "OpenOffice Writer document", genericIcon: "x-office-document"},
+	"application/vnd.sun.xml.writer.global":      {comment: "OpenOffice Writer global document", genericIcon: "x-office-document"},
+	"application/vnd.sun.xml.writer.template":    {comment: "OpenOffice Writer template", genericIcon: "x-office-document"},
+	"application/vnd.symbian.install":            {comment: "SIS package", acronym: "SIS", expanded: "Symbian Installation File", genericIcon: "package-x-generic"},
+	"application/vnd.tcpdump.pcap":               {comment: "network packet capture"},
+	"application/vnd.visio":                      {comment: "Microsoft Visio document", genericIcon: "x-office-document"},
+	"application/vnd.wordperfect":                {comment: "WordPerfect document", genericIcon: "x-office-document"},
+	"application/vnd.xdgapp":                     {comment: "Flatpak application bundle", genericIcon: "package-x-generic"},
+	"application/vnd.youtube.yt":                 {comment: "YouTube media archive", genericIcon: "video-x-generic"},
+	"application/winhlp":                         {comment: "WinHelp help file"},
+	"application/wk1":                            {comment: "Lotus 1-2-3 spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/wmf":                            {comment: "WMF image", acronym: "WMF", expanded: "Windows Metafile"},
+	"application/wordperfect":                    {comment: "WordPerfect document", genericIcon: "x-office-document"},
+	"application/wwf":                            {comment: "WWF document", genericIcon: "x-office-document"},
+	"application/x-123":                          {comment: "Lotus 1-2-3 spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-7z-compressed":                {comment: "7-zip archive", genericIcon: "package-x-generic"},
+	"application/x-abiword":                      {comment: "AbiWord document", genericIcon: "x-office-document"},
+	"application/x-ace":                          {comment: "ACE archive", genericIcon: "package-x-generic"},
+	"application/x-alz":                          {comment: "Alzip archive", genericIcon: "package-x-generic"},
+	"application/x-amiga-disk-format":            {comment: "Amiga disk image"},
+	"application/x-amipro":                       {comment: "Lotus AmiPro document", genericIcon: "x-office-document"},
+	"application/x-annodex":                      {comment: "Annodex exchange format", genericIcon: "video-x-generic"},
+	"application/x-aportisdoc":                   {comment: "AportisDoc document", genericIcon: "x-office-document"},
+	"application/x-apple-diskimage":              {comment: "Apple disk image"},
+	"application/x-apple-systemprofiler+xml":     {comment: "Apple System Profiler"},
+	"application/x-appleworks-document":          {comment: "AppleWorks document", genericIcon: "x-office-document"},
+	"application/x-applix-spreadsheet":           {comment: "Applix Spreadsheets spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-applix-word":                  {comment: "Applix Words document", genericIcon: "x-office-document"},
+	"application/x-arc":                          {comment: "ARC archive", genericIcon: "package-x-generic"},
+	"application/x-archive":                      {comment: "AR archive", genericIcon: "package-x-generic"},
+	"application/x-arj":                          {comment: "ARJ archive", acronym: "ARJ", expanded: "Archived by Robert Jung", genericIcon: "package-x-generic"},
+	"application/x-asar":                         {comment: "Electron Archive (ASAR)", acronym: "ASAR", expanded: "Atom Shell Archive Format"},
+	"application/x-asp":                          {comment: "ASP page", acronym: "ASP", expanded: "Active Server Page", genericIcon: "text-x-script"},
+	"application/x-atari-2600-rom":               {comment: "Atari 2600 ROM", genericIcon: "application-x-executable"},
+	"application/x-atari-7800-rom":               {comment: "Atari 7800 ROM", genericIcon: "application-x-executable"},
+	"application/x-atari-lynx-rom":               {comment: "Atari Lynx ROM", genericIcon: "application-x-executable"},
+	"application/x-awk":                          {comment: "AWK script", genericIcon: "text-x-script"},
+	"application/x-bcpio":                        {comment: "BCPIO archive", acronym: "BCPIO", expanded: "Binary CPIO", genericIcon: "package-x-generic"},
+	"application/x-bittorrent":                   {comment: "BitTorrent seed file"},
+	"application/x-blender":                      {comment: "Blender scene", genericIcon: "image-x-generic"},
+	"application/x-bps-patch":                    {comment: "BPS patch", acronym: "BPS", expanded: "Binary Patching System"},
+	"application/x-bsdiff":                       {comment: "binary differences between files"},
+	"application/x-bzdvi":                        {comment: "TeX DVI document (bzip-compressed)", genericIcon: "x-office-document"},
+	"application/x-bzip":                         {comment: "Bzip archive", genericIcon: "package-x-generic"},
+	"application/x-bzip-compressed-tar":          {comment: "Tar archive (bzip-compressed)", genericIcon: "package-x-generic"},
+	"application/x-bzip2":                        {comment: "Bzip archive", genericIcon: "package-x-generic"},
+	"application/x-bzpdf":                        {comment: "PDF document (bzip-compressed)", genericIcon: "x-office-document"},
+	"application/x-bzpostscript":                 {comment: "PostScript document (bzip-compressed)", genericIcon: "x-office-document"},
+	"application/x-cb7":                          {comment: "comic book archive (7z container)", genericIcon: "x-office-document"},
+	"application/x-cbr":                          {comment: "comic book archive (rar container)", genericIcon: "x-office-document"},
+	"application/x-cbt":                          {comment: "comic book archive (tar container)", genericIcon: "x-office-document"},
+	"application/x-cbz":                          {comment: "comic book archive (zip container)", genericIcon: "x-office-document"},
+	"application/x-ccmx":                         {comment: "CCMX color correction file", genericIcon: "text-x-generic"},
+	"application/x-cd-image":                     {comment: "raw CD image"},
+	"application/x-cdr":                          {comment: "Corel Draw drawing", genericIcon: "image-x-generic"},
+	"application/x-cdrdao-toc":                   {comment: "CD Table Of Contents", genericIcon: "text-x-generic"},
+	"application/x-chess-pgn":                    {comment: "PGN chess game notation", acronym: "PGN", expanded: "Portable Game Notation", genericIcon: "text-x-generic"},
+	"application/x-chm":                          {comment: "CHM document", acronym: "CHM", expanded: "Compiled Help Modules", genericIcon: "x-office-document"},
+	"application/x-cisco-vpn-settings":           {comment: "Cisco VPN settings", genericIcon: "text-x-generic"},
+	"application/x-class-file":                   {comment: "Java byte code"},
+	"application/x-compress":                     {comment: "UNIX-compressed file", genericIcon: "package-x-generic"},
+	"application/x-compressed-iso":               {comment: "Compressed CD image"},
+	"application/x-compressed-tar":               {comment: "Tar archive (gzip-compressed)", genericIcon: "package-x-generic"},
+	"application/x-core":                         {comment: "program crash data"},
+	"application/x-coreldraw":                    {comment: "Corel Draw drawing", genericIcon: "image-x-generic"},
+	"application/x-cpio":                         {comment: "CPIO archive", genericIcon: "package-x-generic"},
+	"application/x-cpio-compressed":              {comment: "CPIO archive (gzip-compressed)", genericIcon: "package-x-generic"},
+	"application/x-csh":                          {comment: "C shell script", genericIcon: "text-x-script"},
+	"application/x-cue":                          {comment: "CD image cuesheet", genericIcon: "text-x-generic"},
+	"application/x-dar":                          {comment: "DAR archive", acronym: "DAR", expanded: "Disk ARchive", genericIcon: "package-x-generic"},
+	"application/x-dbase":                        {comment: "Xbase document", genericIcon: "x-office-document"},
+	"application/x-dbf":                          {comment: "Xbase document", genericIcon: "x-office-document"},
+	"application/x-deb":                          {comment: "Debian package", genericIcon: "package-x-generic"},
+	"application/x-debian-package":               {comment: "Debian package", genericIcon: "package-x-generic"},
+	"application/x-designer":                     {comment: "Qt Designer interface document", genericIcon: "x-office-document"},
+	"application/x-desktop":                      {comment: "desktop entry", genericIcon: "text-x-generic"},
+	"application/x-dia-diagram":                  {comment: "Dia diagram", genericIcon: "image-x-generic"},
+	"application/x-dia-shape":                    {comment: "Dia shape", genericIcon: "image-x-generic"},
+	"application/x-discjuggler-cd-image":         {comment: "Padus DiscJuggler CD image"},
+	"application/x-docbook+xml":                  {comment: "DocBook document", genericIcon: "x-office-document"},
+	"application/x-doom-wad":                     {comment: "Doom WAD file", acronym: "WAD", expanded: "Where's All the Data", genericIcon: "package-x-generic"},
+	"application/x-dreamcast-rom":                {comment: "Dreamcast disc image", genericIcon: "application-x-executable"},
+	"application/x-dvi":                          {comment: "TeX DVI document", acronym: "DVI", expanded: "Device independent file format", genericIcon: "x-office-document"},
+	"application/x-e-theme":                      {comment: "Enlightenment theme"},
+	"application/x-egon":                         {comment: "Egon Animator animation", genericIcon: "image-x-generic"},
+	"application/x-emf":                          {comment: "EMF image", acronym: "EMF", expanded: "Enhanced MetaFile"},
+	"application/x-executable":                   {comment: "executable", genericIcon: "application-x-executable"},
+	"application/x-fd-file":                      {comment: "Floppy disk image"},
+	"application/x-fds-disk":                     {comment: "Nintendo FDS disk image", acronym: "FDS", expanded: "Famicom Disk System"},
+	"application/x-fictionbook":                  {comment: "FictionBook document"},
+	"application/x-fictionbook+xml":              {comment: "FictionBook document"},
+	"application/x-flash-video":                  {comment: "Flash video", genericIcon: "video-x-generic"},
+	"application/x-fluid":                        {comment: "FLTK Fluid file", acronym: "FLTK", expanded: "Fast Light Toolkit", genericIcon: "x-office-document"},
+	"application/x-font-afm":                     {comment: "Adobe font metrics", genericIcon: "font-x-generic"},
+	"application/x-font-bdf":                     {comment: "BDF font", genericIcon: "font-x-generic"},
+	"application/x-font-dos":                     {comment: "DOS font", genericIcon: "font-x-generic"},
+	"application/x-font-framemaker":              {comment: "Adobe FrameMaker font", genericIcon: "font-x-generic"},
+	"application/x-font-libgrx":                  {comment: "LIBGRX font", genericIcon: "font-x-generic"},
+	"application/x-font-linux-psf":               {comment: "Linux PSF console font", acronym: "PSF", expanded: "PC Screen Font", genericIcon: "font-x-generic"},
+	"application/x-font-otf":                     {comment: "OpenType font", genericIcon: "font-x-generic"},
+	"application/x-font-pcf":                     {comment: "PCF font", acronym: "PCF", expanded: "Portable Compiled Format", genericIcon: "font-x-generic"},
+	"application/x-font-speedo":                  {comment: "Speedo font", genericIcon: "font-x-generic"},
+	"application/x-font-sunos-news":              {comment: "SunOS News font", genericIcon: "font-x-generic"},
+	"application/x-font-tex":                     {comment: "TeX font", genericIcon: "font-x-generic"},
+	"application/x-font-tex-tfm":                 {comment: "TeX font metrics", genericIcon: "font-x-generic"},
+	"application/x-font-ttf":                     {comment: "TrueType font", genericIcon: "font-x-generic"},
+	"application/x-font-ttx":                     {comment: "TrueType XML font", genericIcon: "font-x-generic"},
+	"application/x-font-type1":                   {comment: "PostScript type-1 font", genericIcon: "font-x-generic"},
+	"application/x-font-vfont":                   {comment: "V font", genericIcon: "font-x-generic"},
+	"application/x-frame":                        {comment: "Adobe FrameMaker document", genericIcon: "x-office-document"},
+	"application/x-gameboy-color-rom":            {comment: "Game Boy Color ROM", genericIcon: "application-x-executable"},
+	"application/x-gameboy-rom":                  {comment: "Game Boy ROM", genericIcon: "application-x-executable"},
+	"application/x-gamecube-iso-image":           {comment: "GameCube disc image", genericIcon: "application-x-executable"},
+	"application/x-gamecube-rom":                 {comment: "GameCube disc image", genericIcon: "application-x-executable"},
+	"application/x-gamegear-rom":                 {comment: "Game Gear ROM", genericIcon: "application-x-executable"},
+	"application/x-gba-rom":                      {comment: "Game Boy Advance ROM", genericIcon: "application-x-executable"},
+	"application/x-gd-rom-cue":                   {comment: "GD-ROM image cuesheet", genericIcon: "text-x-generic"},
+	"application/x-gdbm":                         {comment: "GDBM database", acronym: "GDBM", expanded: "GNU Database Manager"},
+	"application/x-gdscript":                     {comment: "GDScript script"},
+	"application/x-gedcom":                       {comment: "GEDCOM family history", acronym: "GEDCOM", expanded: "GEnealogical Data COMmunication", genericIcon: "x-office-document"},
+	"application/x-genesis-32x-rom":              {comment: "Genesis 32X ROM", genericIcon: "application-x-executable"},
+	"application/x-genesis-rom":                  {comment: "Genesis ROM", genericIcon: "application-x-executable"},
+	"application/x-gettext":                      {comment: "translation file"},
+	"application/x-gettext-translation":          {comment: "translated messages (machine-readable)"},
+	"application/x-glade":                        {comment: "Glade project", genericIcon: "x-office-document"},
+	"application/x-gnome-app-info":               {comment: "desktop entry", genericIcon: "text-x-generic"},
+	"application/x-gnucash":                      {comment: "GnuCash financial data", genericIcon: "x-office-spreadsheet"},
+	"application/x-gnumeric":                     {comment: "Gnumeric spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-gnuplot":                      {comment: "Gnuplot document", genericIcon: "x-office-document"},
+	"application/x-go-sgf":                       {comment: "SGF record", acronym: "SGF", expanded: "Smart Game Format", genericIcon: "text-x-generic"},
+	"application/x-godot-project":                {comment: "Godot Engine project"},
+	"application/x-godot-resource":               {comment: "Godot Engine resource"},
+	"application/x-godot-scene":                  {comment: "Godot Engine scene"},
+	"application/x-godot-shader":                 {comment: "Godot Engine shader"},
+	"application/x-gpx":                          {comment: "GPX geographic data", acronym: "GPX", expanded: "GPS Exchange Format"},
+	"application/x-gpx+xml":                      {comment: "GPX geographic data", acronym: "GPX", expanded: "GPS Exchange Format"},
+	"application/x-graphite":                     {comment: "Graphite scientific graph", genericIcon: "x-office-document"},
+	"application/x-gtar":                         {comment: "Tar archive", genericIcon: "package-x-generic"},
+	"application/x-gtk-builder":                  {comment: "GTK+ Builder interface document", genericIcon: "x-office-document"},
+	"application/x-gtktalog":                     {comment: "GTKtalog catalog", genericIcon: "x-office-document"},
+	"application/x-gz-font-linux-psf":            {comment: "Linux PSF console font (gzip-compressed)", acronym: "PSF", expanded: "PC Screen Font", genericIcon: "font-x-generic"},
+	"application/x-gzdvi":                        {comment: "TeX DVI document (gzip-compressed)", genericIcon: "x-office-document"},
+	"application/x-gzip":                         {comment: "Gzip archive", genericIcon: "package-x-generic"},
+	"application/x-gzpdf":                        {comment: "PDF document (gzip-compressed)", genericIcon: "x-office-document"},
+	"application/x-gzpostscript":                 {comment: "PostScript document (gzip-compressed)", genericIcon: "x-office-document"},
+	"application/x-hdf":                          {comment: "HDF document", acronym: "HDF", expanded: "Hierarchical Data Format", genericIcon: "x-office-document"},
+	"application/x-hfe-file":                     {comment: "HFE floppy disk image", acronym: "HFE", expanded: "HxC Floppy Emulator", genericIcon: "application-x-executable"},
+	"application/x-hfe-floppy-image":             {comment: "HFE floppy disk image", acronym: "HFE", expanded: "HxC Floppy Emulator", genericIcon: "application-x-executable"},
+	"application/x-hwp":                          {comment: "Haansoft Hangul document", genericIcon: "x-office-document"},
+	"application/x-hwt":                          {comment: "Haansoft Hangul document template", genericIcon: "x-office-document"},
+	"application/x-ica":                          {comment: "Citrix ICA settings file", acronym: "ICA", expanded: "Independent Computing Architecture", genericIcon: "text-x-generic"},
+	"application/x-iff":                          {comment: "IFF file", acronym: "IFF", expanded: "Interchange File Format"},
+	"application/x-ipod-firmware":                {comment: "iPod firmware"},
+	"application/x-ips-patch":                    {comment: "IPS patch", acronym: "IPS", expanded: "International Patching System"},
+	"application/x-ipynb+json":                   {comment: "Jupyter notebook document", genericIcon: "x-office-document"},
+	"application/x-iso9660-appimage":             {comment: "AppImage application bundle", genericIcon: "application-x-executable"},
+	"application/x-iso9660-image":                {comment: "raw CD image"},
+	"application/x-it87":                         {comment: "IT 8.7 color calibration file", genericIcon: "text-x-generic"},
+	"application/x-iwork-keynote-sffkey":         {comment: "Apple Keynote 5 presentation", genericIcon: "x-office-presentation"},
+	"application/x-iwork-numbers-sffnumbers":     {comment: "Apple Numbers spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-iwork-pages-sffpages":         {comment: "Apple Pages document", genericIcon: "x-office-document"},
+	"application/x-jar":                          {comment: "Java archive", genericIcon: "package-x-generic"},
+	"application/x-java":                         {comment: "Java class"},
+	"application/x-java-archive":                 {comment: "Java archive", genericIcon: "package-x-generic"},
+	"application/x-java-class":                   {comment: "Java class"},
+	"application/x-java-jce-keystore":            {comment: "Java JCE keystore", acronym: "JCE", expanded: "Java Cryptography Extension"},
+	"application/x-java-jnlp-file":               {comment: "JNLP file", acronym: "JNLP", expanded: "Java Network Launching Protocol", genericIcon: "text-x-script"},
+	"application/x-java-keystore":                {comment: "Java keystore"},
+	"application/x-java-pack200":                 {comment: "Pack200 Java archive", genericIcon: "package-x-generic"},
+	"application/x-java-vm":                      {comment: "Java class"},
+	"application/x-javascript":                   {comment: "JavaScript program", genericIcon: "text-x-script"},
+	"application/x-jbuilder-project":             {comment: "JBuilder project", genericIcon: "x-office-document"},
+	"application/x-karbon":                       {comment: "Karbon14 drawing", genericIcon: "image-x-generic"},
+	"application/x-kchart":                       {comment: "KChart chart", genericIcon: "x-office-spreadsheet"},
+	"application/x-kexi-connectiondata":          {comment: "Kexi settings"},
+	"application/x-kexiproject-shortcut":         {comment: "Kexi shortcut"},
+	"application/x-kexiproject-sqlite":           {comment: "Kexi database file"},
+	"application/x-kexiproject-sqlite2":          {comment: "Kexi database file"},
+	"application/x-kexiproject-sqlite3":          {comment: "Kexi database file"},
+	"application/x-kformula":                     {comment: "KFormula formula", genericIcon: "x-office-document"},
+	"application/x-killustrator":                 {comment: "KIllustrator drawing", genericIcon: "image-x-generic"},
+	"application/x-kivio":                        {comment: "Kivio flowchart", genericIcon: "x-office-document"},
+	"application/x-kontour":                      {comment: "Kontour drawing", genericIcon: "image-x-generic"},
+	"application/x-kpovmodeler":                  {comment: "KPovModeler scene", genericIcon: "image-x-generic"},
+	"application/x-kpresenter":                   {comment: "KPresenter presentation", genericIcon: "x-office-presentation"},
+	"application/x-krita":                        {comment: "Krita document", genericIcon: "x-office-document"},
+	"application/x-kspread":                      {comment: "KSpread spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-kspread-crypt":                {comment: "KSpread spreadsheet (encrypted)", genericIcon: "x-office-spreadsheet"},
+	"application/x-ksysv-package":                {comment: "KSysV init package", genericIcon: "package-x-generic"},
+	"application/x-kugar":                        {comment: "Kugar document", genericIcon: "x-office-document"},
+	"application/x-kword":                        {comment: "KWord document", genericIcon: "x-office-document"},
+	"application/x-kword-crypt":                  {comment: "KWord document (encrypted)", genericIcon: "x-office-document"},
+	"application/x-lha":                          {comment: "LHA archive", genericIcon: "package-x-generic"},
+	"application/x-lhz":                          {comment: "LHZ archive", genericIcon: "package-x-generic"},
+	"application/x-linguist":                     {comment: "message catalog"},
+	"application/x-lotus123":                     {comment: "Lotus 1-2-3 spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-lrzip":                        {comment: "Lrzip archive", acronym: "Lrzip", expanded: "Long Range Zip", genericIcon: "package-x-generic"},
+	"application/x-lrzip-compressed-tar":         {comment: "Tar archive (lrzip-compressed)", genericIcon: "package-x-generic"},
+	"application/x-lyx":                          {comment: "LyX document", genericIcon: "x-office-document"},
+	"application/x-lz4":                          {comment: "LZ4 archive", genericIcon: "package-x-generic"},
+	"application/x-lz4-compressed-tar":           {comment: "Tar archive (LZ4-compressed)", genericIcon: "package-x-generic"},
+	"application/x-lzh-compressed":               {comment: "LHA archive", genericIcon: "package-x-generic"},
+	"application/x-lzip":                         {comment: "Lzip archive", genericIcon: "package-x-generic"},
+	"application/x-lzip-compressed-tar":          {comment: "Tar archive (lzip-compressed)", genericIcon: "package-x-generic"},
+	"application/x-lzma":                         {comment: "LZMA archive", acronym: "LZMA", expanded: "Lempel-Ziv-Markov chain-Algorithm", genericIcon: "package-x-generic"},
+	"application/x-lzma-compressed-tar":          {comment: "Tar archive (LZMA-compressed)", genericIcon: "package-x-generic"},
+	"application/x-lzop":                         {comment: "LZO archive", acronym: "LZO", expanded: "Lempel-Ziv-Oberhumer", genericIcon: "package-x-generic"},
+	"application/x-lzpdf":                        {comment: "PDF document (lzip-compressed)", genericIcon: "x-office-document"},
+	"application/x-m4":                           {comment: "M4 macro", genericIcon: "text-x-script"},
+	"application/x-macbinary":                    {comment: "Macintosh MacBinary file", genericIcon: "package-x-generic"},
+	"application/x-magicpoint":                   {comment: "MagicPoint presentation", genericIcon: "x-office-presentation"},
+	"application/x-mame-chd":                     {comment: "MAME compressed hard disk image", genericIcon: "application-x-executable"},
+	"application/x-markaby":                      {comment: "Markaby script", genericIcon: "text-x-script"},
+	"application/x-mathematica":                  {comment: "Mathematica Notebook file", genericIcon: "x-office-document"},
+	"application/x-matroska":                     {comment: "Matroska stream", genericIcon: "video-x-generic"},
+	"application/x-mdb":                          {comment: "JET database", acronym: "JET", expanded: "Joint Engine Technology", genericIcon: "x-office-document"},
+	"application/x-mif":                          {comment: "Adobe FrameMaker MIF document"},
+	"application/x-mimearchive":                  {comment: "MHTML web archive", acronym: "MHTML", expanded: "MIME HTML"},
+	"application/x-mobi8-ebook":                  {comment: "Kindle book document"},
+	"application/x-mobipocket-ebook":             {comment: "Mobipocket e-book", genericIcon: "x-office-document"},
+	"application/x-mozilla-bookmarks":            {comment: "Mozilla bookmarks", genericIcon: "text-html"},
+	"application/x-ms-asx":                       {comment: "Microsoft ASX playlist"},
+	"application/x-ms-dos-executable":            {comment: "DOS/Windows executable", genericIcon: "application-x-executable"},
+	"application/x-ms-wim":                       {comment: "WIM disk image", acronym: "WIM", expanded: "Windows Imaging Format"},
+	"application/x-msaccess":                     {comment: "JET database", acronym: "JET", expanded: "Joint Engine Technology", genericIcon: "x-office-document"},
+	"application/x-msexcel":                      {comment: "Excel spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-msi":                          {comment: "Windows Installer package"},
+	"application/x-msmetafile":                   {comment: "WMF image", acronym: "WMF", expanded: "Windows Metafile"},
+	"application/x-mspowerpoint":                 {comment: "PowerPoint presentation", genericIcon: "x-office-presentation"},
+	"application/x-mswinurl":                     {comment: "Internet shortcut"},
+	"application/x-msword":                       {comment: "Word document", genericIcon: "x-office-document"},
+	"application/x-mswrite":                      {comment: "WRI document", genericIcon: "x-office-document"},
+	"application/x-msx-rom":                      {comment: "MSX ROM", genericIcon: "application-x-executable"},
+	"application/x-n64-rom":                      {comment: "Nintendo64 ROM", genericIcon: "application-x-executable"},
+	"application/x-nautilus-link":                {comment: "Nautilus link", genericIcon: "text-x-generic"},
+	"application/x-navi-animation":               {comment: "Windows animated cursor"},
+	"application/x-neo-geo-pocket-color-rom":     {comment: "Neo-Geo Pocket Color ROM", genericIcon: "application-x-executable"},
+	"application/x-neo-geo-pocket-rom":           {comment: "Neo-Geo Pocket ROM", genericIcon: "application-x-executable"},
+	"application/x-nes-rom":                      {comment: "NES ROM", genericIcon: "application-x-executable"},
+	"application/x-netcdf":                       {comment: "Unidata NetCDF document", acronym: "NetCDF", expanded: "Network Common Data Form", genericIcon: "x-office-document"},
+	"application/x-netscape-bookmarks":           {comment: "Mozilla bookmarks", genericIcon: "text-html"},
+	"application/x-netshow-channel":              {comment: "Windows Media Station file", genericIcon: "video-x-generic"},
+	"application/x-nintendo-3ds-executable":      {comment: "Nintendo 3DS Executable", genericIcon: "application-x-executable"},
+	"application/x-nintendo-3ds-rom":             {comment: "Nintendo 3DS ROM", genericIcon: "application-x-executable"},
+	"application/x-nintendo-ds-rom":              {comment: "Nintendo DS ROM", genericIcon: "application-x-executable"},
+	"application/x-nzb":                          {comment: "NewzBin usenet index"},
+	"application/x-object":                       {comment: "object code", genericIcon: "x-office-document"},
+	"application/x-ogg":                          {comment: "Ogg multimedia file", genericIcon: "video-x-generic"},
+	"application/x-ole-storage":                  {comment: "OLE2 compound document storage", genericIcon: "x-office-document"},
+	"application/x-oleo":                         {comment: "GNU Oleo spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-openzim":                      {comment: "OpenZIM file", acronym: "ZIM", expanded: "Zeno IMproved"},
+	"application/x-pagemaker":                    {comment: "Adobe PageMaker document", genericIcon: "x-office-document"},
+	"application/x-pak":                          {comment: "PAK archive", genericIcon: "package-x-generic"},
+	"application/x-palm-database":                {comment: "Palm OS database"},
+	"application/x-par2":                         {comment: "Parchive archive", acronym: "Parchive", expanded: "Parity Volume Set Archive", genericIcon: "package-x-generic"},
+	"application/x-partial-download":             {comment: "Partially downloaded file", genericIcon: "package-x-generic"},
+	"application/x-pc-engine-rom":                {comment: "PC Engine ROM", genericIcon: "application-x-executable"},
+	"application/x-pcap":                         {comment: "network packet capture"},
+	"application/x-pdf":                          {comment: "PDF document", acronym: "PDF", expanded: "Portable Document Format", genericIcon: "x-office-document"},
+	"application/x-pef-executable":               {comment: "PEF executable", acronym: "PEF", expanded: "Preferred Executable Format", genericIcon: "application-x-executable"},
+	"application/x-perl":                         {comment: "Perl script", genericIcon: "text-x-script"},
+	"application/x-photoshop":                    {comment: "Photoshop image"},
+	"application/x-php":                          {comment: "PHP script", genericIcon: "text-x-script"},
+	"application/x-pkcs12":                       {comment: "PKCS#12 certificate bundle", acronym: "PKCS", expanded: "Public-Key Cryptography Standards"},
+	"application/x-pkcs7-certificates":           {comment: "PKCS#7 certificate bundle", acronym: "PKCS", expanded: "Public-Key Cryptography Standards"},
+	"application/x-planperfect":                  {comment: "PlanPerfect spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-pocket-word":                  {comment: "Pocket Word document", genericIcon: "x-office-document"},
+	"application/x-profile":                      {comment: "profiler results", genericIcon: "text-x-generic"},
+	"application/x-pw":                           {comment: "Pathetic Writer document", genericIcon: "x-office-document"},
+	"application/x-pyspread-bz-spreadsheet":      {comment: "Pyspread spreadsheet (bzip-compressed)", genericIcon: "x-office-spreadsheet"},
+	"application/x-pyspread-spreadsheet":         {comment: "Pyspread spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-python-bytecode":              {comment: "Python bytecode"},
+	"application/x-qed-disk":                     {comment: "QEMU QED disk image", acronym: "QED", expanded: "QEMU Enhanced Disk"},
+	"application/x-qemu-disk":                    {comment: "QEMU QCOW disk image", acronym: "QCOW", expanded: "QEMU Copy On Write"},
+	"application/x-qpress":                       {comment: "Qpress archive", genericIcon: "package-x-generic"},
+	"application/x-qtiplot":                      {comment: "QtiPlot document", genericIcon: "x-office-document"},
+	"application/x-quattropro":                   {comment: "Quattro Pro spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-quicktime-media-link":         {comment: "QuickTime playlist", genericIcon: "video-x-generic"},
+	"application/x-quicktimeplayer":              {comment: "QuickTime playlist", genericIcon: "video-x-generic"},
+	"application/x-qw":                           {comment: "Quicken document", genericIcon: "x-office-spreadsheet"},
+	"application/x-rar":                          {comment: "RAR archive", acronym: "RAR", expanded: "Roshal ARchive", genericIcon: "package-x-generic"},
+	"application/x-rar-compressed":               {comment: "RAR archive", acronym: "RAR", expanded: "Roshal ARchive", genericIcon: "package-x-generic"},
+	"application/x-raw-disk-image":               {comment: "Raw disk image"},
+	"application/x-raw-disk-image-xz-compressed": {comment: "Raw disk image (XZ-compressed)"},
+	"application/x-raw-floppy-disk-image":        {comment: "Floppy disk image"},
+	"application/x-redhat-package-manager":       {comment: "RPM package", genericIcon: "package-x-generic"},
+	"application/x-reject":                       {comment: "rejected patch", genericIcon: "text-x-generic"},
+	"application/x-riff":                         {comment: "RIFF container"},
+	"application/x-rnc":                          {comment: "RELAX NG XML schema", acronym: "RELAX NG", expanded: "REgular LAnguage for XML Next Generation", genericIcon: "text-x-generic"},
+	"application/x-rpm":                          {comment: "RPM package", genericIcon: "package-x-generic"},
+	"application/x-ruby":                         {comment: "Ruby script", genericIcon: "text-x-script"},
+	"application/x-sami":                         {comment: "SAMI subtitles", acronym: "SAMI", expanded: "Synchronized Accessible Media Interchange", genericIcon: "text-x-generic"},
+	"application/x-sap-file":                     {comment: "SAP Thomson floppy disk image", acronym: "SAP", expanded: "Système d'Archivage Pukall", genericIcon: "application-x-executable"},
+	"application/x-saturn-rom":                   {comment: "Sega Saturn disc image", genericIcon: "application-x-executable"},
+	"application/x-sc":                           {comment: "SC/Xspread spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-sdp":                          {comment: "SDP multicast stream file", acronym: "SDP", expanded: "Session Description Protocol", genericIcon: "video-x-generic"},
+	"application/x-sega-cd-rom":                  {comment: "Sega CD disc image", genericIcon: "application-x-executable"},
+	"application/x-sega-pico-rom":                {comment: "Sega Pico ROM", genericIcon: "application-x-executable"},
+	"application/x-sg1000-rom":                   {comment: "SG-1000 ROM", genericIcon: "application-x-executable"},
+	"application/x-shar":                         {comment: "shell archive", genericIcon: "package-x-generic"},
+	"application/x-shared-library-la":            {comment: "libtool shared library", genericIcon: "text-x-script"},
+	"application/x-sharedlib":                    {comment: "shared library"},
+	"application/x-shellscript":                  {comment: "shell script", genericIcon: "text-x-script"},
+	"application/x-shockwave-flash":              {comment: "Shockwave Flash file", genericIcon: "video-x-generic"},
+	"application/x-shorten":                      {comment: "Shorten audio", genericIcon: "audio-x-generic"},
+	"application/x-siag":                         {comment: "Siag spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"application/x-sit":                          {comment: "StuffIt archive", genericIcon: "package-x-generic"},
+	"application/x-slp":                          {comment: "Stampede package", genericIcon: "package-x-generic"},
+	"application/x-smaf":                         {comment: "SMAF audio", acronym: "SMAF", expanded: "Synthetic music Mobile Application Format", genericIcon: "audio-x-generic"},
+	"application/x-sms-rom":                      {comment: "Master System ROM", genericIcon: "application-x-executable"},
+	"application/x-snes-rom":                     {comment: "Super NES ROM", genericIcon: "application-x-executable"},
+	"application/x-source-rpm":                   {comment: "Source RPM package", genericIcon: "package-x-generic"},
+	"application/x-spss-por":                     {comment: "SPSS portable data file", acronym: "SPSS", expanded: "Statistical Package for the Social Sciences"},
+	"application/x-spss-sav":                     {comment: "SPSS data file", acronym: "SPSS", expanded: "Statistical Package for the Social Sciences"},
+	"application/x-spss-savefile":                {comment: "SPSS data file", acronym: "SPSS", expanded: "Statistical Package for the Social Sciences"},
+	"application/x-sqlite2":                      {comment: "SQLite2 database"},
+	"application/x-sqlite3":                      {comment: "SQLite3 database"},
+	"application/x-srt":                          {comment: "SubRip subtitles", genericIcon: "text-x-generic"},
+	"application/x-stuffit":                      {comment: "StuffIt archive", genericIcon: "package-x-generic"},
+	"application/x-subrip":                       {comment: "SubRip subtitles", genericIcon: "text-x-generic"},
+	"application/x-sv4cpio":                      {comment: "SV4 CPIO archive", genericIcon: "package-x-generic"},
+	"application/x-sv4crc":                       {comment: "SV4 CPIO archive (with CRC)", genericIcon: "package-x-generic"},
+	"application/x-t602":                         {comment: "T602 document", genericIcon: "x-office-document"},
+	"application/x-tar":                          {comment: "Tar archive", genericIcon: "package-x-generic"},
+	"application/x-targa":                        {comment: "TGA image", acronym: "TGA", expanded: "Truevision Graphics Adapter"},
+	"application/x-tarz":                         {comment: "Tar archive (compressed)", genericIcon: "package-x-generic"},
+	"application/x-tex":                          {comment: "TeX document"},
+	"application/x-tex-gf":                       {comment: "generic font file", genericIcon: "font-x-generic"},
+	"application/x-tex-pk":                       {comment: "packed font file", genericIcon: "font-x-generic"},
+	"application/x-tga":                          {comment: "TGA image", acronym: "TGA", expanded: "Truevision Graphics Adapter"},
+	"application/x-tgif":                         {comment: "TGIF document", genericIcon: "x-office-document"},
+	"application/x-theme":                        {comment: "theme", genericIcon: "package-x-generic"},
+	"application/x-thomson-cartridge-memo7":      {comment: "Thomson Mémo7 cartridge", genericIcon: "application-x-executable"},
+	"application/x-thomson-cassette":             {comment: "Thomson cassette", genericIcon: "application-x-executable"},
+	"application/x-thomson-sap-image":            {comment: "SAP Thomson floppy disk image", acronym: "SAP", expanded: "Système d'Archivage Pukall", genericIcon: "application-x-executable"},
+	"application/x-toutdoux":                     {comment: "ToutDoux document", genericIcon: "x-office-document"},
+	"application/x-trash":                        {comment: "backup file"},
+	"application/x-trig":                         {comment: "TriG RDF document", acronym: "TriG", expanded: "TriG RDF Graph Triple Language"},
+	"application/x-troff":                        {comment: "Troff document"},
+	"application/x-troff-man":                    {comment: "Manual page", genericIcon: "text-x-generic"},
+	"application/x-troff-man-compressed":         {comment: "Manual page (compressed)", genericIcon: "text-x-generic"},
+	"application/x-tzo":                          {comment: "Tar archive (LZO-compressed)", genericIcon: "package-x-generic"},
+	"application/x-ufraw":                        {comment: "UFRaw ID image", acronym: "UFRaw", expanded: "Unidentified Flying Raw", genericIcon: "image-x-generic"},
+	"application/x-ustar":                        {comment: "Ustar archive", genericIcon: "package-x-generic"},
+	"application/x-vdi-disk":                     {comment: "VDI disk image", acronym: "VDI", expanded: "Virtual Disk Image"},
+	"application/x-vhd-disk":                     {comment: "VHD disk image", acronym: "VHD", expanded: "Virtual Hard Disk"},
+	"application/x-vhdx-disk":                    {comment: "VHDX disk image", acronym: "VHDX", expanded: "Virtual Hard Disk v2"},
+	"application/x-virtual-boy-rom":              {comment: "Virtual Boy ROM", genericIcon: "application-x-executable"},
+	"application/x-virtualbox-ova":               {comment: "OVF disk image", acronym: "OVF", expanded: "Open Virtualization Format"},
+	"application/x-virtualbox-vdi":               {comment: "VDI disk image", acronym: "VDI", expanded: "Virtual Disk Image"},
+	"application/x-virtualbox-vhd":               {comment: "VHD disk image", acronym: "VHD", expanded: "Virtual Hard Disk"},
+	"application/x-virtualbox-vhdx":              {comment: "VHDX disk image", acronym: "VHDX", expanded: "Virtual Hard Disk v2"},
+	"application/x-virtualbox-vmdk":              {comment: "VMDK disk image", acronym: "VMDK", expanded: "Virtual Machine Disk"},
+	"application/x-vmdk-disk":                    {comment: "VMDK disk image", acronym: "VMDK", expanded: "Virtual Machine Disk"},
+	"application/x-vnd.kde.kexi":                 {comment: "Kexi database file"},
+	"application/x-wais-source":                  {comment: "WAIS source code", genericIcon: "text-x-generic"},
+	"application/x-wbfs":                         {comment: "Wii disc image", genericIcon: "application-x-executable"},
+	"application/x-wia":                          {comment: "Wii disc image", genericIcon: "application-x-executable"},
+	"application/x-wii-iso-image":                {comment: "Wii disc image", genericIcon: "application-x-executable"},
+	"application/x-wii-rom":                      {comment: "Wii disc image", genericIcon: "application-x-executable"},
+	"application/x-wii-wad":                      {comment: "WiiWare bundle", genericIcon: "application-x-executable"},
+	"application/x-windows-themepack":            {comment: "Microsoft Windows theme pack", genericIcon: "package-x-generic"},
+	"application/x-wmf":                          {comment: "WMF image", acronym: "WMF", expanded: "Windows Metafile"},
+	"application/x-wonderswan-color-rom":         {comment: "Bandai WonderSwan Color ROM", genericIcon: "application-x-executable"},
+	"application/x-wonderswan-rom":               {comment: "Bandai WonderSwan ROM", genericIcon: "application-x-executable"},
+	"application/x-wordperfect":                  {comment: "WordPerfect document", genericIcon: "x-office-document"},
+	"application/x-wpg":                          {comment: "WordPerfect/Drawperfect image", genericIcon: "image-x-generic"},
+	"application/x-wwf":                          {comment: "WWF document", genericIcon: "x-office-document"},
+	"application/x-x509-ca-cert":                 {comment: "DER/PEM/Netscape-encoded X.509 certificate", genericIcon: "text-x-generic"},
+	"application/x-xar":                          {comment: "XAR archive", acronym: "XAR", expanded: "eXtensible ARchive", genericIcon: "package-x-generic"},
+	"application/x-xbel":                         {comment: "XBEL bookmarks", acronym: "XBEL", expanded: "XML Bookmark Exchange Language", genericIcon: "text-html"},
+	"application/x-xliff":                        {comment: "XLIFF translation file", acronym: "XLIFF", expanded: "XML Localization Interchange File Format", genericIcon: "text-x-generic"},
+	"application/x-xpinstall":                    {comment: "XPInstall installer module"},
+	"application/x-xspf+xml":                     {comment: "XSPF playlist", acronym: "XSPF", expanded: "XML Shareable Playlist Format", genericIcon: "audio-x-generic"},
+	"application/x-xz":                           {comment: "XZ archive", genericIcon: "package-x-generic"},
+	"application/x-xz-compressed-tar":            {comment: "Tar archive (XZ-compressed)", genericIcon: "package-x-generic"},
+	"application/x-xzpdf":                        {comment: "PDF document (XZ-compressed)", genericIcon: "x-office-document"},
+	"application/x-yaml":                         {comment: "YAML document", acronym: "YAML", expanded: "YAML Ain't Markup Language", genericIcon: "text-x-generic"},
+	"application/x-zerosize":                     {comment: "empty document"},
+	"application/x-zip":                          {comment: "Zip archive", genericIcon: "package-x-generic"},
+	"application/x-zip-compressed":               {comment: "Zip archive", genericIcon: "package-x-generic"},
+	"application/x-zip-compressed-fb2":           {comment: "Compressed FictionBook document"},
+	"application/x-zoo":                          {comment: "Zoo archive", genericIcon: "package-x-generic"},
+	"application/x-zstd-compressed-tar":          {comment: "Tar archive (Zstandard-compressed)", genericIcon: "package-x-generic"},
+	"application/xhtml+xml":                      {comment: "XHTML page", acronym: "XHTML", expanded: "Extensible HyperText Markup Language", genericIcon: "text-html"},
+	"application/xliff+xml":                      {comment: "XLIFF translation file", acronym: "XLIFF", expanded: "XML Localization Interchange File Format", genericIcon: "text-x-generic"},
+	"application/xml":                            {comment: "XML document", acronym: "XML", expanded: "eXtensible Markup Language", genericIcon: "text-html"},
+	"application/xml-dtd":                        {comment: "DTD file", acronym: "DTD", expanded: "Document Type Definition", genericIcon: "text-x-generic"},
+	"application/xml-external-parsed-entity":     {comment: "XML entities document", acronym: "XML", expanded: "eXtensible Markup Language", genericIcon: "text-html"},
+	"application/xps":                            {comment: "XPS document", acronym: "XPS", expanded: "XML Paper Specification", genericIcon: "x-office-document"},
+	"application/xslt+xml":                       {comment: "XSLT stylesheet", acronym: "XSLT", expanded: "eXtensible Stylesheet Language Transformation", genericIcon: "text-x-generic"},
+	"application/xspf+xml":                       {comment: "XSPF playlist", acronym: "XSPF", expanded: "XML Shareable Playlist Format", genericIcon: "audio-x-generic"},
+	"application/zip":                            {comment: "Zip archive", genericIcon: "package-x-generic"},
+	"application/zlib":                           {comment: "Zlib archive", genericIcon: "package-x-generic"},
+	"application/zstd":                           {comment: "Zstandard archive", genericIcon: "package-x-generic"},
+	"audio/3gpp":                                 {comment: "3GPP multimedia file", acronym: "3GPP", expanded: "3rd Generation Partnership Project"},
+	"audio/3gpp-encrypted":                       {comment: "3GPP multimedia file", acronym: "3GPP", expanded: "3rd Generation Partnership Project"},
+	"audio/3gpp2":                                {comment: "3GPP2 multimedia file", acronym: "3GPP2", expanded: "3rd Generation Partnership Project 2"},
+	"audio/aac":                                  {comment: "AAC audio", acronym: "AAC", expanded: "Advanced Audio Coding"},
+	"audio/ac3":                                  {comment: "Dolby Digital audio"},
+	"audio/amr":                                  {comment: "AMR audio", acronym: "AMR", expanded: "Adaptive Multi-Rate"},
+	"audio/amr-encrypted":                        {comment: "AMR audio", acronym: "AMR", expanded: "Adaptive Multi-Rate"},
+	"audio/amr-wb":                               {comment: "AMR-WB audio", acronym: "AMR-WB", expanded: "Adaptive Multi-Rate Wideband"},
+	"audio/amr-wb-encrypted":                     {comment: "AMR-WB audio", acronym: "AMR-WB", expanded: "Adaptive Multi-Rate Wideband"},
+	"audio/annodex":                              {comment: "Annodex audio"},
+	"audio/basic":                                {comment: "ULAW (Sun) audio"},
+	"audio/dff":                                  {comment: "DSDIFF audio", acronym: "DSDIFF", expanded: "Direct Stream Digital Interchange File Format"},
+	"audio/dsd":                                  {comment: "DSF audio", acronym: "DSF", expanded: "Direct stream digital Stream File"},
+	"audio/dsf":                                  {comment: "DSF audio", acronym: "DSF", expanded: "Direct stream digital Stream File"},
+	"audio/flac":                                 {comment: "FLAC audio", acronym: "FLAC", expanded: "Free Lossless Audio Codec"},
+	"audio/imelody":                              {comment: "iMelody ringtone"},
+	"audio/m3u":                                  {comment: "Media playlist"},
+	"audio/m4a":                                  {comment: "MPEG-4 audio"},
+	"audio/midi":                                 {comment: "MIDI audio", acronym: "MIDI", expanded: "Musical Instrument Digital Interface"},
+	"audio/mobile-xmf":                           {comment: "Mobile XMF audio", acronym: "XMF", expanded: "eXtensible Music Format"},
+	"audio/mp2":                                  {comment: "MP2 audio"},
+	"audio/mp3":                                  {comment: "MP3 audio"},
+	"audio/mp4":                                  {comment: "MPEG-4 audio"},
+	"audio/mpeg":                                 {comment: "MP3 audio"},
+	"audio/mpegurl":                              {comment: "Media playlist"},
+	"audio/ogg":                                  {comment: "Ogg audio"},
+	"audio/prs.sid":                              {comment: "Commodore 64 audio"},
+	"audio/scpls":                                {comment: "MP3 ShoutCast playlist"},
+	"audio/tta":                                  {comment: "TrueAudio audio"},
+	"audio/usac":                                 {comment: "USAC audio", acronym: "USAC", expanded: "Unified Speech and Audio Coding"},
+	"audio/vnd.audible":                          {comment: "Audible.Com audio"},
+	"audio/vnd.audible.aax":                      {comment: "Audible Enhanced audio"},
+	"audio/vnd.dts":                              {comment: "DTS audio", acronym: "DTS", expanded: "Digital Theater Systems"},
+	"audio/vnd.dts.hd":                           {comment: "DTS-HD audio", acronym: "DTS-HD", expanded: "Digital Theater Systems High Definition"},
+	"audio/vnd.m-realaudio":                      {comment: "RealAudio document"},
+	"audio/vnd.nokia.mobile-xmf":                 {comment: "Mobile XMF audio", acronym: "XMF", expanded: "eXtensible Music Format"},
+	"audio/vnd.rn-realaudio":                     {comment: "RealAudio document"},
+	"audio/vnd.wave":                             {comment: "WAV audio"},
+	"audio/vorbis":                               {comment: "Ogg Vorbis audio"},
+	"audio/wav":                                  {comment: "WAV audio"},
+	"audio/webm":                                 {comment: "WebM audio"},
+	"audio/wma":                                  {comment: "Windows Media audio"},
+	"audio/x-aac":                                {comment: "AAC audio", acronym: "AAC", expanded: "Advanced Audio Coding"},
+	"audio/x-adpcm":                              {comment: "PCM audio", acronym: "PCM", expanded: "Pulse-code Modulation"},
+	"audio/x-aifc":                               {comment: "AIFC audio", acronym: "AIFC", expanded: "Audio Interchange File format Compressed"},
+	"audio/x-aiff":                               {comment: "AIFF/Amiga/Mac audio", acronym: "AIFF", expanded: "Audio Interchange File Format"},
+	"audio/x-aiffc":                              {comment: "AIFC audio", acronym: "AIFC", expanded: "Audio Interchange File format Compressed"},
+	"audio/x-amzxml":                             {comment: "AmazonMP3 download file"},
+	"audio/x-annodex":                            {comment: "Annodex audio"},
+	"audio/x-ape":                                {comment: "Monkey's audio"},
+	"audio/x-dff":                                {comment: "DSDIFF audio", acronym: "DSDIFF", expanded: "Direct Stream Digital Interchange File Format"},
+	"audio/x-dsd":                                {comment: "DSF audio", acronym: "DSF", expanded: "Direct stream digital Stream File"},
+	"audio/x-dsf":                                {comment: "DSF audio", acronym: "DSF", expanded: "Direct stream digital Stream File"},
+	"audio/x-dts":                                {comment: "DTS audio", acronym: "DTS", expanded: "Digital Theater Systems"},
+	"audio/x-dtshd":                              {comment: "DTS-HD audio", acronym: "DTS-HD", expanded: "Digital Theater Systems High Definition"},
+	"audio/x-flac":                               {comment: "FLAC audio", acronym: "FLAC", expanded: "Free Lossless Audio Codec"},
+	"audio/x-flac+ogg":                           {comment: "Ogg FLAC audio"},
+	"audio/x-gsm":                                {comment: "GSM 06.10 audio", acronym: "GSM", expanded: "Global System for Mobile communications"},
+	"audio/x-imelody":                            {comment: "iMelody ringtone"},
+	"audio/x-iriver-pla":                         {comment: "iRiver playlist"},
+	"audio/x-it":                                 {comment: "Impulse Tracker audio"},
+	"audio/x-m3u":                                {comment: "Media playlist"},
+	"audio/x-m4a":                                {comment: "MPEG-4 audio"},
+	"audio/x-m4b":                                {comment: "MPEG-4 audio book"},
+	"audio/x-m4r":                                {comment: "MPEG-4 ringtone"},
+	"audio/x-matroska":                           {comment: "Matroska audio"},
+	"audio/x-midi":                               {comment: "MIDI audio", acronym: "MIDI", expanded: "Musical Instrument Digital Interface"},
+	"audio/x-minipsf":                            {comment: "MiniPSF audio", acronym: "MiniPSF", expanded: "Miniature Portable Sound Format"},
+	"audio/x-mo3":                                {comment: "compressed Tracker audio"},
+	"audio/x-mod":                                {comment: "Amiga SoundTracker audio"},
+	"audio/x-mp2":                                {comment: "MP2 audio"},
+	"audio/x-mp3":                                {comment: "MP3 audio"},
+	"audio/x-mp3-playlist":                       {comment: "Media playlist"},
+	"audio/x-mpeg":                               {comment: "MP3 audio"},
+	"audio/x-mpegurl":                            {comment: "Media playlist"},
+	"audio/x-mpg":                                {comment: "MP3 audio"},
+	"audio/x-ms-asx":                             {comment: "Microsoft ASX playlist"},
+	"audio/x-ms-wma":                             {comment: "Windows Media audio"},
+	"audio/x-musepack":                           {comment: "Musepack audio"},
+	"audio/x-ogg":                                {comment: "Ogg audio"},
+	"audio/x-oggflac":                            {comment: "Ogg FLAC audio"},
+	"audio/x-opus+ogg":                           {comment: "Opus audio"},
+	"audio/x-pn-audibleaudio":                    {comment: "Audible.Com audio"},
+	"audio/x-pn-realaudio":                       {comment: "RealAudio document"},
+	"audio/x-psf":                                {comment: "PSF audio", acronym: "PSF", expanded: "Portable Sound Format"},
+	"audio/x-psflib":                             {comment: "PSFlib audio library", acronym: "PSFlib", expanded: "Portable Sound Format Library"},
+	"audio/x-riff":                               {comment: "RIFF audio"},
+	"audio/x-rn-3gpp-amr":                        {comment: "3GPP multimedia file", acronym: "3GPP", expanded: "3rd Generation Partnership Project"},
+	"audio/x-rn-3gpp-amr-encrypted":              {comment: "3GPP multimedia file", acronym: "3GPP", expanded: "3rd Generation Partnership Project"},
+	"audio/x-rn-3gpp-amr-wb":                     {comment: "3GPP multimedia file", acronym: "3GPP", expanded: "3rd Generation Partnership Project"},
+	"audio/x-rn-3gpp-amr-wb-encrypted":           {comment: "3GPP multimedia file", acronym: "3GPP", expanded: "3rd Generation Partnership Project"},
+	"audio/x-s3m":                                {comment: "Scream Tracker 3 audio"},
+	"audio/x-scpls":                              {comment: "MP3 ShoutCast playlist"},
+	"audio/x-shorten":                            {comment: "Shorten audio", genericIcon: "audio-x-generic"},
+	"audio/x-speex":                              {comment: "Speex audio"},
+	"audio/x-speex+ogg":                          {comment: "Ogg Speex audio"},
+	"audio/x-stm":                                {comment: "Scream Tracker audio"},
+	"audio/x-tta":                                {comment: "TrueAudio audio"},
+	"audio/x-voc":                                {comment: "VOC audio"},
+	"audio/x-vorbis":                             {comment: "Ogg Vorbis audio"},
+	"audio/x-vorbis+ogg":                         {comment: "Ogg Vorbis audio"},
+	"audio/x-wav":                                {comment: "WAV audio"},
+	"audio/x-wavpack":                            {comment: "WavPack audio"},
+	"audio/x-wavpack-correction":                 {comment: "WavPack audio correction file"},
+	"audio/x-xi":                                 {comment: "Scream Tracker instrument"},
+	"audio/x-xm":                                 {comment: "FastTracker II audio"},
+	"audio/x-xmf":                                {comment: "XMF audio", acronym: "XMF", expanded: "eXtensible Music Format"},
+	"audio/xmf":                                  {comment: "XMF audio", acronym: "XMF", expanded: "eXtensible Music Format"},
+	"flv-application/octet-stream":               {comment: "Flash video", genericIcon: "video-x-generic"},
+	"font/collection":                            {comment: "Font collection", genericIcon: "font-x-generic"},
+	"font/otf":                                   {comment: "OpenType font", genericIcon: "font-x-generic"},
+	"font/ttf":                                   {comment: "TrueType font", genericIcon: "font-x-generic"},
+	"font/woff":                                  {comment: "WOFF font", acronym: "WOFF", expanded: "Web Open Font Format", genericIcon: "font-x-generic"},
+	"font/woff2":                                 {comment: "WOFF2 font", acronym: "WOFF2", expanded: "Web Open Font Format 2.0", genericIcon: "font-x-generic"},
+	"image/astc":                                 {comment: "ASTC texture", acronym: "ASTC", expanded: "Advanced Scalable Texture Compression"},
+	"image/avif":                                 {comment: "AVIF image", acronym: "AVIF", expanded: "AV1 Image File Format"},
+	"image/avif-sequence":                        {comment: "AVIF image", acronym: "AVIF", expanded: "AV1 Image File Format"},
+	"image/bmp":                                  {comment: "Windows BMP image"},
+	"image/cdr":                                  {comment: "Corel Draw drawing", genericIcon: "image-x-generic"},
+	"image/cgm":                                  {comment: "CGM image", acronym: "CGM", expanded: "Computer Graphics Metafile"},
+	"image/dpx":                                  {comment: "DPX image", acronym: "DPX", expanded: "Digital Moving Picture Exchange"},
+	"image/emf":                                  {comment: "EMF image", acronym: "EMF", expanded: "Enhanced MetaFile"},
+	"image/fax-g3":                               {comment: "CCITT G3 fax image", acronym: "CCITT", expanded: "Comité Consultatif International Téléphonique et Télégraphique"},
+	"image/fits":                                 {comment: "FITS document", acronym: "FITS", expanded: "Flexible Image Transport System"},
+	"image/g3fax":                                {comment: "CCITT G3 fax image", acronym: "CCITT", expanded: "Comité Consultatif International Téléphonique et Télégraphique"},
+	"image/gif":                                  {comment: "GIF image", acronym: "GIF", expanded: "Graphics Interchange Format"},
+	"image/heic":                                 {comment: "HEIF image", acronym: "HEIF", expanded: "High Efficiency Image File"},
+	"image/heic-sequence":                        {comment: "HEIF image", acronym: "HEIF", expanded: "High Efficiency Image File"},
+	"image/heif":                                 {comment: "HEIF image", acronym: "HEIF", expanded: "High Efficiency Image File"},
+	"image/heif-sequence":                        {comment: "HEIF image", acronym: "HEIF", expanded: "High Efficiency Image File"},
+	"image/ico":                                  {comment: "Windows icon"},
+	"image/icon":                                 {comment: "Windows icon"},
+	"image/ief":                                  {comment: "IEF image"},
+	"image/jp2":                                  {comment: "JPEG-2000 JP2 image", acronym: "JP2", expanded: "JPEG-2000"},
+	"image/jpeg":                                 {comment: "JPEG image", acronym: "JPEG", expanded: "Joint Photographic Experts Group"},
+	"image/jpeg2000":                             {comment: "JPEG-2000 JP2 image", acronym: "JP2", expanded: "JPEG-2000"},
+	"image/jpeg2000-image":                       {comment: "JPEG-2000 JP2 image", acronym: "JP2", expanded: "JPEG-2000"},
+	"image/jpm":                                  {comment: "JPEG-2000 JPM image", acronym: "JPM", expanded: "JPEG-2000 Mixed"},
+	"image/jpx":                                  {comment: "JPEG-2000 JPX image", acronym: "JPX", expanded: "JPEG-2000 eXtended"},
+	"image/jxl":                                  {comment: "JPEG XL image"},
+	"image/ktx":                                  {comment: "Khronos texture image"},
+	"image/ktx2":                                 {comment: "Khronos texture image"},
+	"image/openraster":                           {comment: "OpenRaster image"},
+	"image/pdf":                                  {comment: "PDF document", acronym: "PDF", expanded: "Portable Document Format", genericIcon: "x-office-document"},
+	"image/photoshop":                            {comment: "Photoshop image"},
+	"image/pjpeg":                                {comment: "JPEG image", acronym: "JPEG", expanded: "Joint Photographic Experts Group"},
+	"image/png":                                  {comment: "PNG image", acronym: "PNG", expanded: "Portable Network Graphics"},
+	"image/psd":                                  {comment: "Photoshop image"},
+	"image/rle":                                  {comment: "RLE bitmap image", acronym: "RLE", expanded: "Run Length Encoded"},
+	"image/svg+xml":                              {comment: "SVG image", acronym: "SVG", expanded: "Scalable Vector Graphics"},
+	"image/svg+xml-compressed":                   {comment: "compressed SVG image", acronym: "SVG", expanded: "Scalable Vector Graphics"},
+	"image/targa":                                {comment: "TGA image", acronym: "TGA", expanded: "Truevision Graphics Adapter"},
+	"image/tga":                                  {comment: "TGA image", acronym: "TGA", expanded: "Truevision Graphics Adapter"},
+	"image/tiff":                                 {comment: "TIFF image", acronym: "TIFF", expanded: "Tagged Image File Format"},
+	"image/vnd.adobe.photoshop":                  {comment: "Photoshop image"},
+	"image/vnd.djvu":                             {comment: "DjVu image"},
+	"image/vnd.djvu+multipage":                   {comment: "DjVu document", genericIcon: "x-office-document"},
+	"image/vnd.dwg":                              {comment: "AutoCAD image"},
+	"image/vnd.dxf":                              {comment: "DXF vector image"},
+	"image/vnd.microsoft.icon":                   {comment: "Windows icon"},
+	"image/vnd.ms-modi":                          {comment: "MDI image", acronym: "MDI", expanded: "Microsoft Document Imaging"},
+	"image/vnd.rn-realpix":                       {comment: "RealPix document"},
+	"image/vnd.wap.wbmp":                         {comment: "WBMP image", acronym: "WBMP", expanded: "WAP bitmap"},
+	"image/vnd.zbrush.pcx":                       {comment: "PCX image", acronym: "PCX", expanded: "PiCture eXchange"},
+	"image/webp":                                 {comment: "WebP image"},
+	"image/wmf":                                  {comment: "WMF image", acronym: "WMF", expanded: "Windows Metafile"},
+	"image/x-3ds":                                {comment: "3D Studio image"},
+	"image/x-adobe-dng":                          {comment: "Adobe DNG negative", acronym: "DNG", expanded: "Digital Negative"},
+	"image/x-applix-graphics":                    {comment: "Applix Graphics image"},
+	"image/x-bmp":                                {comment: "Windows BMP image"},
+	"image/x-bzeps":                              {comment: "EPS image (bzip-compressed)"},
+	"image/x-canon-cr2":                          {comment: "Canon CR2 raw image", acronym: "CR2", expanded: "Canon Raw 2"},
+	"image/x-canon-cr3":                          {comment: "Canon CR3 raw image", acronym: "CR3", expanded: "Canon Raw 3"},
+	"image/x-canon-crw":                          {comment: "Canon CRW raw image", acronym: "CRW", expanded: "Canon RaW"},
+	"image/x-cdr":                                {comment: "Corel Draw drawing", genericIcon: "image-x-generic"},
+	"image/x-cmu-raster":                         {comment: "CMU raster image"},
+	"image/x-compressed-xcf":                     {comment: "compressed GIMP image"},
+	"image/x-dcraw":                              {comment: "digital raw image"},
+	"image/x-dds":                                {comment: "DirectDraw surface"},
+	"image/x-dib":                                {comment: "DIB image", acronym: "DIB", expanded: "Device Independent Bitmap"},
+	"image/x-djvu":                               {comment: "DjVu image"},
+	"image/x-emf":                                {comment: "EMF image", acronym: "EMF", expanded: "Enhanced MetaFile"},
+	"image/x-eps":                                {comment: "EPS image", acronym: "EPS", expanded: "Encapsulated PostScript"},
+	"image/x-exr":                                {comment: "EXR image"},
+	"image/x-fits":                               {comment: "FITS document", acronym: "FITS", expanded: "Flexible Image Transport System"},
+	"image/x-fpx":                                {comment: "FPX image", acronym: "FPX", expanded: "FlashPiX"},
+	"image/x-fuji-raf":                           {comment: "Fuji RAF raw image", acronym: "RAF", expanded: "RAw Format"},
+	"image/x-gimp-gbr":                           {comment: "GIMP brush"},
+	"image/x-gimp-gih":                           {comment: "GIMP brush pipe"},
+	"image/x-gimp-pat":                           {comment: "GIMP pattern"},
+	"image/x-gzeps":                              {comment: "EPS image (gzip-compressed)"},
+	"image/x-icb":                                {comment: "TGA image", acronym: "TGA", expanded: "Truevision Graphics Adapter"},
+	"image/x-icns":                               {comment: "MacOS X icon"},
+	"image/x-ico":                                {comment: "Windows icon"},
+	"image/x-icon":                               {comment: "Windows icon"},
+	"image/x-iff":                                {comment: "ILBM image", acronym: "ILBM", expanded: "InterLeaved BitMap"},
+	"image/x-ilbm":                               {comment: "ILBM image", acronym: "ILBM", expanded: "InterLeaved BitMap"},
+	"image/x-jng":                                {comment: "JNG image", acronym: "JNG", expanded: "JPEG Network Graphics"},
+	"image/x-jp2-codestream":                     {comment: "JPEG-2000 codestream"},
+	"image/x-jpeg2000-image":                     {comment: "JPEG-2000 JP2 image", acronym: "JP2", expanded: "JPEG-2000"},
+	"image/x-kodak-dcr":                          {comment: "Kodak DCR raw image", acronym: "DCR", expanded: "Digital Camera Raw"},
+	"image/x-kodak-k25":                          {comment: "Kodak K25 raw image", acronym: "K25", expanded: "Kodak DC25"},
+	"image/x-kodak-kdc":                          {comment: "Kodak KDC raw image", acronym: "KDC", expanded: "Kodak Digital Camera"},
+	"image/x-lwo":                                {comment: "LightWave object"},
+	"image/x-lws":                                {comment: "LightWave scene"},
+	"image/x-macpaint":                           {comment: "MacPaint Bitmap image"},
+	"image/x-minolta-mrw":                        {comment: "Minolta MRW raw image", acronym: "MRW", expanded: "Minolta RaW"},
+	"image/x-ms-bmp":                             {comment: "Windows BMP image"},
+	"image/x-msod":                               {comment: "Office drawing"},
+	"image/x-niff":                               {comment: "NIFF image", acronym: "NIFF", expanded: "Navy Image File Format"},
+	"image/x-nikon-nef":                          {comment: "Nikon NEF raw image", acronym: "NEF", expanded: "Nikon Electronic Format"},
+	"image/x-nikon-nrw":                          {comment: "Nikon NRW raw image"},
+	"image/x-olympus-orf":                        {comment: "Olympus ORF raw image", acronym: "ORF", expanded: "Olympus Raw Format"},
+	"image/x-panasonic-raw":                      {comment: "Panasonic raw image"},
+	"image/x-panasonic-raw2":                     {comment: "Panasonic raw image"},
+	"image/x-panasonic-rw":                       {comment: "Panasonic raw image"},
+	"image/x-panasonic-rw2":                      {comment: "Panasonic raw image"},
+	"image/x-pcx":                                {comment: "PCX image", acronym: "PCX", expanded: "PiCture eXchange"},
+	"image/x-pentax-pef":                         {comment: "Pentax PEF raw image", acronym: "PEF", expanded: "Pentax Electronic Format"},
+	"image/x-photo-cd":                           {comment: "PCD image", acronym: "PCD", expanded: "PhotoCD"},
+	"image/x-photoshop":                          {comment: "Photoshop image"},
+	"image/x-pict":                               {comment: "Macintosh Quickdraw/PICT drawing"},
+	"image/x-portable-anymap":                    {comment: "PNM image", acronym: "PNM", expanded: "Portable Anymap"},
+	"image/x-portable-bitmap":                    {comment: "PBM image", acronym: "PBM", expanded: "Portable BitMap"},
+	"image/x-portable-graymap":                   {comment: "PGM image", acronym: "PGM", expanded: "Portable GrayMap"},
+	"image/x-portable-pixmap":                    {comment: "PPM image", acronym: "PPM", expanded: "Portable PixMap"},
+	"image/x-psd":                                {comment: "Photoshop image"},
+	"image/x-quicktime":                          {comment: "QuickTime image"},
+	"image/x-rgb":                                {comment: "RGB image"},
+	"image/x-sgi":                                {comment: "SGI image"},
+	"image/x-sigma-x3f":                          {comment: "Sigma X3F raw image", acronym: "X3F", expanded: "X3 Foveon"},
+	"image/x-skencil":                            {comment: "Skencil document"},
+	"image/x-sony-arw":                           {comment: "Sony ARW raw image", acronym: "ARW", expanded: "Alpha Raw format"},
+	"image/x-sony-sr2":                           {comment: "Sony SR2 raw image", acronym: "SR2", expanded: "Sony Raw format 2"},
+	"image/x-sony-srf":                           {comment: "Sony SRF raw image", acronym: "SRF", expanded: "Sony Raw Format"},
+	"image/x-sun-raster":                         {comment: "Sun raster image"},
+	"image/x-targa":                              {comment: "TGA image", acronym: "TGA", expanded: "Truevision Graphics Adapter"},
+	"image/x-tga":                                {comment: "TGA image", acronym: "TGA", expanded: "Truevision Graphics Adapter"},
+	"image/x-tiff-multipage":                     {comment: "Multi-page TIFF image", acronym: "TIFF", expanded: "Tagged Image File Format"},
+	"image/x-win-bitmap":                         {comment: "Windows cursor"},
+	"image/x-win-metafile":                       {comment: "WMF image", acronym: "WMF", expanded: "Windows Metafile"},
+	"image/x-wmf":                                {comment: "WMF image", acronym: "WMF", expanded: "Windows Metafile"},
+	"image/x-xbitmap":                            {comment: "XBM image", acronym: "XBM", expanded: "X BitMap"},
+	"image/x-xcf":                                {comment: "GIMP image"},
+	"image/x-xcursor":                            {comment: "X11 cursor"},
+	"image/x-xfig":                               {comment: "XFig image"},
+	"image/x-xpixmap":                            {comment: "XPM image", acronym: "XPM", expanded: "X PixMap"},
+	"image/x-xpm":                                {comment: "XPM image", acronym: "XPM", expanded: "X PixMap"},
+	"image/x-xwindowdump":                        {comment: "X window image"},
+	"image/x.djvu":                               {comment: "DjVu image"},
+	"inode/blockdevice":                          {comment: "block device"},
+	"inode/chardevice":                           {comment: "character device"},
+	"inode/directory":                            {comment: "folder", genericIcon: "folder"},
+	"inode/fifo":                                 {comment: "pipe"},
+	"inode/mount-point":                          {comment: "mount point"},
+	"inode/socket":                               {comment: "socket"},
+	"inode/symlink":                              {comment: "symbolic link"},
+	"message/delivery-status":                    {comment: "mail delivery report", genericIcon: "text-x-generic"},
+	"message/disposition-notification":           {comment: "mail disposition report", genericIcon: "text-x-generic"},
+	"message/external-body":                      {comment: "reference to remote file", genericIcon: "text-x-generic"},
+	"message/news":                               {comment: "Usenet news message", genericIcon: "text-x-generic"},
+	"message/partial":                            {comment: "partial email message", genericIcon: "text-x-generic"},
+	"message/rfc822":                             {comment: "email message", genericIcon: "text-x-generic"},
+	"message/x-gnu-rmail":                        {comment: "GNU mail message", genericIcon: "text-x-generic"},
+	"model/3mf":                                  {comment: "3MF document", acronym: "3MF", expanded: "3D Manufacturing Format"},
+	"model/gltf+json":                            {comment: "glTF model", acronym: "glTF", expanded: "GL Transmission Format"},
+	"model/gltf-binary":                          {comment: "glTF model", acronym: "glTF", expanded: "GL Transmission Format"},
+	"model/iges":                                 {comment: "IGES document", acronym: "IGES", expanded: "Initial Graphics Exchange Specification", genericIcon: "x-office-document"},
+	"model/mtl":                                  {comment: "OBJ 3D model material library"},
+	"model/obj":                                  {comment: "OBJ 3D model"},
+	"model/stl":                                  {comment: "STL 3D model", acronym: "STL", expanded: "StereoLithography"},
+	"model/vrml":                                 {comment: "VRML document", acronym: "VRML", expanded: "Virtual Reality Modeling Language", genericIcon: "x-office-document"},
+	"model/x.stl-ascii":                          {comment: "STL 3D model", acronym: "STL", expanded: "StereoLithography"},
+	"model/x.stl-binary":                         {comment: "STL 3D model", acronym: "STL", expanded: "StereoLithography"},
+	"multipart/alternative":                      {comment: "message in several formats"},
+	"multipart/appledouble":                      {comment: "Macintosh AppleDouble-encoded file"},
+	"multipart/digest":                           {comment: "message digest"},
+	"multipart/encrypted":                        {comment: "encrypted message"},
+	"multipart/mixed":                            {comment: "compound documents"},
+	"multipart/related":                          {comment: "compound document"},
+	"multipart/report":                           {comment: "mail system report"},
+	"multipart/signed":                           {comment: "signed message"},
+	"multipart/x-mixed-replace":                  {comment: "stream of data (server push)"},
+	"text/cache-manifest":                        {comment: "Web application cache file"},
+	"text/calendar":                              {comment: "VCS/ICS calendar", acronym: "VCS/ICS", expanded: "vCalendar/iCalendar"},
+	"text/crystal":                               {comment: "Crystal source code"},
+	"text/css":                                   {comment: "CSS stylesheet", acronym: "CSS", expanded: "Cascading Style Sheets"},
+	"text/csv":                                   {comment: "CSV document", acronym: "CSV", expanded: "Comma Separated Values"},
+	"text/csv-schema":                            {comment: "CSV Schema document", acronym: "CSV", expanded: "Comma Separated Values"},
+	"text/directory":                             {comment: "electronic business card"},
+	"text/ecmascript":                            {comment: "ECMAScript program", genericIcon: "text-x-script"},
+	"text/enriched":                              {comment: "enriched text document"},
+	"text/gedcom":                                {comment: "GEDCOM family history", acronym: "GEDCOM", expanded: "GEnealogical Data COMmunication", genericIcon: "x-office-document"},
+	"text/google-video-pointer":                  {comment: "Google Video Pointer shortcut"},
+	"text/html":                                  {comment: "HTML document", acronym: "HTML", expanded: "HyperText Markup Language"},
+	"text/htmlh":                                 {comment: "help page"},
+	"text/ico":                                   {comment: "Windows icon"},
+	"text/javascript":                            {comment: "JavaScript program", genericIcon: "text-x-script"},
+	"text/markdown":                              {comment: "Markdown document"},
+	"text/mathml":                                {comment: "MathML document", acronym: "MathML", expanded: "Mathematical Markup Language"},
+	"text/org":                                   {comment: "Org-mode file"},
+	"text/plain":                                 {comment: "plain text document"},
+	"text/rdf":                                   {comment: "RDF file", acronym: "RDF", expanded: "Resource Description Framework"},
+	"text/rfc822-headers":                        {comment: "email headers"},
+	"text/richtext":                              {comment: "rich text document"},
+	"text/rss":                                   {comment: "RSS summary", acronym: "RSS", expanded: "RDF Site Summary", genericIcon: "text-html"},
+	"text/rtf":                                   {comment: "RTF document", acronym: "RTF", expanded: "Rich Text Format", genericIcon: "x-office-document"},
+	"text/rust":                                  {comment: "Rust source code"},
+	"text/sgml":                                  {comment: "SGML document", acronym: "SGML", expanded: "Standard Generalized Markup Language"},
+	"text/spreadsheet":                           {comment: "spreadsheet interchange document"},
+	"text/tab-separated-values":                  {comment: "TSV document", acronym: "TSV", expanded: "Tab Separated Values"},
+	"text/tcl":                                   {comment: "Tcl script"},
+	"text/troff":                                 {comment: "Troff document"},
+	"text/turtle":                                {comment: "Turtle document"},
+	"text/vbs":                                   {comment: "VBScript program", genericIcon: "text-x-script"},
+	"text/vbscript":                              {comment: "VBScript program", genericIcon: "text-x-script"},
+	"text/vcard":                                 {comment: "electronic business card"},
+	"text/vnd.graphviz":                          {comment: "Graphviz DOT graph", genericIcon: "x-office-document"},
+	"text/vnd.qt.linguist":                       {comment: "message catalog"},
+	"text/vnd.rn-realtext":                       {comment: "RealText document"},
+	"text/vnd.senx.warpscript":                   {comment: "WarpScript source code"},
+	"text/vnd.sun.j2me.app-descriptor":           {comment: "JAD document", acronym: "JAD", expanded: "Java Application Descriptor"},
+	"text/vnd.trolltech.linguist":                {comment: "message catalog"},
+	"text/vnd.wap.wml":                           {comment: "WML document", acronym: "WML", expanded: "Wireless Markup Language"},
+	"text/vnd.wap.wmlscript":                     {comment: "WMLScript program"},
+	"text/vtt":                                   {comment: "WebVTT subtitles", acronym: "VTT", expanded: "Video Text Tracks", genericIcon: "text-x-generic"},
+	"text/x-adasrc":                              {comment: "Ada source code"},
+	"text/x-authors":                             {comment: "author list"},
+	"text/x-bibtex":                              {comment: "BibTeX document"},
+	"text/x-c":                                   {comment: "C source code"},
+	"text/x-c++hdr":                              {comment: "C++ header"},
+	"text/x-c++src":                              {comment: "C++ source code"},
+	"text/x-changelog":                           {comment: "ChangeLog document"},
+	"text/x-chdr":                                {comment: "C header"},
+	"text/x-cmake":                               {comment: "CMake source code"},
+	"text/x-cobol":                               {comment: "COBOL source code", acronym: "COBOL", expanded: "COmmon Business Oriented Language"},
+	"text/x-comma-separated-values":              {comment: "CSV document", acronym: "CSV", expanded: "Comma Separated Values"},
+	"text/x-common-lisp":                         {comment: "Common Lisp source code"},
+	"text/x-copying":                             {comment: "license terms"},
+	"text/x-credits":                             {comment: "author credits"},
+	"text/x-crystal":                             {comment: "Crystal source code"},
+	"text/x-csharp":                              {comment: "C# source code"},
+	"text/x-csrc":                                {comment: "C source code"},
+	"text/x-csv":                                 {comment: "CSV document", acronym: "CSV", expanded: "Comma Separated Values"},
+	"text/x-dart":                                {comment: "Dart source code"},
+	"text/x-dbus-service":                        {comment: "D-Bus service file"},
+	"text/x-dcl":                                 {comment: "DCL script", acronym: "DCL", expanded: "Data Conversion Laboratory"},
+	"text/x-diff":                                {comment: "differences between files"},
+	"text/x-dsl":                                 {comment: "DSSSL document", acronym: "DSSSL", expanded: "Document Style Semantics and Specification Language"},
+	"text/x-dsrc":                                {comment: "D source code"},
+	"text/x-dtd":                                 {comment: "DTD file", acronym: "DTD", expanded: "Document Type Definition", genericIcon: "text-x-generic"},
+	"text/x-eiffel":                              {comment: "Eiffel source code"},
+	"text/x-elixir":                              {comment: "Elixir source code"},
+	"text/x-emacs-lisp":                          {comment: "Emacs Lisp source code"},
+	"text/x-erlang":                              {comment: "Erlang source code"},
+	"text/x-fortran":                             {comment: "Fortran source code"},
+	"text/x-gcode-gx":                            {comment: "G-code Extended file"},
+	"text/x-genie":                               {comment: "Genie source code", genericIcon: "text-x-generic"},
+	"text/x-gettext-translation":                 {comment: "translation file"},
+	"text/x-gettext-translation-template":        {comment: "translation template"},
+	"text/x-gherkin":                             {comment: "Gherkin document"},
+	"text/x-go":                                  {comment: "Go source code"},
+	"text/x-google-video-pointer":                {comment: "Google Video Pointer shortcut"},
+	"text/x-gradle":                              {comment: "Gradle script"},
+	"text/x-groovy":                              {comment: "Groovy source code", genericIcon: "text-x-script"},
+	"text/x-haskell":                             {comment: "Haskell source code"},
+	"text/x-idl":                                 {comment: "IDL document", acronym: "IDL", expanded: "Interface Definition Language"},
+	"text/x-imelody":                             {comment: "iMelody ringtone"},
+	"text/x-install":                             {comment: "installation instructions"},
+	"text/x-iptables":                            {comment: "iptables configuration file"},
+	"text/x-java":                                {comment: "Java source code"},
+	"text/x-kaitai-struct":                       {comment: "Kaitai Struct definition file"},
+	"text/x-kotlin":                              {comment: "Kotlin source code"},
+	"text/x-ldif":                                {comment: "LDIF address book", acronym: "LDIF", expanded: "LDAP Data Interchange Format"},
+	"text/x-lilypond":                            {comment: "Lilypond music sheet"},
+	"text/x-literate-haskell":                    {comment: "LHS source code", acronym: "LHS", expanded: "Literate Haskell source code"},
+	"text/x-log":                                 {comment: "application log"},
+	"text/x-lua":                                 {comment: "Lua script"},
+	"text/x-lyx":                                 {comment: "LyX document", genericIcon: "x-office-document"},
+	"text/x-makefile":                            {comment: "Makefile build file"},
+	"text/x-markdown":                            {comment: "Markdown document"},
+	"text/x-matlab":                              {comment: "MATLAB file"},
+	"text/x-maven+xml":                           {comment: "Maven description file", genericIcon: "text-x-generic"},
+	"text/x-meson":                               {comment: "Meson source code"},
+	"text/x-microdvd":                            {comment: "MicroDVD subtitles"},
+	"text/x-moc":                                 {comment: "Qt MOC file", acronym: "Qt MOC", expanded: "Qt Meta Object Compiler"},
+	"text/x-modelica":                            {comment: "Modelica model"},
+	"text/x-mof":                                 {comment: "MOF file", acronym: "MOF", expanded: "Windows Managed Object File"},
+	"text/x-mpl2":                                {comment: "MPlayer2 subtitles"},
+	"text/x-mpsub":                               {comment: "MPSub subtitles", acronym: "MPSub", expanded: "MPlayer Subtitle"},
+	"text/x-mrml":                                {comment: "MRML playlist", acronym: "MRML", expanded: "Multimedia Retrieval Markup Language"},
+	"text/x-ms-regedit":                          {comment: "Windows Registry extract"},
+	"text/x-mup":                                 {comment: "Mup musical composition document"},
+	"text/x-nfo":                                 {comment: "NFO document"},
+	"text/x-objc++src":                           {comment: "Objective-C++ source code"},
+	"text/x-objcsrc":                             {comment: "Objective-C source code"},
+	"text/x-ocaml":                               {comment: "OCaml source code"},
+	"text/x-ocl":                                 {comment: "OCL file", acronym: "OCL", expanded: "Object Constraint Language"},
+	"text/x-octave":                              {comment: "MATLAB file"},
+	"text/x-ooc":                                 {comment: "OOC source code", acronym: "OOC", expanded: "Out Of Class"},
+	"text/x-opencl-src":                          {comment: "OpenCL source code", acronym: "OpenCL", expanded: "Open Computing Language"},
+	"text/x-opml":                                {comment: "OPML syndication feed", acronym: "OPML", expanded: "Outline Processor Markup Language", genericIcon: "text-html"},
+	"text/x-opml+xml":                            {comment: "OPML syndication feed", acronym: "OPML", expanded: "Outline Processor Markup Language", genericIcon: "text-html"},
+	"text/x-pascal":                              {comment: "Pascal source code"},
+	"text/x-patch":                               {comment: "differences between files"},
+	"text/x-perl":                                {comment: "Perl script", genericIcon: "text-x-script"},
+	"text/x-po":                                  {comment: "translation file"},
+	"text/x-pot":                                 {comment: "translation template"},
+	"text/x-python":                              {comment: "Python script"},
+	"text/x-python3":                             {comment: "Python 3 script"},
+	"text/x-qml":                                 {comment: "Qt Markup Language file"},
+	"text/x-readme":                              {comment: "README document"},
+	"text/x-reject":                              {comment: "rejected patch", genericIcon: "text-x-generic"},
+	"text/x-rpm-spec":                            {comment: "RPM spec file", acronym: "RPM", expanded: "Red Hat Package Manager"},
+	"text/x-rst":                                 {comment: "reStructuredText document"},
+	"text/x-sagemath":                            {comment: "SageMath script"},
+	"text/x-sass":                                {comment: "Sass CSS pre-processor file", acronym: "Sass", expanded: "Syntactically Awesome Style Sheets", genericIcon: "text-x-generic"},
+	"text/x-scala":                               {comment: "Scala source code"},
+	"text/x-scheme":                              {comment: "Scheme source code"},
+	"text/x-scons":                               {comment: "SCons configuration file"},
+	"text/x-scss":                                {comment: "SCSS pre-processor file", acronym: "SCSS", expanded: "Sassy CSS", genericIcon: "text-x-generic"},
+	"text/x-setext":                              {comment: "Setext document"},
+	"text/x-sh":                                  {comment: "shell script", genericIcon: "text-x-script"},
+	"text/x-sql":                                 {comment: "SQL code"},
+	"text/x-ssa":                                 {comment: "SSA subtitles", acronym: "SSA", expanded: "SubStation Alpha"},
+	"text/x-subviewer":                           {comment: "SubViewer subtitles"},
+	"text/x-svhdr":                               {comment: "SystemVerilog header"},
+	"text/x-svsrc":                               {comment: "SystemVerilog source code"},
+	"text/x-systemd-unit":                        {comment: "systemd unit file"},
+	"text/x-tcl":                                 {comment: "Tcl script"},
+	"text/x-tex":                                 {comment: "TeX document"},
+	"text/x-texinfo":                             {comment: "TeXInfo document"},
+	"text/x-troff":                               {comment: "Troff document"},
+	"text/x-troff-me":                            {comment: "Troff ME input document"},
+	"text/x-troff-mm":                            {comment: "Troff MM input document"},
+	"text/x-troff-ms":                            {comment: "Troff MS input document"},
+	"text/x-twig":                                {comment: "Twig template", genericIcon: "text-x-generic-template"},
+	"text/x-txt2tags":                            {comment: "txt2tags document"},
+	"text/x-uil":                                 {comment: "X-Motif UIL table"},
+	"text/x-uri":                                 {comment: "resource location"},
+	"text/x-uuencode":                            {comment: "uuencoded file"},
+	"text/x-vala":                                {comment: "Vala source code"},
+	"text/x-vcalendar":                           {comment: "VCS/ICS calendar", acronym: "VCS/ICS", expanded: "vCalendar/iCalendar"},
+	"text/x-vcard":                               {comment: "electronic business card"},
+	"text/x-verilog":                             {comment: "Verilog source code"},
+	"text/x-vhdl":                                {comment: "VHDL source code", acronym: "VHDL", expanded: "Very-High-Speed Integrated Circuit Hardware Description Language"},
+	"text/x-xmi":                                 {comment: "XMI file", acronym: "XMI", expanded: "XML Metadata Interchange"},
+	"text/x-xslfo":                               {comment: "XSL FO file", acronym: "XSL FO", expanded: "XSL Formatting Objects"},
+	"text/x-yaml":                                {comment: "YAML document", acronym: "YAML", expanded: "YAML Ain't Markup Language", genericIcon: "text-x-generic"},
+	"text/x.gcode":                               {comment: "G-code file", genericIcon: "text-x-generic"},
+	"text/xmcd":                                  {comment: "XMCD CD database"},
+	"text/xml":                                   {comment: "XML document", acronym: "XML", expanded: "eXtensible Markup Language", genericIcon: "text-html"},
+	"text/xml-external-parsed-entity":            {comment: "XML entities document", acronym: "XML", expanded: "eXtensible Markup Language", genericIcon: "text-html"},
+	"text/yaml":                                  {comment: "YAML document", acronym: "YAML", expanded: "YAML Ain't Markup Language", genericIcon: "text-x-generic"},
+	"video/3gp":                                  {comment: "3GPP multimedia file", acronym: "3GPP", expanded: "3rd Generation Partnership Project"},
+	"video/3gpp":                                 {comment: "3GPP multimedia file", acronym: "3GPP", expanded: "3rd Generation Partnership Project"},
+	"video/3gpp-encrypted":                       {comment: "3GPP multimedia file", acronym: "3GPP", expanded: "3rd Generation Partnership Project"},
+	"video/3gpp2":                                {comment: "3GPP2 multimedia file", acronym: "3GPP2", expanded: "3rd Generation Partnership Project 2"},
+	"video/annodex":                              {comment: "Annodex video"},
+	"video/avi":                                  {comment: "AVI video", acronym: "AVI", expanded: "Audio Video Interleave"},
+	"video/divx":                                 {comment: "AVI video", acronym: "AVI", expanded: "Audio Video Interleave"},
+	"video/dv":                                   {comment: "DV video", acronym: "DV", expanded: "Digital Video"},
+	"video/fli":                                  {comment: "FLIC animation"},
+	"video/flv":                                  {comment: "Flash video", genericIcon: "video-x-generic"},
+	"video/isivideo":                             {comment: "ISI video"},
+	"video/mj2":                                  {comment: "JPEG-2000 MJ2 video", acronym: "MJ2", expanded: "Motion JPEG-2000"},
+	"video/mp2t":                                 {comment: "MPEG-2 transport stream", acronym: "MPEG-2 TS", expanded: "Moving Picture Experts Group 2 Transport Stream"},
+	"video/mp4":                                  {comment: "MPEG-4 video"},
+	"video/mp4v-es":                              {comment: "MPEG-4 video"},
+	"video/mpeg":                                 {comment: "MPEG video", acronym: "MPEG", expanded: "Moving Picture Experts Group"},
+	"video/mpeg-system":                          {comment: "MPEG video", acronym: "MPEG", expanded: "Moving Picture Experts Group"},
+	"video/msvideo":                              {comment: "AVI video", acronym: "AVI", expanded: "Audio Video Interleave"},
+	"video/ogg":                                  {comment: "Ogg video"},
+	"video/quicktime":                            {comment: "QuickTime video"},
+	"video/vivo":                                 {comment: "Vivo video"},
+	"video/vnd.divx":                             {comment: "AVI video", acronym: "AVI", expanded: "Audio Video Interleave"},
+	"video/vnd.mpegurl":                          {comment: "Video playlist"},
+	"video/vnd.radgamettools.bink":               {comment: "Bink Video"},
+	"video/vnd.radgamettools.smacker":            {comment: "Smacker Video"},
+	"video/vnd.rn-realvideo":                     {comment: "RealVideo document"},
+	"video/vnd.vivo":                             {comment: "Vivo video"},
+	"video/wavelet":                              {comment: "Wavelet video"},
+	"video/webm":                                 {comment: "WebM video"},
+	"video/x-anim":                               {comment: "ANIM animation"},
+	"video/x-annodex":                            {comment: "Annodex video"},
+	"video/x-avi":                                {comment: "AVI video", acronym: "AVI", expanded: "Audio Video Interleave"},
+	"video/x-fli":                                {comment: "FLIC animation"},
+	"video/x-flic":                               {comment: "FLIC animation"},
+	"video/x-flv":                                {comment: "Flash video", genericIcon: "video-x-generic"},
+	"video/x-javafx":                             {comment: "JavaFX video", genericIcon: "video-x-generic"},
+	"video/x-m4v":                                {comment: "MPEG-4 video"},
+	"video/x-matroska":                           {comment: "Matroska video"},
+	"video/x-matroska-3d":                        {comment: "Matroska 3D video"},
+	"video/x-mjpeg":                              {comment: "MJPEG video stream", acronym: "MJPEG", expanded: "Motion JPEG"},
+	"video/x-mng":                                {comment: "MNG animation", acronym: "MNG", expanded: "Multiple-Image Network Graphics"},
+	"video/x-mpeg":                               {comment: "MPEG video", acronym: "MPEG", expanded: "Moving Picture Experts Group"},
+	"video/x-mpeg-system":                        {comment: "MPEG video", acronym: "MPEG", expanded: "Moving Picture Experts Group"},
+	"video/x-mpeg2":                              {comment: "MPEG video", acronym: "MPEG", expanded: "Moving Picture Experts Group"},
+	"video/x-mpegurl":                            {comment: "Video playlist"},
+	"video/x-ms-asf":                             {comment: "ASF video", acronym: "ASF", expanded: "Advanced Streaming Format"},
+	"video/x-ms-asf-plugin":                      {comment: "ASF video", acronym: "ASF", expanded: "Advanced Streaming Format"},
+	"video/x-ms-wax":                             {comment: "Microsoft ASX playlist"},
+	"video/x-ms-wm":                              {comment: "ASF video", acronym: "ASF", expanded: "Advanced Streaming Format"},
+	"video/x-ms-wmv":                             {comment: "Windows Media video"},
+	"video/x-ms-wmx":                             {comment: "Microsoft ASX playlist"},
+	"video/x-ms-wvx":                             {comment: "Microsoft ASX playlist"},
+	"video/x-msvideo":                            {comment: "AVI video", acronym: "AVI", expanded: "Audio Video Interleave"},
+	"video/x-nsv":                                {comment: "NullSoft video"},
+	"video/x-ogg":                                {comment: "Ogg video"},
+	"video/x-ogm":                                {comment: "OGM video"},
+	"video/x-ogm+ogg":                            {comment: "OGM video"},
+	"video/x-real-video":                         {comment: "RealVideo document"},
+	"video/x-sgi-movie":                          {comment: "SGI video"},
+	"video/x-theora":                             {comment: "Ogg Theora video"},
+	"video/x-theora+ogg":                         {comment: "Ogg Theora video"},
+	"x-content/audio-cdda":                       {comment: "audio CD"},
+	"x-content/audio-dvd":                        {comment: "audio DVD"},
+	"x-content/audio-player":                     {comment: "portable audio player"},
+	"x-content/blank-bd":                         {comment: "blank Blu-ray disc"},
+	"x-content/blank-cd":                         {comment: "blank CD disc"},
+	"x-content/blank-dvd":                        {comment: "blank DVD disc"},
+	"x-content/blank-hddvd":                      {comment: "blank HD DVD disc"},
+	"x-content/ebook-reader":                     {comment: "e-book reader"},
+	"x-content/image-dcf":                        {comment: "digital photos"},
+	"x-content/image-picturecd":                  {comment: "Picture CD"},
+	"x-content/ostree-repository":                {comment: "OSTree software updates"},
+	"x-content/software":                         {comment: "software"},
+	"x-content/unix-software":                    {comment: "UNIX software"},
+	"x-content/video-bluray":                     {comment: "Blu-ray video disc"},
+	"x-content/video-dvd":                        {comment: "video DVD"},
+	"x-content/video-hddvd":                      {comment: "HD DVD video disc"},
+	"x-content/video-svcd":                       {comment: "Super Video CD"},
+	"x-content/video-vcd":                        {comment: "Video CD"},
+	"x-content/win32-software":                   {comment: "Windows software"},
+	"x-directory/normal":                         {comment: "folder", genericIcon: "folder"},
+	"x-epoc/x-sisx-app":                          {comment: "SISX package", acronym: "SIS", expanded: "Symbian Installation File", genericIcon: "package-x-generic"},
+	"zz-application/zz-winassoc-123":             {comment: "Lotus 1-2-3 spreadsheet", genericIcon: "x-office-spreadsheet"},
+	"zz-application/zz-winassoc-cab":             {comment: "Microsoft Cabinet archive", genericIcon: "package-x-generic"},
+	"zz-application/zz-winassoc-cdr":             {comment: "Corel Draw drawing", genericIcon: "image-x-generic"},
+	"zz-application/zz-winassoc-doc":             {comment: "Word document", genericIcon: "x-office-document"},
+	"zz-application/zz-winassoc-hlp":             {comment: "WinHelp help file"},
+	"zz-application/zz-winassoc-mdb":             {comment: "JET database", acronym: "JET", expanded: "Joint Engine Technology", genericIcon: "x-office-document"},
+	"zz-application/zz-winassoc-uu":              {comment: "uuencoded file"},
+	"zz-application/zz-winassoc-xls":             {comment: "Excel spreadsheet", genericIcon: "x-office-spreadsheet"},
+}
+
+// ianaTypes holds the lower-cased names of the types registered with IANA
+// and whether the registration is obsolete.
+var ianaTypes = map[string]bool{
+	"application/1d-interleaved-parityfec":                                    false,
+	"application/3gpdash-qoe-report+xml":                                      false,
+	"application/3gpp-ims+xml":                                                false,
+	"application/a2l":                                                         false,
+	"application/activemessage":                                               false,
+	"application/activity+json":                                               false,
+	"application/alto-costmap+json":                                           false,
+	"application/alto-costmapfilter+json":                                     false,
+	"application/alto-directory+json":                                         false,
+	"application/alto-endpointcost+json":                                      false,
+	"application/alto-endpointcostparams+json":                                false,
+	"application/alto-endpointprop+json":                                      false,
+	"application/alto-endpointpropparams+json":                                false,
+	"application/alto-error+json":                                             false,
+	"application/alto-networkmap+json":                                        false,
+	"application/alto-networkmapfilter+json":                                  false,
+	"application/aml":                                                         false,
+	"application/andrew-inset":                                                false,
+	"application/applefile":                                                   false,
+	"application/atf":                                                         false,
+	"application/atfx":                                                        false,
+	"application/atom+xml":                                                    false,
+	"application/atomcat+xml":                                                 false,
+	"application/atomdeleted+xml":                                             false,
+	"application/atomicmail":                                                  false,
+	"application/atomsvc+xml":                                                 false,
+	"application/atxml":                                                       false,
+	"application/auth-policy+xml":                                             false,
+	"application/bacnet-xdd+zip":                                              false,
+	"application/batch-smtp":                                                  false,
+	"application/beep+xml":                                                    false,
+	"application/calendar+json":                                               false,
+	"application/calendar+xml":                                                false,
+	"application/call-completion":                                             false,
+	"application/cals-1840":                                                   false,
+	"application/cbor":                                                        false,
+	"application/cccex":                                                       false,
+	"application/ccmp+xml":                                                    false,
+	"application/ccxml+xml":                                                   false,
+	"application/cdfx+xml":                                                    false,
+	"application/cdmi-capability":                                             false,
+	"application/cdmi-container":                                              false,
+	"application/cdmi-domain":                                                 false,
+	"application/cdmi-object":                                                 false,
+	"application/cdmi-queue":                                                  false,
+	"application/cdni":                                                        false,
+	"application/cea":                                                         false,
+	"application/cea-2018+xml":                                                false,
+	"application/cellml+xml":                                                  false,
+	"application/cfw":                                                         false,
+	"application/clue_info+xml":                                               false,
+	"application/cms":                                                         false,
+	"application/cnrp+xml":                                                    false,
+	"application/coap-group+json":                                             false,
+	"application/coap-payload":                                                false,
+	"application/commonground":                                                false,
+	"application/conference-info+xml":                                         false,
+	"application/cose":                                                        false,
+	"application/cose-key":                                                    false,
+	"application/cose-key-set":                                                false,
+	"application/cpl+xml":                                                     false,
+	"application/csrattrs":                                                    false,
+	"application/csta+xml":                                                    false,
+	"application/cstadata+xml":                                                false,
+	"application/csvm+json":                                                   false,
+	"application/cwt":                                                         false,
+	"application/cybercash":                                                   false,
+	"application/dash+xml":                                                    false,
+	"application/dashdelta":                                                   false,
+	"application/davmount+xml":                                                false,
+	"application/dca-rft":                                                     false,
+	"application/dcd":                                                         false,
+	"application/dec-dx":                                                      false,
+	"application/dialog-info+xml":                                             false,
+	"application/dicom":                                                       false,
+	"application/dicom+json":                                                  false,
+	"application/dicom+xml":                                                   false,
+	"application/dii":                                                         false,
+	"application/dit":                                                         false,
+	"application/dns":                                                         false,
+	"application/dns+json":                                                    false,
+	"application/dskpp+xml":                                                   false,
+	"application/dssc+der":                                                    false,
+	"application/dssc+xml":                                                    false,
+	"application/dvcs":                                                        false,
+	"application/ecmascript":                                                  true,
+	"application/edi-consent":                                                 false,
+	"application/edi-x12":                                                     false,
+	"application/edifact":                                                     false,
+	"application/efi":                                                         false,
+	"application/emergencycalldata.comment+xml":                               false,
+	"application/emergencycalldata.control+xml":                               false,
+	"application/emergencycalldata.deviceinfo+xml":                            false,
+	"application/emergencycalldata.ecall.msd":                                 false,
+	"application/emergencycalldata.providerinfo+xml":                          false,
+	"application/emergencycalldata.serviceinfo+xml":                           false,
+	"application/emergencycalldata.subscriberinfo+xml":                        false,
+	"application/emergencycalldata.veds+xml":                                  false,
+	"application/emma+xml":                                                    false,
+	"application/emotionml+xml":                                               false,
+	"application/encaprtp":                                                    false,
+	"application/epp+xml":                                                     false,
+	"application/epub+zip":                                                    false,
+	"application/eshop":                                                       false,
+	"application/exi":                                                         false,
+	"application/fastinfoset":                                                 false,
+	"application/fastsoap":                                                    false,
+	"application/fdt+xml":                                                     false,
+	"application/fhir+json":                                                   false,
+	"application/fhir+xml":                                                    false,
+	"application/fits":                                                        false,
+	"application/font-sfnt":                                                   true,
+	"application/font-tdpfr":                                                  false,
+	"application/font-woff":                                                   true,
+	"application/framework-attributes+xml":                                    false,
+	"application/geo+json":                                                    false,
+	"application/geo+json-seq":                                                false,
+	"application/geoxacml+xml":                                                false,
+	"application/gltf-buffer":                                                 false,
+	"application/gml+xml":                                                     false,
+	"application/gzip":                                                        false,
+	"application/h224":                                                        false,
+	"application/held+xml":                                                    false,
+	"application/http":                                                        false,
+	"application/hyperstudio":                                                 false,
+	"application/ibe-key-request+xml":                                         false,
+	"application/ibe-pkg-reply+xml":                                           false,
+	"application/ibe-pp-data":                                                 false,
+	"application/iges":                                                        false,
+	"application/im-iscomposing+xml":                                          false,
+	"application/index":                                                       false,
+	"application/index.cmd":                                                   false,
+	"application/index.obj":                                                   false,
+	"application/index.response":                                              false,
+	"application/index.vnd":                                                   false,
+	"application/inkml+xml":                                                   false,
+	"application/iotp":                                                        false,
+	"application/ipfix":                                                       false,
+	"application/ipp":                                                         false,
+	"application/isup":                                                        false,
+	"application/its+xml":                                                     false,
+	"application/javascript":                                                  true,
+	"application/jf2feed+json":                                                false,
+	"application/jose":                                                        false,
+	"application/jose+json":                                                   false,
+	"application/jrd+json":                                                    false,
+	"application/json":                                                        false,
+	"application/json-patch+json":                                             false,
+	"application/json-seq":                                                    false,
+	"application/jwk+json":                                                    false,
+	"application/jwk-set+json":                                                false,
+	"application/jwt":                                                         false,
+	"application/kpml-request+xml":                                            false,
+	"application/kpml-response+xml":                                           false,
+	"application/ld+json":                                                     false,
+	"application/lgr+xml":                                                     false,
+	"application/link-format":                                                 false,
+	"application/load-control+xml":                                            false,
+	"application/lost+xml":                                                    false,
+	"application/lostsync+xml":                                                false,
+	"application/lxf":                                                         false,
+	"application/mac-binhex40":                                                false,
+	"application/macwriteii":                                                  false,
+	"application/mads+xml":                                                    false,
+	"application/marc":                                                        false,
+	"application/marcxml+xml":                                                 false,
+	"application/mathematica":                                                 false,
+	"application/mathml+xml":                                                  false,
+	"application/mathml-content+xml":                                          false,
+	"application/mathml-presentation+xml":                                     false,
+	"application/mbms-associated-procedure-description+xml":                   false,
+	"application/mbms-deregister+xml":                                         false,
+	"application/mbms-envelope+xml":                                           false,
+	"application/mbms-msk+xml":                                                false,
+	"application/mbms-msk-response+xml":                                       false,
+	"application/mbms-protection-description+xml":                             false,
+	"application/mbms-reception-report+xml":                                   false,
+	"application/mbms-register+xml":                                           false,
+	"application/mbms-register-response+xml":                                  false,
+	"application/mbms-schedule+xml":                                           false,
+	"application/mbms-user-service-description+xml":                           false,
+	"application/mbox":                                                        false,
+	"application/media-policy-dataset+xml":                                    false,
+	"application/media_control+xml":                                           false,
+	"application/mediaservercontrol+xml":                                      false,
+	"application/merge-patch+json":                                            false,
+	"application/metalink4+xml":                                               false,
+	"application/mets+xml":                                                    false,
+	"application/mf4":                                                         false,
+	"application/mikey":                                                       false,
+	"application/mmt-usd+xml":                                                 false,
+	"application/mods+xml":                                                    false,
+	"application/moss-keys":                                                   false,
+	"application/moss-signature":                                              false,
+	"application/mosskey-data":                                                false,
+	"application/mosskey-request":                                             false,
+	"application/mp21":                                                        false,
+	"application/mp4":                                                         false,
+	"application/mpeg4-generic":                                               false,
+	"application/mpeg4-iod":                                                   false,
+	"application/mpeg4-iod-xmt":                                               false,
+	"application/mrb-consumer+xml":                                            false,
+	"application/mrb-publish+xml":                                             false,
+	"application/msc-ivr+xml":                                                 false,
+	"application/msc-mixer+xml":                                               false,
+	"application/msword":                                                      false,
+	"application/mud+json":                                                    false,
+	"application/mxf":                                                         false,
+	"application/n-quads":                                                     false,
+	"application/n-triples":                                                   false,
+	"application/nasdata":                                                     false,
+	"application/news-checkgroups":                                            false,
+	"application/news-groupinfo":                                              false,
+	"application/news-transmission":                                           false,
+	"application/nlsml+xml":                                                   false,
+	"application/node":                                                        false,
+	"application/nss":                                                         false,
+	"application/ocsp-request":                                                false,
+	"application/ocsp-response":                                               false,
+	"application/octet-stream":                                                false,
+	"application/oda":                                                         false,
+	"application/odx":                                                         false,
+	"application/oebps-package+xml":                                           false,
+	"application/ogg":                                                         false,
+	"application/oxps":                                                        false,
+	"application/p2p-overlay+xml":                                             false,
+	"application/parityfec":                                                   false,
+	"application/passport":                                                    false,
+	"application/patch-ops-error+xml":                                         false,
+	"application/pdf":                                                         false,
+	"application/pdx":                                                         false,
+	"application/pgp-encrypted":                                               false,
+	"application/pgp-keys":                                                    false,
+	"application/pgp-signature":                                               false,
+	"application/pidf+xml":                                                    false,
+	"application/pidf-diff+xml":                                               false,
+	"application/pkcs10":                                                      false,
+	"application/pkcs12":                                                      false,
+	"application/pkcs7-mime":                                                  false,
+	"application/pkcs7-signature":                                             false,
+	"application/pkcs8":                                                       false,
+	"application/pkcs8-encrypted":                                             false,
+	"application/pkix-attr-cert":                                              false,
+	"application/pkix-cert":                                                   false,
+	"application/pkix-crl":                                                    false,
+	"application/pkix-pkipath":                                                false,
+	"application/pkixcmp":                                                     false,
+	"application/pls+xml":                                                     false,
+	"application/poc-settings+xml":                                            false,
+	"application/postscript":                                                  false,
+	"application/ppsp-tracker+json":                                           false,
+	"application/problem+json":                                                false,
+	"application/problem+xml":                                                 false,
+	"application/provenance+xml":                                              false,
+	"application/prs.alvestrand.titrax-sheet":                                 false,
+	"application/prs.cww":                                                     false,
+	"application/prs.hpub+zip":                                                false,
+	"application/prs.nprend":                                                  false,
+	"application/prs.plucker":                                                 false,
+	"application/prs.rdf-xml-crypt":                                           false,
+	"application/prs.xsf+xml":                                                 false,
+	"application/pskc+xml":                                                    false,
+	"application/qsig":                                                        false,
+	"application/raptorfec":                                                   false,
+	"application/rdap+json":                                                   false,
+	"application/rdf+xml":                                                     false,
+	"application/reginfo+xml":                                                 false,
+	"application/relax-ng-compact-syntax":                                     false,
+	"application/remote-printing":                                             false,
+	"application/reputon+json":                                                false,
+	"application/resource-lists+xml":                                          false,
+	"application/resource-lists-diff+xml":                                     false,
+	"application/rfc+xml":                                                     false,
+	"application/riscos":                                                      false,
+	"application/rlmi+xml":                                                    false,
+	"application/rls-services+xml":                                            false,
+	"application/route-apd+xml":                                               false,
+	"application/route-s-tsid+xml":                                            false,
+	"application/route-usd+xml":                                               false,
+	"application/rpki-ghostbusters":                                           false,
+	"application/rpki-manifest":                                               false,
+	"application/rpki-publication":                                            false,
+	"application/rpki-roa":                                                    false,
+	"application/rpki-updown":                                                 false,
+	"application/rtf":                                                         false,
+	"application/rtploopback":                                                 false,
+	"application/rtx":                                                         false,
+	"application/samlassertion+xml":                                           false,
+	"application/samlmetadata+xml":                                            false,
+	"application/sbml+xml":                                                    false,
+	"application/scaip+xml":                                                   false,
+	"application/scim+json":                                                   false,
+	"application/scvp-cv-request":                                             false,
+	"application/scvp-cv-response":                                            false,
+	"application/scvp-vp-request":                                             false,
+	"application/scvp-vp-response":                                            false,
+	"application/sdp":                                                         false,
+	"application/secevent+jwt":                                                false,
+	"application/senml+cbor":                                                  false,
+	"application/senml+json":                                                  false,
+	"application/senml+xml":                                                   false,
+	"application/senml-exi":                                                   false,
+	"application/sensml+cbor":                                                 false,
+	"application/sensml+json":                                                 false,
+	"application/sensml+xml":                                                  false,
+	"application/sensml-exi":                                                  false,
+	"application/sep+xml":                                                     false,
+	"application/sep-exi":                                                     false,
+	"application/session-info":                                                false,
+	"application/set-payment":                                                 false,
+	"application/set-payment-initiation":                                      false,
+	"application/set-registration":                                            false,
+	"application/set-registration-initiation":                                 false,
+	"application/sgml":                                                        false,
+	"application/sgml-open-catalog":                                           false,
+	"application/shf+xml":                                                     false,
+	"application/sieve":                                                       false,
+	"application/simple-filter+xml":                                           false,
+	"application/simple-message-summary":                                      false,
+	"application/simplesymbolcontainer":                                       false,
+	"application/slate":                                                       false,
+	"application/smil":                                                        false,
+	"application/smil+xml":                                                    false,
+	"application/smpte336m":                                                   false,
+	"application/soap+fastinfoset":                                            false,
+	"application/soap+xml":                                                    false,
+	"application/sparql-query":                                                false,
+	"application/sparql-results+xml":                                          false,
+	"application/spirits-event+xml":                                           false,
+	"application/sql":                                                         false,
+	"application/srgs":                                                        false,
+	"application/srgs+xml":                                                    false,
+	"application/sru+xml":                                                     false,
+	"application/ssml+xml":                                                    false,
+	"application/stix+json":                                                   false,
+	"application/tamp-apex-update":                                            false,
+	"application/tamp-apex-update-confirm":                                    false,
+	"application/tamp-community-update":                                       false,
+	"application/tamp-community-update-confirm":                               false,
+	"application/tamp-error":                                                  false,
+	"application/tamp-sequence-adjust":                                        false,
+	"application/tamp-sequence-adjust-confirm":                                false,
+	"application/tamp-status-query":                                           false,
+	"application/tamp-status-response":                                        false,
+	"application/tamp-update":                                                 false,
+	"application/tamp-update-confirm":                                         false,
+	"application/taxii+json":                                                  false,
+	"application/tei+xml":                                                     false,
+	"application/thraud+xml":                                                  false,
+	"application/timestamp-query":                                             false,
+	"application/timestamp-reply":                                             false,
+	"application/timestamped-data":                                            false,
+	"application/tlsrpt+gzip":                                                 false,
+	"application/tlsrpt+json":                                                 false,
+	"application/tnauthlist":                                                  false,
+	"application/trickle-ice-sdpfrag":                                         false,
+	"application/trig":                                                        false,
+	"application/ttml+xml":                                                    false,
+	"application/tve-trigger":                                                 false,
+	"application/ulpfec":                                                      false,
+	"application/urc-grpsheet+xml":                                            false,
+	"application/urc-ressheet+xml":                                            false,
+	"application/urc-targetdesc+xml":                                          false,
+	"application/urc-uisocketdesc+xml":                                        false,
+	"application/vcard+json":                                                  false,
+	"application/vcard+xml":                                                   false,
+	"application/vemmi":                                                       false,
+	"application/vnd.1000minds.decision-model+xml":                            false,
+	"application/vnd.3gpp-prose+xml":                                          false,
+	"application/vnd.3gpp-prose-pc3ch+xml":                                    false,
+	"application/vnd.3gpp-v2x-local-service-information":                      false,
+	"application/vnd.3gpp.access-transfer-events+xml":                         false,
+	"application/vnd.3gpp.bsf+xml":                                            false,
+	"application/vnd.3gpp.gmop+xml":                                           false,
+	"application/vnd.3gpp.mcdata-payload":                                     false,
+	"application/vnd.3gpp.mcdata-signalling":                                  false,
+	"application/vnd.3gpp.mcptt-affiliation-command+xml":                      false,
+	"application/vnd.3gpp.mcptt-floor-request+xml":                            false,
+	"application/vnd.3gpp.mcptt-info+xml":                                     false,
+	"application/vnd.3gpp.mcptt-location-info+xml":                            false,
+	"application/vnd.3gpp.mcptt-mbms-usage-info+xml":                          false,
+	"application/vnd.3gpp.mcptt-signed+xml":                                   false,
+	"application/vnd.3gpp.mid-call+xml":                                       false,
+	"application/vnd.3gpp.pic-bw-large":                                       false,
+	"application/vnd.3gpp.pic-bw-small":                                       false,
+	"application/vnd.3gpp.pic-bw-var":                                         false,
+	"application/vnd.3gpp.sms":                                                false,
+	"application/vnd.3gpp.sms+xml":                                            false,
+	"application/vnd.3gpp.srvcc-ext+xml":                                      false,
+	"application/vnd.3gpp.srvcc-info+xml":                                     false,
+	"application/vnd.3gpp.state-and-event-info+xml":                           false,
+	"application/vnd.3gpp.ussd+xml":                                           false,
+	"application/vnd.3gpp2.bcmcsinfo+xml":                                     false,
+	"application/vnd.3gpp2.sms":                                               false,
+	"application/vnd.3gpp2.tcap":                                              false,
+	"application/vnd.3lightssoftware.imagescal":                               false,
+	"application/vnd.3m.post-it-notes":                                        false,
+	"application/vnd.accpac.simply.aso":                                       false,
+	"application/vnd.accpac.simply.imp":                                       false,
+	"application/vnd.acucobol":                                                false,
+	"application/vnd.acucorp":                                                 false,
+	"application/vnd.adobe.flash.movie":                                       false,
+	"application/vnd.adobe.formscentral.fcdt":                                 false,
+	"application/vnd.adobe.fxp":                                               false,
+	"application/vnd.adobe.partial-upload":                                    false,
+	"application/vnd.adobe.xdp+xml":                                           false,
+	"application/vnd.adobe.xfdf":                                              false,
+	"application/vnd.aether.imp":                                              false,
+	"application/vnd.afpc.afplinedata":                                        false,
+	"application/vnd.afpc.modca":                                              false,
+	"application/vnd.ah-barcode":                                              false,
+	"application/vnd.ahead.space":                                             false,
+	"application/vnd.airzip.filesecure.azf":                                   false,
+	"application/vnd.airzip.filesecure.azs":                                   false,
+	"application/vnd.amadeus+json":                                            false,
+	"application/vnd.amazon.mobi8-ebook":                                      false,
+	"application/vnd.americandynamics.acc":                                    false,
+	"application/vnd.amiga.ami":                                               false,
+	"application/vnd.amundsen.maze+xml":                                       false,
+	"application/vnd.anki":                                                    false,
+	"application/vnd.anser-web-certificate-issue-initiation":                  false,
+	"application/vnd.antix.game-component":                                    false,
+	"application/vnd.apache.thrift.binary":                                    false,
+	"application/vnd.apache.thrift.compact":                                   false,
+	"application/vnd.apache.thrift.json":                                      false,
+	"application/vnd.api+json":                                                false,
+	"application/vnd.apothekende.reservation+json":                            false,
+	"application/vnd.apple.installer+xml":                                     false,
+	"application/vnd.apple.mpegurl":                                           false,
+	"application/vnd.arastra.swi":                                             true,
+	"application/vnd.aristanetworks.swi":                                      false,
+	"application/vnd.artisan+json":                                            false,
+	"application/vnd.artsquare":                                               false,
+	"application/vnd.astraea-software.iota":                                   false,
+	"application/vnd.audiograph":                                              false,
+	"application/vnd.autopackage":                                             false,
+	"application/vnd.avalon+json":                                             false,
+	"application/vnd.avistar+xml":                                             false,
+	"application/vnd.balsamiq.bmml+xml":                                       false,
+	"application/vnd.balsamiq.bmpr":                                           false,
+	"application/vnd.banana-accounting":                                       false,
+	"application/vnd.bbf.usp.msg":                                             false,
+	"application/vnd.bbf.usp.msg+json":                                        false,
+	"application/vnd.bekitzur-stech+json":                                     false,
+	"application/vnd.bint.med-content":                                        false,
+	"application/vnd.biopax.rdf+xml":                                          false,
+	"application/vnd.blink-idb-value-wrapper":                                 false,
+	"application/vnd.blueice.multipass":                                       false,
+	"application/vnd.bluetooth.ep.oob":                                        false,
+	"application/vnd.bluetooth.le.oob":                                        false,
+	"application/vnd.bmi":                                                     false,
+	"application/vnd.businessobjects":                                         false,
+	"application/vnd.byu.uapi+json":                                           false,
+	"application/vnd.cab-jscript":                                             false,
+	"application/vnd.canon-cpdl":                                              false,
+	"application/vnd.canon-lips":                                              false,
+	"application/vnd.capasystems-pg+json":                                     false,
+	"application/vnd.cendio.thinlinc.clientconf":                              false,
+	"application/vnd.century-systems.tcp_stream":                              false,
+	"application/vnd.chemdraw+xml":                                            false,
+	"application/vnd.chess-pgn":                                               false,
+	"application/vnd.chipnuts.karaoke-mmd":                                    false,
+	"application/vnd.cinderella":                                              false,
+	"application/vnd.cirpack.isdn-ext":                                        false,
+	"application/vnd.citationstyles.style+xml":                                false,
+	"application/vnd.claymore":                                                false,
+	"application/vnd.cloanto.rp9":                                             false,
+	"application/vnd.clonk.c4group":                                           false,
+	"application/vnd.cluetrust.cartomobile-config":                            false,
+	"application/vnd.cluetrust.cartomobile-config-pkg":                        false,
+	"application/vnd.coffeescript":                                            false,
+	"application/vnd.collabio.xodocuments.document":                           false,
+	"application/vnd.collabio.xodocuments.document-template":                  false,
+	"application/vnd.collabio.xodocuments.presentation":                       false,
+	"application/vnd.collabio.xodocuments.presentation-template":              false,
+	"application/vnd.collabio.xodocuments.spreadsheet":                        false,
+	"application/vnd.collabio.xodocuments.spreadsheet-template":               false,
+	"application/vnd.collection+json":                                         false,
+	"application/vnd.collection.doc+json":                                     false,
+	"application/vnd.collection.next+json":                                    false,
+	"application/vnd.comicbook+zip":                                           false,
+	"application/vnd.comicbook-rar":                                           false,
+	"application/vnd.commerce-battelle":                                       false,
+	"application/vnd.commonspace":                                             false,
+	"application/vnd.contact.cmsg":                                            false,
+	"application/vnd.coreos.ignition+json":                                    false,
+	"application/vnd.cosmocaller":                                             false,
+	"application/vnd.crick.clicker":                                           false,
+	"application/vnd.crick.clicker.keyboard":                                  false,
+	"application/vnd.crick.clicker.palette":                                   false,
+	"application/vnd.crick.clicker.template":                                  false,
+	"application/vnd.crick.clicker.wordbank":                                  false,
+	"application/vnd.criticaltools.wbs+xml":                                   false,
+	"application/vnd.ctc-posml":                                               false,
+	"application/vnd.ctct.ws+xml":                                             false,
+	"application/vnd.cups-pdf":                                                false,
+	"application/vnd.cups-postscript":                                         false,
+	"application/vnd.cups-ppd":                                                false,
+	"application/vnd.cups-raster":                                             false,
+	"application/vnd.cups-raw":                                                false,
+	"application/vnd.curl":                                                    false,
+	"application/vnd.cyan.dean.root+xml":                                      false,
+	"application/vnd.cybank":                                                  false,
+	"application/vnd.d2l.coursepackage1p0+zip":                                false,
+	"application/vnd.dart":                                                    false,
+	"application/vnd.data-vision.rdz":                                         false,
+	"application/vnd.datapackage+json":                                        false,
+	"application/vnd.dataresource+json":                                       false,
+	"application/vnd.debian.binary-package":                                   false,
+	"application/vnd.dece.data":                                               false,
+	"application/vnd.dece.ttml+xml":                                           false,
+	"application/vnd.dece.unspecified":                                        false,
+	"application/vnd.dece.zip":                                                false,
+	"application/vnd.denovo.fcselayout-link":                                  false,
+	"application/vnd.desmume-movie":                                           false,
+	"application/vnd.dir-bi.plate-dl-nosuffix":                                false,
+	"application/vnd.dm.delegation+xml":                                       false,
+	"application/vnd.dna":                                                     false,
+	"application/vnd.document+json":                                           false,
+	"application/vnd.dolby.mobile.1":                                          false,
+	"application/vnd.dolby.mobile.2":                                          false,
+	"application/vnd.doremir.scorecloud-binary-document":                      false,
+	"application/vnd.dpgraph":                                                 false,
+	"application/vnd.dreamfactory":                                            false,
+	"application/vnd.drive+json":                                              false,
+	"application/vnd.dtg.local":                                               false,
+	"application/vnd.dtg.local.flash":                                         false,
+	"application/vnd.dtg.local.html":                                          false,
+	"application/vnd.dvb.ait":                                                 false,
+	"application/vnd.dvb.dvbj":                                                false,
+	"application/vnd.dvb.esgcontainer":                                        false,
+	"application/vnd.dvb.ipdcdftnotifaccess":                                  false,
+	"application/vnd.dvb.ipdcesgaccess":                                       false,
+	"application/vnd.dvb.ipdcesgaccess2":                                      false,
+	"application/vnd.dvb.ipdcesgpdd":                                          false,
+	"application/vnd.dvb.ipdcroaming":                                         false,
+	"application/vnd.dvb.iptv.alfec-base":                                     false,
+	"application/vnd.dvb.iptv.alfec-enhancement":                              false,
+	"application/vnd.dvb.notif-aggregate-root+xml":                            false,
+	"application/vnd.dvb.notif-container+xml":                                 false,
+	"application/vnd.dvb.notif-generic+xml":                                   false,
+	"application/vnd.dvb.notif-ia-msglist+xml":                                false,
+	"application/vnd.dvb.notif-ia-registration-request+xml":                   false,
+	"application/vnd.dvb.notif-ia-registration-response+xml":                  false,
+	"application/vnd.dvb.notif-init+xml":                                      false,
+	"application/vnd.dvb.pfr":                                                 false,
+	"application/vnd.dvb.service":                                             false,
+	"application/vnd.dxr":                                                     false,
+	"application/vnd.dynageo":                                                 false,
+	"application/vnd.dzr":                                                     false,
+	"application/vnd.easykaraoke.cdgdownload":                                 false,
+	"application/vnd.ecdis-update":                                            false,
+	"application/vnd.ecip.rlp":                                                false,
+	"application/vnd.ecowin.chart":                                            false,
+	"application/vnd.ecowin.filerequest":                                      false,
+	"application/vnd.ecowin.fileupdate":                                       false,
+	"application/vnd.ecowin.series":                                           false,
+	"application/vnd.ecowin.seriesrequest":                                    false,
+	"application/vnd.ecowin.seriesupdate":                                     false,
+	"application/vnd.efi.img":                                                 false,
+	"application/vnd.efi.iso":                                                 false,
+	"application/vnd.emclient.accessrequest+xml":                              false,
+	"application/vnd.enliven":                                                 false,
+	"application/vnd.enphase.envoy":                                           false,
+	"application/vnd.eprints.data+xml":                                        false,
+	"application/vnd.epson.esf":                                               false,
+	"application/vnd.epson.msf":                                               false,
+	"application/vnd.epson.quickanime":                                        false,
+	"application/vnd.epson.salt":                                              false,
+	"application/vnd.epson.ssf":                                               false,
+	"application/vnd.ericsson.quickcall":                                      false,
+	"application/vnd.espass-espass+zip":                                       false,
+	"application/vnd.eszigno3+xml":                                            false,
+	"application/vnd.etsi.aoc+xml":                                            false,
+	"application/vnd.etsi.asic-e+zip":                                         false,
+	"application/vnd.etsi.asic-s+zip":                                         false,
+	"application/vnd.etsi.cug+xml":                                            false,
+	"application/vnd.etsi.iptvcommand+xml":                                    false,
+	"application/vnd.etsi.iptvdiscovery+xml":                                  false,
+	"application/vnd.etsi.iptvprofile+xml":                                    false,
+	"application/vnd.etsi.iptvsad-bc+xml":                                     false,
+	"application/vnd.etsi.iptvsad-cod+xml":                                    false,
+	"application/vnd.etsi.iptvsad-npvr+xml":                                   false,
+	"application/vnd.etsi.iptvservice+xml":                                    false,
+	"application/vnd.etsi.iptvsync+xml":                                       false,
+	"application/vnd.etsi.iptvueprofile+xml":                                  false,
+	"application/vnd.etsi.mcid+xml":                                           false,
+	"application/vnd.etsi.mheg5":                                              false,
+	"application/vnd.etsi.overload-control-policy-dataset+xml":                false,
+	"application/vnd.etsi.pstn+xml":                                           false,
+	"application/vnd.etsi.sci+xml":                                            false,
+	"application/vnd.etsi.simservs+xml":                                       false,
+	"application/vnd.etsi.timestamp-token":                                    false,
+	"application/vnd.etsi.tsl+xml":                                            false,
+	"application/vnd.etsi.tsl.der":                                            false,
+	"application/vnd.eudora.data":                                             false,
+	"application/vnd.evolv.ecig.profile":                                      false,
+	"application/vnd.evolv.ecig.settings":                                     false,
+	"application/vnd.evolv.ecig.theme":                                        false,
+	"application/vnd.ezpix-album":                                             false,
+	"application/vnd.ezpix-package":                                           false,
+	"application/vnd.f-secure.mobile":                                         false,
+	"application/vnd.fastcopy-disk-image":                                     false,
+	"application/vnd.fdf":                                                     false,
+	"application/vnd.fdsn.mseed":                                              false,
+	"application/vnd.fdsn.seed":                                               false,
+	"application/vnd.ffsns":                                                   false,
+	"application/vnd.filmit.zfc":                                              false,
+	"application/vnd.fints":                                                   false,
+	"application/vnd.firemonkeys.cloudcell":                                   false,
+	"application/vnd.flographit":                                              false,
+	"application/vnd.fluxtime.clip":                                           false,
+	"application/vnd.font-fontforge-sfd":                                      false,
+	"application/vnd.framemaker":                                              false,
+	"application/vnd.frogans.fnc":                                             false,
+	"application/vnd.frogans.ltf":                                             false,
+	"application/vnd.fsc.weblaunch":                                           false,
+	"application/vnd.fujitsu.oasys":                                           false,
+	"application/vnd.fujitsu.oasys2":                                          false,
+	"application/vnd.fujitsu.oasys3":                                          false,
+	"application/vnd.fujitsu.oasysgp":                                         false,
+	"application/vnd.fujitsu.oasysprs":                                        false,
+	"application/vnd.fujixerox.art-ex":                                        false,
+	"application/vnd.fujixerox.art4":                                          false,
+	"application/vnd.fujixerox.ddd":                                           false,
+	"application/vnd.fujixerox.docuworks":                                     false,
+	"application/vnd.fujixerox.docuworks.binder":                              false,
+	"application/vnd.fujixerox.docuworks.container":                           false,
+	"application/vnd.fujixerox.hbpl":                                          false,
+	"application/vnd.fut-misnet":                                              false,
+	"application/vnd.fuzzysheet":                                              false,
+	"application/vnd.genomatix.tuxedo":                                        false,
+	"application/vnd.geo+json":                                                true,
+	"application/vnd.geocube+xml":                                             false,
+	"application/vnd.geogebra.file":                                           false,
+	"application/vnd.geogebra.tool":                                           false,
+	"application/vnd.geometry-explorer":                                       false,
+	"application/vnd.geonext":                                                 false,
+	"application/vnd.geoplan":                                                 false,
+	"application/vnd.geospace":                                                false,
+	"application/vnd.gerber":                                                  false,
+	"application/vnd.globalplatform.card-content-mgt":                         false,
+	"application/vnd.globalplatform.card-content-mgt-response":                false,
+	"application/vnd.gmx":                                                     false,
+	"application/vnd.google-earth.kml+xml":                                    false,
+	"application/vnd.google-earth.kmz":                                        false,
+	"application/vnd.gov.sk.e-form+xml":                                       false,
+	"application/vnd.gov.sk.e-form+zip":                                       false,
+	"application/vnd.gov.sk.xmldatacontainer+xml":                             false,
+	"application/vnd.grafeq":                                                  false,
+	"application/vnd.gridmp":                                                  false,
+	"application/vnd.groove-account":                                          false,
+	"application/vnd.groove-help":                                             false,
+	"application/vnd.groove-identity-message":                                 false,
+	"application/vnd.groove-injector":                                         false,
+	"application/vnd.groove-tool-message":                                     false,
+	"application/vnd.groove-tool-template":                                    false,
+	"application/vnd.groove-vcard":                                            false,
+	"application/vnd.hal+json":                                                false,
+	"application/vnd.hal+xml":                                                 false,
+	"application/vnd.handheld-entertainment+xml":                              false,
+	"application/vnd.hbci":                                                    false,
+	"application/vnd.hc+json":                                                 false,
+	"application/vnd.hcl-bireports":                                           false,
+	"application/vnd.hdt":                                                     false,
+	"application/vnd.heroku+json":                                             false,
+	"application/vnd.hhe.lesson-player":                                       false,
+	"application/vnd.hp-hpgl":                                                 false,
+	"application/vnd.hp-hpid":                                                 false,
+	"application/vnd.hp-hps":                                                  false,
+	"application/vnd.hp-jlyt":                                                 false,
+	"application/vnd.hp-pcl":                                                  false,
+	"application/vnd.hp-pclxl":                                                false,
+	"application/vnd.httphone":                                                false,
+	"application/vnd.hydrostatix.sof-data":                                    false,
+	"application/vnd.hyper+json":                                              false,
+	"application/vnd.hyper-item+json":                                         false,
+	"application/vnd.hyperdrive+json":                                         false,
+	"application/vnd.hzn-3d-crossword":                                        false,
+	"application/vnd.ibm.afplinedata":                                         false,
+	"application/vnd.ibm.electronic-media":                                    false,
+	"application/vnd.ibm.minipay":                                             false,
+	"application/vnd.ibm.modcap":                                              false,
+	"application/vnd.ibm.rights-management":                                   false,
+	"application/vnd.ibm.secure-container":                                    false,
+	"application/vnd.iccprofile":                                              false,
+	"application/vnd.ieee.1905":                                               false,
+	"application/vnd.igloader":                                                false,
+	"application/vnd.imagemeter.folder+zip":                                   false,
+	"application/vnd.imagemeter.image+zip":                                    false,
+	"application/vnd.immervision-ivp":                                         false,
+	"application/vnd.immervision-ivu":                                         false,
+	"application/vnd.ims.imsccv1p1":                                           false,
+	"application/vnd.ims.imsccv1p2":                                           false,
+	"application/vnd.ims.imsccv1p3":                                           false,
+	"application/vnd.ims.lis.v2.result+json":                                  false,
+	"application/vnd.ims.lti.v2.toolconsumerprofile+json":                     false,
+	"application/vnd.ims.lti.v2.toolproxy+json":                               false,
+	"application/vnd.ims.lti.v2.toolproxy.id+json":                            false,
+	"application/vnd.ims.lti.v2.toolsettings+json":                            false,
+	"application/vnd.ims.lti.v2.toolsettings.simple+json":                     false,
+	"application/vnd.informedcontrol.rms+xml":                                 false,
+	"application/vnd.informix-visionary":                                      true,
+	"application/vnd.infotech.project":                                        false,
+	"application/vnd.infotech.project+xml":                                    false,
+	"application/vnd.innopath.wamp.notification":                              false,
+	"application/vnd.insors.igm":                                              false,
+	"application/vnd.intercon.formnet":                                        false,
+	"application/vnd.intergeo":                                                false,
+	"application/vnd.intertrust.digibox":                                      false,
+	"application/vnd.intertrust.nncp":                                         false,
+	"application/vnd.intu.qbo":                                                false,
+	"application/vnd.intu.qfx":                                                false,
+	"application/vnd.iptc.g2.catalogitem+xml":                                 false,
+	"application/vnd.iptc.g2.conceptitem+xml":                                 false,
+	"application/vnd.iptc.g2.knowledgeitem+xml":                               false,
+	"application/vnd.iptc.g2.newsitem+xml":                                    false,
+	"application/vnd.iptc.g2.newsmessage+xml":                                 false,
+	"application/vnd.iptc.g2.packageitem+xml":                                 false,
+	"application/vnd.iptc.g2.planningitem+xml":                                false,
+	"application/vnd.ipunplugged.rcprofile":                                   false,
+	"application/vnd.irepository.package+xml":                                 false,
+	"application/vnd.is-xpr":                                                  false,
+	"application/vnd.isac.fcs":                                                false,
+	"application/vnd.jam":                                                     false,
+	"application/vnd.japannet-directory-service":                              false,
+	"application/vnd.japannet-jpnstore-wakeup":                                false,
+	"application/vnd.japannet-payment-wakeup":                                 false,
+	"application/vnd.japannet-registration":                                   false,
+	"application/vnd.japannet-registration-wakeup":                            false,
+	"application/vnd.japannet-setstore-wakeup":                                false,
+	"application/vnd.japannet-verification":                                   false,
+	"application/vnd.japannet-verification-wakeup":                            false,
+	"application/vnd.jcp.javame.midlet-rms":                                   false,
+	"application/vnd.jisp":                                                    false,
+	"application/vnd.joost.joda-archive":                                      false,
+	"application/vnd.jsk.isdn-ngn":                                            false,
+	"application/vnd.kahootz":                                                 false,
+	"application/vnd.kde.karbon":                                              false,
+	"application/vnd.kde.kchart":                                              false,
+	"application/vnd.kde.kformula":                                            false,
+	"application/vnd.kde.kivio":                                               false,
+	"application/vnd.kde.kontour":                                             false,
+	"application/vnd.kde.kpresenter":                                          false,
+	"application/vnd.kde.kspread":                                             false,
+	"application/vnd.kde.kword":                                               false,
+	"application/vnd.kenameaapp":                                              false,
+	"application/vnd.kidspiration":                                            false,
+	"application/vnd.kinar":                                                   false,
+	"application/vnd.koan":                                                    false,
+	"application/vnd.kodak-descriptor":                                        false,
+	"application/vnd.las.las+json":                                            false,
+	"application/vnd.las.las+xml":                                             false,
+	"application/vnd.leap+json":                                               false,
+	"application/vnd.liberty-request+xml":                                     false,
+	"application/vnd.llamagraphics.life-balance.desktop":                      false,
+	"application/vnd.llamagraphics.life-balance.exchange+xml":                 false,
+	"application/vnd.lotus-1-2-3":                                             false,
+	"application/vnd.lotus-approach":                                          false,
+	"application/vnd.lotus-freelance":                                         false,
+	"application/vnd.lotus-notes":                                             false,
+	"application/vnd.lotus-organizer":                                         false,
+	"application/vnd.lotus-screencam":                                         false,
+	"application/vnd.lotus-wordpro":                                           false,
+	"application/vnd.macports.portpkg":                                        false,
+	"application/vnd.mapbox-vector-tile":                                      false,
+	"application/vnd.marlin.drm.actiontoken+xml":                              false,
+	"application/vnd.marlin.drm.conftoken+xml":                                false,
+	"application/vnd.marlin.drm.license+xml":                                  false,
+	"application/vnd.marlin.drm.mdcf":                                         false,
+	"application/vnd.mason+json":                                              false,
+	"application/vnd.maxmind.maxmind-db":                                      false,
+	"application/vnd.mcd":                                                     false,
+	"application/vnd.medcalcdata":                                             false,
+	"application/vnd.mediastation.cdkey":                                      false,
+	"application/vnd.meridian-slingshot":                                      false,
+	"application/vnd.mfer":                                                    false,
+	"application/vnd.mfmp":                                                    false,
+	"application/vnd.micro+json":                                              false,
+	"application/vnd.micrografx.flo":                                          false,
+	"application/vnd.micrografx.igx":                                          false,
+	"application/vnd.microsoft.portable-executable":                           false,
+	"application/vnd.microsoft.windows.thumbnail-cache":                       false,
+	"application/vnd.miele+json":                                              false,
+	"application/vnd.mif":                                                     false,
+	"application/vnd.minisoft-hp3000-save":                                    false,
+	"application/vnd.mitsubishi.misty-guard.trustweb":                         false,
+	"application/vnd.mobius.daf":                                              false,
+	"application/vnd.mobius.dis":                                              false,
+	"application/vnd.mobius.mbk":                                              false,
+	"application/vnd.mobius.mqy":                                              false,
+	"application/vnd.mobius.msl":                                              false,
+	"application/vnd.mobius.plc":                                              false,
+	"application/vnd.mobius.txf":                                              false,
+	"application/vnd.mophun.application":                                      false,
+	"application/vnd.mophun.certificate":                                      false,
+	"application/vnd.motorola.flexsuite":                                      false,
+	"application/vnd.motorola.flexsuite.adsi":                                 false,
+	"application/vnd.motorola.flexsuite.fis":                                  false,
+	"application/vnd.motorola.flexsuite.gotap":                                false,
+	"application/vnd.motorola.flexsuite.kmr":                                  false,
+	"application/vnd.motorola.flexsuite.ttc":                                  false,
+	"application/vnd.motorola.flexsuite.wem":                                  false,
+	"application/vnd.motorola.iprm":                                           false,
+	"application/vnd.mozilla.xul+xml":                                         false,
+	"application/vnd.ms-3mfdocument":                                          false,
+	"application/vnd.ms-artgalry":                                             false,
+	"application/vnd.ms-asf":                                                  false,
+	"application/vnd.ms-cab-compressed":                                       false,
+	"application/vnd.ms-excel":                                                false,
+	"application/vnd.ms-excel.addin.macroenabled.12":                          false,
+	"application/vnd.ms-excel.sheet.binary.macroenabled.12":                   false,
+	"application/vnd.ms-excel.sheet.macroenabled.12":                          false,
+	"application/vnd.ms-excel.template.macroenabled.12":                       false,
+	"application/vnd.ms-fontobject":                                           false,
+	"application/vnd.ms-htmlhelp":                                             false,
+	"application/vnd.ms-ims":                                                  false,
+	"application/vnd.ms-lrm":                                                  false,
+	"application/vnd.ms-office.activex+xml":                                   false,
+	"application/vnd.ms-officetheme":                                          false,
+	"application/vnd.ms-playready.initiator+xml":                              false,
+	"application/vnd.ms-powerpoint":                                           false,
+	"application/vnd.ms-powerpoint.addin.macroenabled.12":                     false,
+	"application/vnd.ms-powerpoint.presentation.macroenabled.12":              false,
+	"application/vnd.ms-powerpoint.slide.macroenabled.12":                     false,
+	"application/vnd.ms-powerpoint.slideshow.macroenabled.12":                 false,
+	"application/vnd.ms-powerpoint.template.macroenabled.12":                  false,
+	"application/vnd.ms-printdevicecapabilities+xml":                          false,
+	"application/vnd.ms-printschematicket+xml":                                false,
+	"application/vnd.ms-project":                                              false,
+	"application/vnd.ms-tnef":                                                 false,
+	"application/vnd.ms-windows.devicepairing":                                false,
+	"application/vnd.ms-windows.nwprinting.oob":                               false,
+	"application/vnd.ms-windows.printerpairing":                               false,
+	"application/vnd.ms-windows.wsd.oob":                                      false,
+	"application/vnd.ms-wmdrm.lic-chlg-req":                                   false,
+	"application/vnd.ms-wmdrm.lic-resp":                                       false,
+	"application/vnd.ms-wmdrm.meter-chlg-req":                                 false,
+	"application/vnd.ms-wmdrm.meter-resp":                                     false,
+	"application/vnd.ms-word.document.macroenabled.12":                        false,
+	"application/vnd.ms-word.template.macroenabled.12":                        false,
+	"application/vnd.ms-works":                                                false,
+	"application/vnd.ms-wpl":                                                  false,
+	"application/vnd.ms-xpsdocument":                                          false,
+	"application/vnd.msa-disk-image":                                          false,
+	"application/vnd.mseq":                                                    false,
+	"application/vnd.msign":                                                   false,
+	"application/vnd.multiad.creator":                                         false,
+	"application/vnd.multiad.creator.cif":                                     false,
+	"application/vnd.music-niff":                                              false,
+	"application/vnd.musician":                                                false,
+	"application/vnd.muvee.style":                                             false,
+	"application/vnd.mynfc":                                                   false,
+	"application/vnd.ncd.control":                                             false,
+	"application/vnd.ncd.reference":                                           false,
+	"application/vnd.nearst.inv+json":                                         false,
+	"application/vnd.nervana":                                                 false,
+	"application/vnd.netfpx":                                                  false,
+	"application/vnd.neurolanguage.nlu":                                       false,
+	"application/vnd.nimn":                                                    false,
+	"application/vnd.nintendo.nitro.rom":                                      false,
+	"application/vnd.nintendo.snes.rom":                                       false,
+	"application/vnd.nitf":                                                    false,
+	"application/vnd.noblenet-directory":                                      false,
+	"application/vnd.noblenet-sealer":                                         false,
+	"application/vnd.noblenet-web":                                            false,
+	"application/vnd.nokia.catalogs":                                          false,
+	"application/vnd.nokia.conml+wbxml":                                       false,
+	"application/vnd.nokia.conml+xml":                                         false,
+	"application/vnd.nokia.iptv.config+xml":                                   false,
+	"application/vnd.nokia.isds-radio-presets":                                false,
+	"application/vnd.nokia.landmark+wbxml":                                    false,
+	"application/vnd.nokia.landmark+xml":                                      false,
+	"application/vnd.nokia.landmarkcollection+xml":                            false,
+	"application/vnd.nokia.n-gage.ac+xml":                                     false,
+	"application/vnd.nokia.n-gage.data":                                       true,
+	"application/vnd.nokia.n-gage.symbian.install":                            true,
+	"application/vnd.nokia.ncd":                                               false,
+	"application/vnd.nokia.pcd+wbxml":                                         false,
+	"application/vnd.nokia.pcd+xml":                                           false,
+	"application/vnd.nokia.radio-preset":                                      false,
+	"application/vnd.nokia.radio-presets":                                     false,
+	"application/vnd.novadigm.edm":                                            false,
+	"application/vnd.novadigm.edx":                                            false,
+	"application/vnd.novadigm.ext":                                            false,
+	"application/vnd.ntt-local.content-share":                                 false,
+	"application/vnd.ntt-local.file-transfer":                                 false,
+	"application/vnd.ntt-local.ogw_remote-access":                             false,
+	"application/vnd.ntt-local.sip-ta_remote":                                 false,
+	"application/vnd.ntt-local.sip-ta_tcp_stream":                             false,
+	"application/vnd.oasis.opendocument.chart":                                false,
+	"application/vnd.oasis.opendocument.chart-template":                       false,
+	"application/vnd.oasis.opendocument.database":                             false,
+	"application/vnd.oasis.opendocument.formula":                              false,
+	"application/vnd.oasis.opendocument.formula-template":                     false,
+	"application/vnd.oasis.opendocument.graphics":                             false,
+	"application/vnd.oasis.opendocument.graphics-template":                    false,
+	"application/vnd.oasis.opendocument.image":                                false,
+	"application/vnd.oasis.opendocument.image-template":                       false,
+	"application/vnd.oasis.opendocument.presentation":                         false,
+	"application/vnd.oasis.opendocument.presentation-template":                false,
+	"application/vnd.oasis.opendocument.spreadsheet":                          false,
+	"application/vnd.oasis.opendocument.spreadsheet-template":                 false,
+	"application/vnd.oasis.opendocument.text":                                 false,
+	"application/vnd.oasis.opendocument.text-master":                          false,
+	"application/vnd.oasis.opendocument.text-template":                        false,
+	"application/vnd.oasis.opendocument.text-web":                             false,
+	"application/vnd.obn":                                                     false,
+	"application/vnd.ocf+cbor":                                                false,
+	"application/vnd.oftn.l10n+json":                                          false,
+	"application/vnd.oipf.contentaccessdownload+xml":                          false,
+	"application/vnd.oipf.contentaccessstreaming+xml":                         false,
+	"application/vnd.oipf.cspg-hexbinary":                                     false,
+	"application/vnd.oipf.dae.svg+xml":                                        false,
+	"application/vnd.oipf.dae.xhtml+xml":                                      false,
+	"application/vnd.oipf.mippvcontrolmessage+xml":                            false,
+	"application/vnd.oipf.pae.gem":                                            false,
+	"application/vnd.oipf.spdiscovery+xml":                                    false,
+	"application/vnd.oipf.spdlist+xml":                                        false,
+	"application/vnd.oipf.ueprofile+xml":                                      false,
+	"application/vnd.oipf.userprofile+xml":                                    false,
+	"application/vnd.olpc-sugar":                                              false,
+	"application/vnd.oma-scws-config":                                         false,
+	"application/vnd.oma-scws-http-request":                                   false,
+	"application/vnd.oma-scws-http-response":                                  false,
+	"application/vnd.oma.bcast.associated-procedure-parameter+xml":            false,
+	"application/vnd.oma.bcast.drm-trigger+xml":                               false,
+	"application/vnd.oma.bcast.imd+xml":                                       false,
+	"application/vnd.oma.bcast.ltkm":                                          false,
+	"application/vnd.oma.bcast.notification+xml":                              false,
+	"application/vnd.oma.bcast.provisioningtrigger":                           false,
+	"application/vnd.oma.bcast.sgboot":                                        false,
+	"application/vnd.oma.bcast.sgdd+xml":                                      false,
+	"application/vnd.oma.bcast.sgdu":                                          false,
+	"application/vnd.oma.bcast.simple-symbol-container":                       false,
+	"application/vnd.oma.bcast.smartcard-trigger+xml":                         false,
+	"application/vnd.oma.bcast.sprov+xml":                                     false,
+	"application/vnd.oma.bcast.stkm":                                          false,
+	"application/vnd.oma.cab-address-book+xml":                                false,
+	"application/vnd.oma.cab-feature-handler+xml":                             false,
+	"application/vnd.oma.cab-pcc+xml":                                         false,
+	"application/vnd.oma.cab-subs-invite+xml":                                 false,
+	"application/vnd.oma.cab-user-prefs+xml":                                  false,
+	"application/vnd.oma.dcd":                                                 false,
+	"application/vnd.oma.dcdc":                                                false,
+	"application/vnd.oma.dd2+xml":                                             false,
+	"application/vnd.oma.drm.risd+xml":                                        false,
+	"application/vnd.oma.group-usage-list+xml":                                false,
+	"application/vnd.oma.lwm2m+json":                                          false,
+	"application/vnd.oma.lwm2m+tlv":                                           false,
+	"application/vnd.oma.pal+xml":                                             false,
+	"application/vnd.oma.poc.detailed-progress-report+xml":                    false,
+	"application/vnd.oma.poc.final-report+xml":                                false,
+	"application/vnd.oma.poc.groups+xml":                                      false,
+	"application/vnd.oma.poc.invocation-descriptor+xml":                       false,
+	"application/vnd.oma.poc.optimized-progress-report+xml":                   false,
+	"application/vnd.oma.push":                                                false,
+	"application/vnd.oma.scidm.messages+xml":                                  false,
+	"application/vnd.oma.xcap-directory+xml":                                  false,
+	"application/vnd.omads-email+xml":                                         false,
+	"application/vnd.omads-file+xml":                                          false,
+	"application/vnd.omads-folder+xml":                                        false,
+	"application/vnd.omaloc-supl-init":                                        false,
+	"application/vnd.onepager":                                                false,
+	"application/vnd.onepagertamp":                                            false,
+	"application/vnd.onepagertamx":                                            false,
+	"application/vnd.onepagertat":                                             false,
+	"application/vnd.onepagertatp":                                            false,
+	"application/vnd.onepagertatx":                                            false,
+	"application/vnd.openblox.game+xml":                                       false,
+	"application/vnd.openblox.game-binary":                                    false,
+	"application/vnd.openeye.oeb":                                             false,
+	"application/vnd.openstreetmap.data+xml":                                  false,
+	"application/vnd.openxmlformats-officedocument.custom-properties+xml":     false,
+	"application/vnd.openxmlformats-officedocument.customxmlproperties+xml":   false,
+	"application/vnd.openxmlformats-officedocument.drawing+xml":               false,
+	"application/vnd.openxmlformats-officedocument.drawingml.chart+xml":       false,
+	"application/vnd.openxmlformats-officedocument.drawingml.chartshapes+xml": false,
+	"application/vnd.openxmlformats-officedocument.drawingml.diagramcolors+xml":            false,
+	"application/vnd.openxmlformats-officedocument.drawingml.diagramdata+xml":              false,
+	"application/vnd.openxmlformats-officedocument.drawingml.diagramlayout+xml":            false,
+	"application/vnd.openxmlformats-officedocument.drawingml.diagramstyle+xml":             false,
+	"application/vnd.openxmlformats-officedocument.extended-properties+xml":                false,
+	"application/vnd.openxmlformats-officedocument.presentationml.commentauthors+xml":      false,
+	"application/vnd.openxmlformats-officedocument.presentationml.comments+xml":            false,
+	"application/vnd.openxmlformats-officedocument.presentationml.handoutmaster+xml":       false,
+	"application/vnd.openxmlformats-officedocument.presentationml.notesmaster+xml":         false,
+	"application/vnd.openxmlformats-officedocument.presentationml.notesslide+xml":          false,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation":            false,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation.main+xml":   false,
+	"application/vnd.openxmlformats-officedocument.presentationml.presprops+xml":           false,
+	"application/vnd.openxmlformats-officedocument.presentationml.slide":                   false,
+	"application/vnd.openxmlformats-officedocument.presentationml.slide+xml":               false,
+	"application/vnd.openxmlformats-officedocument.presentationml.slidelayout+xml":         false,
+	"application/vnd.openxmlformats-officedocument.presentationml.slidemaster+xml":         false,
+	"application/vnd.openxmlformats-officedocument.presentationml.slideshow":               false,
+	"application/vnd.openxmlformats-officedocument.presentationml.slideshow.main+xml":      false,
+	"application/vnd.openxmlformats-officedocument.presentationml.slideupdateinfo+xml":     false,
+	"application/vnd.openxmlformats-officedocument.presentationml.tablestyles+xml":         false,
+	"application/vnd.openxmlformats-officedocument.presentationml.tags+xml":                false,
+	"application/vnd.openxmlformats-officedocument.presentationml.template":                false,
+	"application/vnd.openxmlformats-officedocument.presentationml.template.main+xml":       false,
+	"application/vnd.openxmlformats-officedocument.presentationml.viewprops+xml":           false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.calcchain+xml":            false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.chartsheet+xml":           false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.comments+xml":             false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.connections+xml":          false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.dialogsheet+xml":          false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.externallink+xml":         false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.pivotcachedefinition+xml": false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.pivotcacherecords+xml":    false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.pivottable+xml":           false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.querytable+xml":           false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.revisionheaders+xml":      false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.revisionlog+xml":          false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sharedstrings+xml":        false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":                    false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml":           false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheetmetadata+xml":        false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml":               false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.table+xml":                false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.tablesinglecells+xml":     false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.template":                 false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.template.main+xml":        false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.usernames+xml":            false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.volatiledependencies+xml": false,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml":            false,
+	"application/vnd.openxmlformats-officedocument.theme+xml":                              false,
+	"application/vnd.openxmlformats-officedocument.themeoverride+xml":                      false,
+	"application/vnd.openxmlformats-officedocument.vmldrawing":                             false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.comments+xml":          false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":              false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document.glossary+xml": false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml":     false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.endnotes+xml":          false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.fonttable+xml":         false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.footer+xml":            false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.footnotes+xml":         false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml":         false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.settings+xml":          false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml":            false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.template":              false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.template.main+xml":     false,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.websettings+xml":       false,
+	"application/vnd.openxmlformats-package.core-properties+xml":                           false,
+	"application/vnd.openxmlformats-package.digital-signature-xmlsignature+xml":            false,
+	"application/vnd.openxmlformats-package.relationships+xml":                             false,
+	"application/vnd.oracle.resource+json":                                                 false,
+	"application/vnd.orange.indata":                                                        false,
+	"application/vnd.osa.netdeploy":                                                        false,
+	"application/vnd.osgeo.mapguide.package":                                               false,
+	"application/vnd.osgi.bundle":                                                          false,
+	"application/vnd.osgi.dp":                                                              false,
+	"application/vnd.osgi.subsystem":                                                       false,
+	"application/vnd.otps.ct-kip+xml":                                                      false,
+	"application/vnd.oxli.countgraph":                                                      false,
+	"application/vnd.pagerduty+json":                                                       false,
+	"application/vnd.palm":                                                                 false,
+	"application/vnd.panoply":                                                              false,
+	"application/vnd.paos+xml":                                                             false,
+	"application/vnd.patentdive":                                                           false,
+	"application/vnd.pawaafile":                                                            false,
+	"application/vnd.pcos":                                                                 false,
+	"application/vnd.pg.format":                                                            false,
+	"application/vnd.pg.osasli":                                                            false,
+	"application/vnd.piaccess.application-licence":                                         false,
+	"application/vnd.picsel":                               false,
+	"application/vnd.pmi.widget":                           false,
+	"application/vnd.poc.group-advertisement+xml":          false,
+	"application/vnd.pocketlearn":                          false,
+	"application/vnd.powerbuilder6":                        false,
+	"application/vnd.powerbuilder6-s":                      false,
+	"application/vnd.powerbuilder7":                        false,
+	"application/vnd.powerbuilder7-s":                      false,
+	"application/vnd.powerbuilder75":                       false,
+	"application/vnd.powerbuilder75-s":                     false,
+	"application/vnd.preminet":                             false,
+	"application/vnd.previewsystems.box":                   false,
+	"application/vnd.proteus.magazine":                     false,
+	"application/vnd.psfs":                                 false,
+	"application/vnd.publishare-delta-tree":                false,
+	"application/vnd.pvi.ptid1":                            false,
+	"application/vnd.pwg-multiplexed":                      false,
+	"application/vnd.pwg-xhtml-print+xml":                  false,
+	"application/vnd.qualcomm.brew-app-res":                false,
+	"application/vnd.quarantainenet":                       false,
+	"application/vnd.quark.quarkxpress":                    false,
+	"application/vnd.quobject-quoxdocument":                false,
+	"application/vnd.radisys.moml+xml":                     false,
+	"application/vnd.radisys.msml+xml":                     false,
+	"application/vnd.radisys.msml-audit+xml":               false,
+	"application/vnd.radisys.msml-audit-conf+xml":          false,
+	"application/vnd.radisys.msml-audit-conn+xml":          false,
+	"application/vnd.radisys.msml-audit-dialog+xml":        false,
+	"application/vnd.radisys.msml-audit-stream+xml":        false,
+	"application/vnd.radisys.msml-conf+xml":                false,
+	"application/vnd.radisys.msml-dialog+xml":              false,
+	"application/vnd.radisys.msml-dialog-base+xml":         false,
+	"application/vnd.radisys.msml-dialog-fax-detect+xml":   false,
+	"application/vnd.radisys.msml-dialog-fax-sendrecv+xml": false,
+	"application/vnd.radisys.msml-dialog-group+xml":        false,
+	"application/vnd.radisys.msml-dialog-speech+xml":       false,
+	"application/vnd.radisys.msml-dialog-transform+xml":    false,
+	"application/vnd.rainstor.data":                        false,
+	"application/vnd.rapid":                                false,
+	"application/vnd.rar":                                  false,
+	"application/vnd.realvnc.bed":                          false,
+	"application/vnd.recordare.musicxml":                   false,
+	"application/vnd.recordare.musicxml+xml":               false,
+	"application/vnd.renlearn.rlprint":                     false,
+	"application/vnd.restful+json":                         false,
+	"application/vnd.rig.cryptonote":                       false,
+	"application/vnd.route66.link66+xml":                   false,
+	"application/vnd.rs-274x":                              false,
+	"application/vnd.ruckus.download":                      false,
+	"application/vnd.s3sms":                                false,
+	"application/vnd.sailingtracker.track":                 false,
+	"application/vnd.sbm.cid":                              false,
+	"application/vnd.sbm.mid2":                             false,
+	"application/vnd.scribus":                              false,
+	"application/vnd.sealed.3df":                           false,
+	"application/vnd.sealed.csf":                           false,
+	"application/vnd.sealed.doc":                           false,
+	"application/vnd.sealed.eml":                           false,
+	"application/vnd.sealed.mht":                           false,
+	"application/vnd.sealed.net":                           false,
+	"application/vnd.sealed.ppt":                           false,
+	"application/vnd.sealed.tiff":                          false,
+	"application/vnd.sealed.xls":                           false,
+	"application/vnd.sealedmedia.softseal.html":            false,
+	"application/vnd.sealedmedia.softseal.pdf":             false,
+	"application/vnd.seemail":                              false,
+	"application/vnd.sema":                                 false,
+	"application/vnd.semd":                                 false,
+	"application/vnd.semf":                                 false,
+	"application/vnd.shana.informed.formdata":              false,
+	"application/vnd.shana.informed.formtemplate":          false,
+	"application/vnd.shana.informed.interchange":           false,
+	"application/vnd.shana.informed.package":               false,
+	"application/vnd.shootproof+json":                      false,
+	"application/vnd.sigrok.session":                       false,
+	"application/vnd.simtech-mindmapper":                   false,
+	"application/vnd.siren+json":                           false,
+	"application/vnd.smaf":                                 false,
+	"application/vnd.smart.notebook":                       false,
+	"application/vnd.smart.teacher":                        false,
+	"application/vnd.software602.filler.form+xml":          false,
+	"application/vnd.software602.filler.form-xml-zip":      false,
+	"application/vnd.solent.sdkm+xml":                      false,
+	"application/vnd.spotfire.dxp":                         false,
+	"application/vnd.spotfire.sfs":                         false,
+	"application/vnd.sqlite3":                              false,
+	"application/vnd.sss-cod":                              false,
+	"application/vnd.sss-dtf":                              false,
+	"application/vnd.sss-ntf":                              false,
+	"application/vnd.stepmania.package":                    false,
+	"application/vnd.stepmania.stepchart":                  false,
+	"application/vnd.street-stream":                        false,
+	"application/vnd.sun.wadl+xml":                         false,
+	"application/vnd.sus-calendar":                         false,
+	"application/vnd.svd":                                  false,
+	"application/vnd.swiftview-ics":                        false,
+	"application/vnd.syncml+xml":                           false,
+	"application/vnd.syncml.dm+wbxml":                      false,
+	"application/vnd.syncml.dm+xml":                        false,
+	"application/vnd.syncml.dm.notification":               false,
+	"application/vnd.syncml.dmddf+wbxml":                   false,
+	"application/vnd.syncml.dmddf+xml":                     false,
+	"application/vnd.syncml.dmtnds+wbxml":                  false,
+	"application/vnd.syncml.dmtnds+xml":                    false,
+	"application/vnd.syncml.ds.notification":               false,
+	"application/vnd.tableschema+json":                     false,
+	"application/vnd.tao.intent-module-archive":            false,
+	"application/vnd.tcpdump.pcap":                         false,
+	"application/vnd.think-cell.ppttc+json":                false,
+	"application/vnd.tmd.mediaflex.api+xml":                false,
+	"application/vnd.tml":                                  false,
+	"application/vnd.tmobile-livetv":                       false,
+	"application/vnd.tri.onesource":                        false,
+	"application/vnd.trid.tpt":                             false,
+	"application/vnd.triscape.mxs":                         false,
+	"application/vnd.trueapp":                              false,
+	"application/vnd.truedoc":                              false,
+	"application/vnd.ubisoft.webplayer":                    false,
+	"application/vnd.ufdl":                                 false,
+	"application/vnd.uiq.theme":                            false,
+	"application/vnd.umajin":                               false,
+	"application/vnd.unity":                                false,
+	"application/vnd.uoml+xml":                             false,
+	"application/vnd.uplanet.alert":                        false,
+	"application/vnd.uplanet.alert-wbxml":                  false,
+	"application/vnd.uplanet.bearer-choice":                false,
+	"application/vnd.uplanet.bearer-choice-wbxml":          false,
+	"application/vnd.uplanet.cacheop":                      false,
+	"application/vnd.uplanet.cacheop-wbxml":                false,
+	"application/vnd.uplanet.channel":                      false,
+	"application/vnd.uplanet.channel-wbxml":                false,
+	"application/vnd.uplanet.list":                         false,
+	"application/vnd.uplanet.list-wbxml":                   false,
+	"application/vnd.uplanet.listcmd":                      false,
+	"application/vnd.uplanet.listcmd-wbxml":                false,
+	"application/vnd.uplanet.signal":                       false,
+	"application/vnd.uri-map":                              false,
+	"application/vnd.valve.source.material":                false,
+	"application/vnd.vcx":                                  false,
+	"application/vnd.vd-study":                             false,
+	"application/vnd.vectorworks":                          false,
+	"application/vnd.vel+json":                             false,
+	"application/vnd.verimatrix.vcas":                      false,
+	"application/vnd.vidsoft.vidconference":                false,
+	"application/vnd.visio":                                false,
+	"application/vnd.visionary":                            false,
+	"application/vnd.vividence.scriptfile":                 false,
+	"application/vnd.vsf":                                  false,
+	"application/vnd.wap.sic":                              false,
+	"application/vnd.wap.slc":                              false,
+	"application/vnd.wap.wbxml":                            false,
+	"application/vnd.wap.wmlc":                             false,
+	"application/vnd.wap.wmlscriptc":                       false,
+	"application/vnd.webturbo":                             false,
+	"application/vnd.wfa.p2p":                              false,
+	"application/vnd.wfa.wsc":                              false,
+	"application/vnd.windows.devicepairing":                false,
+	"application/vnd.wmc":                                  false,
+	"application/vnd.wmf.bootstrap":                        false,
+	"application/vnd.wolfram.mathematica":                  false,
+	"application/vnd.wolfram.mathematica.package":          false,
+	"application/vnd.wolfram.player":                       false,
+	"application/vnd.wordperfect":                          false,
+	"application/vnd.wqd":                                  false,
+	"application/vnd.wrq-hp3000-labelled":                  false,
+	"application/vnd.wt.stf":                               false,
+	"application/vnd.wv.csp+wbxml":                         false,
+	"application/vnd.wv.csp+xml":                           false,
+	"application/vnd.wv.ssp+xml":                           false,
+	"application/vnd.xacml+json":                           false,
+	"application/vnd.xara":                                 false,
+	"application/vnd.xfdl":                                 false,
+	"application/vnd.xfdl.webform":                         false,
+	"application/vnd.xmi+xml":                              false,
+	"application/vnd.xmpie.cpkg":                           false,
+	"application/vnd.xmpie.dpkg":                           false,
+	"application/vnd.xmpie.plan":                           false,
+	"application/vnd.xmpie.ppkg":                           false,
+	"application/vnd.xmpie.xlim":                           false,
+	"application/vnd.yamaha.hv-dic":                        false,
+	"application/vnd.yamaha.hv-script":                     false,
+	"application/vnd.yamaha.hv-voice":                      false,
+	"application/vnd.yamaha.openscoreformat":               false,
+	"application/vnd.yamaha.openscoreformat.osfpvg+xml":    false,
+	"application/vnd.yamaha.remote-setup":                  false,
+	"application/vnd.yamaha.smaf-audio":                    false,
+	"application/vnd.yamaha.smaf-phrase":                   false,
+	"application/vnd.yamaha.through-ngn":                   false,
+	"application/vnd.yamaha.tunnel-udpencap":               false,
+	"application/vnd.yaoweme":                              false,
+	"application/vnd.yellowriver-custom-menu":              false,
+	"application/vnd.youtube.yt":                           false,
+	"application/vnd.zul":                                  false,
+	"application/vnd.zzazz.deck+xml":                       false,
+	"application/voicexml+xml":                             false,
+	"application/voucher-cms+json":                         false,
+	"application/vq-rtcpxr":                                false,
+	"application/watcherinfo+xml":                          false,
+	"application/webpush-options+json":                     false,
+	"application/whoispp-query":                            false,
+	"application/whoispp-response":                         false,
+	"application/widget":                                   false,
+	"application/wita":                                     false,
+	"application/wordperfect5.1":                           false,
+	"application/wsdl+xml":                                 false,
+	"application/wspolicy+xml":                             false,
+	"application/x-www-form-urlencoded":                    false,
+	"application/x400-bp":                                  false,
+	"application/xacml+xml":                                false,
+	"application/xcap-att+xml":                             false,
+	"application/xcap-caps+xml":                            false,
+	"application/xcap-diff+xml":                            false,
+	"application/xcap-el+xml":                              false,
+	"application/xcap-error+xml":                           false,
+	"application/xcap-ns+xml":                              false,
+	"application/xcon-conference-info+xml":                 false,
+	"application/xcon-conference-info-diff+xml":            false,
+	"application/xenc+xml":                                 false,
+	"application/xhtml+xml":                                false,
+	"application/xliff+xml":                                false,
+	"application/xml":                                      false,
+	"application/xml-dtd":                                  false,
+	"application/xml-external-parsed-entity":               false,
+	"application/xml-patch+xml":                            false,
+	"application/xmpp+xml":                                 false,
+	"application/xop+xml":                                  false,
+	"application/xslt+xml":                                 false,
+	"application/xv+xml":                                   false,
+	"application/yang":                                     false,
+	"application/yang-data+json":                           false,
+	"application/yang-data+xml":                            false,
+	"application/yang-patch+json":                          false,
+	"application/yang-patch+xml":                           false,
+	"application/yin+xml":                                  false,
+	"application/zip":                                      false,
+	"application/zlib":                                     false,
+	"audio/1d-interleaved-parityfec":                       false,
+	"audio/32kadpcm":                                       false,
+	"audio/3gpp":                                           false,
+	"audio/3gpp2":                                          false,
+	"audio/aac":                                            false,
+	"audio/ac3":                                            false,
+	"audio/amr":                                            false,
+	"audio/amr-wb":                                         false,
+	"audio/amr-wb+":                                        false,
+	"audio/aptx":                                           false,
+	"audio/asc":                                            false,
+	"audio/atrac-advanced-lossless":                        false,
+	"audio/atrac-x":                                        false,
+	"audio/atrac3":                                         false,
+	"audio/basic":                                          false,
+	"audio/bv16":                                           false,
+	"audio/bv32":                                           false,
+	"audio/clearmode":                                      false,
+	"audio/cn":                                             false,
+	"audio/dat12":                                          false,
+	"audio/dls":                                            false,
+	"audio/dsr-es201108":                                   false,
+	"audio/dsr-es202050":                                   false,
+	"audio/dsr-es202211":                                   false,
+	"audio/dsr-es202212":                                   false,
+	"audio/dv":                                             false,
+	"audio/dvi4":                                           false,
+	"audio/eac3":                                           false,
+	"audio/encaprtp":                                       false,
+	"audio/evrc":                                           false,
+	"audio/evrc-qcp":                                       false,
+	"audio/evrc0":                                          false,
+	"audio/evrc1":                                          false,
+	"audio/evrcb":                                          false,
+	"audio/evrcb0":                                         false,
+	"audio/evrcb1":                                         false,
+	"audio/evrcnw":                                         false,
+	"audio/evrcnw0":                                        false,
+	"audio/evrcnw1":                                        false,
+	"audio/evrcwb":                                         false,
+	"audio/evrcwb0":                                        false,
+	"audio/evrcwb1":                                        false,
+	"audio/evs":                                            false,
+	"audio/fwdred":                                         false,
+	"audio/g711-0":                                         false,
+	"audio/g719":                                           false,
+	"audio/g722":                                           false,
+	"audio/g7221":                                          false,
+	"audio/g723":                                           false,
+	"audio/g726-16":                                        false,
+	"audio/g726-24":                                        false,
+	"audio/g726-32":                                        false,
+	"audio/g726-40":                                        false,
+	"audio/g728":                                           false,
+	"audio/g729":                                           false,
+	"audio/g7291":                                          false,
+	"audio/g729d":                                          false,
+	"audio/g729e":                                          false,
+	"audio/gsm":                                            false,
+	"audio/gsm-efr":                                        false,
+	"audio/gsm-hr-08":                                      false,
+	"audio/ilbc":                                           false,
+	"audio/ip-mr_v2.5":                                     false,
+	"audio/l16":                                            false,
+	"audio/l20":                                            false,
+	"audio/l24":                                            false,
+	"audio/l8":                                             false,
+	"audio/lpc":                                            false,
+	"audio/melp":                                           false,
+	"audio/melp1200":                                       false,
+	"audio/melp2400":                                       false,
+	"audio/melp600":                                        false,
+	"audio/mobile-xmf":                                     false,
+	"audio/mp4":                                            false,
+	"audio/mp4a-latm":                                      false,
+	"audio/mpa":                                            false,
+	"audio/mpa-robust":                                     false,
+	"audio/mpeg":                                           false,
+	"audio/mpeg4-generic":                                  false,
+	"audio/ogg":                                            false,
+	"audio/opus":                                           false,
+	"audio/parityfec":                                      false,
+	"audio/pcma":                                           false,
+	"audio/pcma-wb":                                        false,
+	"audio/pcmu":                                           false,
+	"audio/pcmu-wb":                                        false,
+	"audio/prs.sid":                                        false,
+	"audio/qcelp":                                          false,
+	"audio/raptorfec":                                      false,
+	"audio/red":                                            false,
+	"audio/rtp-enc-aescm128":                               false,
+	"audio/rtp-midi":                                       false,
+	"audio/rtploopback":                                    false,
+	"audio/rtx":                                            false,
+	"audio/smv":                                            false,
+	"audio/smv-qcp":                                        false,
+	"audio/smv0":                                           false,
+	"audio/sp-midi":                                        false,
+	"audio/speex":                                          false,
+	"audio/t140c":                                          false,
+	"audio/t38":                                            false,
+	"audio/telephone-event":                                false,
+	"audio/tone":                                           false,
+	"audio/uemclip":                                        false,
+	"audio/ulpfec":                                         false,
+	"audio/usac":                                           false,
+	"audio/vdvi":                                           false,
+	"audio/vmr-wb":                                         false,
+	"audio/vnd.3gpp.iufp":                                  false,
+	"audio/vnd.4sb":                                        false,
+	"audio/vnd.audiokoz":                                   false,
+	"audio/vnd.celp":                                       false,
+	"audio/vnd.cisco.nse":                                  false,
+	"audio/vnd.cmles.radio-events":                         false,
+	"audio/vnd.cns.anp1":                                   false,
+	"audio/vnd.cns.inf1":                                   false,
+	"audio/vnd.dece.audio":                                 false,
+	"audio/vnd.digital-winds":                              false,
+	"audio/vnd.dlna.adts":                                  false,
+	"audio/vnd.dolby.heaac.1":                              false,
+	"audio/vnd.dolby.heaac.2":                              false,
+	"audio/vnd.dolby.mlp":                                  false,
+	"audio/vnd.dolby.mps":                                  false,
+	"audio/vnd.dolby.pl2":                                  false,
+	"audio/vnd.dolby.pl2x":                                 false,
+	"audio/vnd.dolby.pl2z":                                 false,
+	"audio/vnd.dolby.pulse.1":                              false,
+	"audio/vnd.dra":                                        false,
+	"audio/vnd.dts":                                        false,
+	"audio/vnd.dts.hd":                                     false,
+	"audio/vnd.dvb.file":                                   false,
+	"audio/vnd.everad.plj":                                 false,
+	"audio/vnd.hns.audio":                                  false,
+	"audio/vnd.lucent.voice":                               false,
+	"audio/vnd.ms-playready.media.pya":                     false,
+	"audio/vnd.nokia.mobile-xmf":                           false,
+	"audio/vnd.nortel.vbk":                                 false,
+	"audio/vnd.nuera.ecelp4800":                            false,
+	"audio/vnd.nuera.ecelp7470":                            false,
+	"audio/vnd.nuera.ecelp9600":                            false,
+	"audio/vnd.octel.sbc":                                  false,
+	"audio/vnd.presonus.multitrack":                        false,
+	"audio/vnd.qcelp":                                      false,
+	"audio/vnd.rhetorex.32kadpcm":                          false,
+	"audio/vnd.rip":                                        false,
+	"audio/vnd.sealedmedia.softseal.mpeg":                  false,
+	"audio/vnd.vmx.cvsd":                                   false,
+	"audio/vorbis":                                         false,
+	"audio/vorbis-config":                                  false,
+	"font/collection":                                      false,
+	"font/otf":                                             false,
+	"font/sfnt":                                            false,
+	"font/ttf":                                             false,
+	"font/woff":                                            false,
+	"font/woff2":                                           false,
+	"image/aces":                                           false,
+	"image/bmp":                                            false,
+	"image/cgm":                                            false,
+	"image/dicom-rle":                                      false,
+	"image/emf":                                            false,
+	"image/fits":                                           false,
+	"image/g3fax":                                          false,
+	"image/gif":                                            false,
+	"image/ief":                                            false,
+	"image/jls":                                            false,
+	"image/jp2":                                            false,
+	"image/jpeg":                                           false,
+	"image/jpm":                                            false,
+	"image/jpx":                                            false,
+	"image/ktx":                                            false,
+	"image/naplps":                                         false,
+	"image/png":                                            false,
+	"image/prs.btif":                                       false,
+	"image/prs.pti":                                        false,
+	"image/pwg-raster":                                     false,
+	"image/svg+xml":                                        false,
+	"image/t38":                                            false,
+	"image/tiff":                                           false,
+	"image/tiff-fx":                                        false,
+	"image/vnd.adobe.photoshop":                            false,
+	"image/vnd.airzip.accelerator.azv":                     false,
+	"image/vnd.cns.inf2":                                   false,
+	"image/vnd.dece.graphic":                               false,
+	"image/vnd.djvu":                                       false,
+	"image/vnd.dvb.subtitle":                               false,
+	"image/vnd.dwg":                                        false,
+	"image/vnd.dxf":                                        false,
+	"image/vnd.fastbidsheet":                               false,
+	"image/vnd.fpx":                                        false,
+	"image/vnd.fst":                                        false,
+	"image/vnd.fujixerox.edmics-mmr":                       false,
+	"image/vnd.fujixerox.edmics-rlc":                       false,
+	"image/vnd.globalgraphics.pgb":                         false,
+	"image/vnd.microsoft.icon":                             false,
+	"image/vnd.mix":                                        false,
+	"image/vnd.mozilla.apng":                               false,
+	"image/vnd.ms-modi":                                    false,
+	"image/vnd.net-fpx":                                    false,
+	"image/vnd.radiance":                                   false,
+	"image/vnd.sealed.png":                                 false,
+	"image/vnd.sealedmedia.softseal.gif":                   false,
+	"image/vnd.sealedmedia.softseal.jpg":                   false,
+	"image/vnd.svf":                                        false,
+	"image/vnd.tencent.tap":                                false,
+	"image/vnd.valve.source.texture":                       false,
+	"image/vnd.wap.wbmp":                                   false,
+	"image/vnd.xiff":                                       false,
+	"image/vnd.zbrush.pcx":                                 false,
+	"image/wmf":                                            false,
+	"message/cpim":                                         false,
+	"message/delivery-status":                              false,
+	"message/disposition-notification":                     false,
+	"message/external-body":                                false,
+	"message/feedback-report":                              false,
+	"message/global":                                       false,
+	"message/global-delivery-status":                       false,
+	"message/global-disposition-notification":              false,
+	"message/global-headers":                               false,
+	"message/http":                                         false,
+	"message/imdn+xml":                                     false,
+	"message/news":                                         false,
+	"message/partial":                                      false,
+	"message/rfc822":                                       false,
+	"message/s-http":                                       false,
+	"message/sip":                                          false,
+	"message/sipfrag":                                      false,
+	"message/tracking-status":                              false,
+	"message/vnd.si.simp":                                  false,
+	"message/vnd.wfa.wsc":                                  false,
+	"model/3mf":                                            false,
+	"model/gltf+json":                                      false,
+	"model/gltf-binary":                                    false,
+	"model/iges":                                           false,
+	"model/mesh":                                           false,
+	"model/stl":                                            false,
+	"model/vnd.collada+xml":                                false,
+	"model/vnd.dwf":                                        false,
+	"model/vnd.flatland.3dml":                              false,
+	"model/vnd.gdl":                                        false,
+	"model/vnd.gs.gdl":                                     false,
+	"model/vnd.gtw":                                        false,
+	"model/vnd.moml+xml":                                   false,
+	"model/vnd.mts":                                        false,
+	"model/vnd.opengex":                                    false,
+	"model/vnd.parasolid.transmit.binary":                  false,
+	"model/vnd.parasolid.transmit.text":                    false,
+	"model/vnd.rosette.annotated-data-model":               false,
+	"model/vnd.usdz+zip":                                   false,
+	"model/vnd.valve.source.compiled-map":                  false,
+	"model/vnd.vtu":                                        false,
+	"model/vrml":                                           false,
+	"model/x3d+fastinfoset":                                false,
+	"model/x3d+xml":                                        false,
+	"model/x3d-vrml":                                       false,
+	"multipart/alternative":                                false,
+	"multipart/appledouble":                                false,
+	"multipart/byteranges":                                 false,
+	"multipart/digest":                                     false,
+	"multipart/encrypted":                                  false,
+	"multipart/form-data":                                  false,
+	"multipart/header-set":                                 false,
+	"multipart/mixed":                                      false,
+	"multipart/multilingual":                               false,
+	"multipart/parallel":                                   false,
+	"multipart/related":                                    false,
+	"multipart/report":                                     false,
+	"multipart/signed":                                     false,
+	"multipart/vnd.bint.med-plus":                          false,
+	"multipart/voice-message":                              false,
+	"multipart/x-mixed-replace":                            false,
+	"text/1d-interleaved-parityfec":                        false,
+	"text/cache-manifest":                                  false,
+	"text/calendar":                                        false,
+	"text/css":                                             false,
+	"text/csv":                                             false,
+	"text/csv-schema":                                      false,
+	"text/directory":                                       false,
+	"text/dns":                                             false,
+	"text/ecmascript":                                      true,
+	"text/encaprtp":                                        false,
+	"text/enriched":                                        false,
+	"text/fwdred":                                          false,
+	"text/grammar-ref-list":                                false,
+	"text/html":                                            false,
+	"text/javascript":                                      false,
+	"text/jcr-cnd":                                         false,
+	"text/markdown":                                        false,
+	"text/mizar":                                           false,
+	"text/n3":                                              false,
+	"text/parameters":                                      false,
+	"text/parityfec":                                       false,
+	"text/plain":                                           false,
+	"text/provenance-notation":                             false,
+	"text/prs.fallenstein.rst":                             false,
+	"text/prs.lines.tag":                                   false,
+	"text/prs.prop.logic":                                  false,
+	"text/raptorfec":                                       false,
+	"text/red":                                             false,
+	"text/rfc822-headers":                                  false,
+	"text/richtext":                                        false,
+	"text/rtf":                                             false,
+	"text/rtp-enc-aescm128":                                false,
+	"text/rtploopback":                                     false,
+	"text/rtx":                                             false,
+	"text/sgml":                                            false,
+	"text/strings":                                         false,
+	"text/t140":                                            false,
+	"text/tab-separated-values":                            false,
+	"text/troff":                                           false,
+	"text/turtle":                                          false,
+	"text/ulpfec":                                          false,
+	"text/uri-list":                                        false,
+	"text/vcard":                                           false,
+	"text/vnd.a":                                           false,
+	"text/vnd.abc":                                         false,
+	"text/vnd.ascii-art":                                   false,
+	"text/vnd.curl":                                        false,
+	"text/vnd.debian.copyright":                            false,
+	"text/vnd.dmclientscript":                              false,
+	"text/vnd.dvb.subtitle":                                false,
+	"text/vnd.esmertec.theme-descriptor":                   false,
+	"text/vnd.fly":                                         false,
+	"text/vnd.fmi.flexstor":                                false,
+	"text/vnd.gml":                                         false,
+	"text/vnd.graphviz":                                    false,
+	"text/vnd.hgl":                                         false,
+	"text/vnd.in3d.3dml":                                   false,
+	"text/vnd.in3d.spot":                                   false,
+	"text/vnd.iptc.newsml":                                 false,
+	"text/vnd.iptc.nitf":                                   false,
+	"text/vnd.latex-z":                                     false,
+	"text/vnd.motorola.reflex":                             false,
+	"text/vnd.ms-mediapackage":                             false,
+	"text/vnd.net2phone.commcenter.command":                false,
+	"text/vnd.radisys.msml-basic-layout":                   false,
+	"text/vnd.si.uricatalogue":                             false,
+	"text/vnd.sun.j2me.app-descriptor":                     false,
+	"text/vnd.trolltech.linguist":                          false,
+	"text/vnd.wap.si":                                      false,
+	"text/vnd.wap.sl":                                      false,
+	"text/vnd.wap.wml":                                     false,
+	"text/vnd.wap.wmlscript":                               false,
+	"text/xml":                                             false,
+	"text/xml-external-parsed-entity":                      false,
+	"video/1d-interleaved-parityfec":                       false,
+	"video/3gpp":                                           false,
+	"video/3gpp-tt":                                        false,
+	"video/3gpp2":                                          false,
+	"video/bmpeg":                                          false,
+	"video/bt656":                                          false,
+	"video/celb":                                           false,
+	"video/dv":                                             false,
+	"video/encaprtp":                                       false,
+	"video/h261":                                           false,
+	"video/h263":                                           false,
+	"video/h263-1998":                                      false,
+	"video/h263-2000":                                      false,
+	"video/h264":                                           false,
+	"video/h264-rcdo":                                      false,
+	"video/h264-svc":                                       false,
+	"video/h265":                                           false,
+	"video/iso.segment":                                    false,
+	"video/jpeg":                                           false,
+	"video/jpeg2000":                                       false,
+	"video/mj2":                                            false,
+	"video/mp1s":                                           false,
+	"video/mp2p":                                           false,
+	"video/mp2t":                                           false,
+	"video/mp4":                                            false,
+	"video/mp4v-es":                                        false,
+	"video/mpeg":                                           false,
+	"video/mpeg4-generic":                                  false,
+	"video/mpv":                                            false,
+	"video/nv":                                             false,
+	"video/ogg":                                            false,
+	"video/parityfec":                                      false,
+	"video/pointer":                                        false,
+	"video/quicktime":                                      false,
+	"video/raptorfec":                                      false,
+	"video/raw":                                            false,
+	"video/rtp-enc-aescm128":                               false,
+	"video/rtploopback":                                    false,
+	"video/rtx":                                            false,
+	"video/smpte291":                                       false,
+	"video/smpte292m":                                      false,
+	"video/ulpfec":                                         false,
+	"video/vc1":                                            false,
+	"video/vnd.cctv":                                       false,
+	"video/vnd.dece.hd":                                    false,
+	"video/vnd.dece.mobile":                                false,
+	"video/vnd.dece.mp4":                                   false,
+	"video/vnd.dece.pd":                                    false,
+	"video/vnd.dece.sd":                                    false,
+	"video/vnd.dece.video":                                 false,
+	"video/vnd.directv.mpeg":                               false,
+	"video/vnd.directv.mpeg-tts":                           false,
+	"video/vnd.dlna.mpeg-tts":                              false,
+	"video/vnd.dvb.file":                                   false,
+	"video/vnd.fvt":                                        false,
+	"video/vnd.hns.video":                                  false,
+	"video/vnd.iptvforum.1dparityfec-1010":                 false,
+	"video/vnd.iptvforum.1dparityfec-2005":                 false,
+	"video/vnd.iptvforum.2dparityfec-1010":                 false,
+	"video/vnd.iptvforum.2dparityfec-2005":                 false,
+	"video/vnd.iptvforum.ttsavc":                           false,
+	"video/vnd.iptvforum.ttsmpeg2":                         false,
+	"video/vnd.motorola.video":                             false,
+	"video/vnd.motorola.videop":                            false,
+	"video/vnd.mpegurl":                                    false,
+	"video/vnd.ms-playready.media.pyv":                     false,
+	"video/vnd.nokia.interleaved-multimedia":               false,
+	"video/vnd.nokia.mp4vr":                                false,
+	"video/vnd.nokia.videovoip":                            false,
+	"video/vnd.objectvideo":                                false,
+	"video/vnd.radgamettools.bink":                         false,
+	"video/vnd.radgamettools.smacker":                      false,
+	"video/vnd.sealed.mpeg1":                               false,
+	"video/vnd.sealed.mpeg4":                               false,
+	"video/vnd.sealed.swf":                                 false,
+	"video/vnd.sealedmedia.softseal.mov":                   false,
+	"video/vnd.uvvu.mp4":                                   false,
+	"video/vnd.vivo":                                       false,
+	"video/vp8":                                            false,
+}
@@ -0,0 +1,132 @@
+package mime
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode/utf8"
+)
+
+// Result is the outcome of content sniffing. Confidence is between 0 and 1;
+// it is derived from the priority of the matching signature, and is low for
+// the text/plain and application/octet-stream fallbacks.
+type Result struct {
+	Type       string
+	Confidence float64
+}
+
+const (
+	textConfidence    = 0.1
+	binaryConfidence  = 0
+	archiveConfidence = 0.9
+)
+
+// DetectBytes returns the MIME type of data as determined by the magic-number
+// database. data should hold the beginning of the file; anything past the
+// longest signature is ignored.
+func DetectBytes(data []byte) Result {
+	if sig, ok := defaultMagic.detect(data); ok {
+		return Result{Type: sig.Type, Confidence: float64(sig.Priority) / 100}
+	}
+	if isText(data) {
+		return Result{Type: "text/plain", Confidence: textConfidence}
+	}
+	return Result{Type: "application/octet-stream", Confidence: binaryConfidence}
+}
+
+// DetectReader reads as many bytes from r as the signature database needs and
+// returns the detected MIME type. A short read is not an error. If r also
+// implements io.ReaderAt and io.Seeker, as *os.File does, ZIP-based formats
+// are told apart by DetectArchive, which reads the whole central directory.
+func DetectReader(r io.Reader) (Result, error) {
+	buf := make([]byte, defaultMagic.readLen())
+	n, err := io.ReadFull(r, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return Result{}, err
+	}
+	res := DetectBytes(buf[:n])
+	if res.Type == typeZip || IsSubtypeOf(res.Type, typeZip) {
+		if typ, err := detectArchiveAt(r); err == nil && typ != "" {
+			res = Result{Type: typ, Confidence: archiveConfidence}
+		}
+	}
+	return res, nil
+}
+
+// peeker is implemented by *bufio.Reader.
+type peeker interface {
+	Peek(n int) ([]byte, error)
+}
+
+// DetectStream determines the type of the content of r, including its
+// charset as DetectContentType does, without consuming it: it reads only as
+// many bytes as the signature database needs and returns a reader that
+// yields those bytes followed by the rest of r. If r is a *bufio.Reader or
+// another reader with a Peek method, the bytes are peeked and r itself is
+// returned; a buffer smaller than the database needs limits detection to
+// what it holds. A short read is not an error; on any other error the returned
+// reader still replays the bytes read so far.
+func (reg *Registry) DetectStream(r io.Reader) (MediaType, io.Reader, error) {
+	n := defaultMagic.readLen()
+	var head []byte
+	var err error
+	if p, ok := r.(peeker); ok {
+		if s, ok := r.(interface{ Size() int }); ok && s.Size() < n {
+			// Peeking past the buffer fails before reading anything.
+			n = s.Size()
+		}
+		head, err = p.Peek(n)
+		if err == io.EOF || err == bufio.ErrBufferFull {
+			// Short content, or a buffer smaller than n: use what is there.
+			err = nil
+		}
+	} else {
+		buf := make([]byte, n)
+		var m int
+		m, err = io.ReadFull(r, buf)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			err = nil
+		}
+		head = buf[:m]
+		r = io.MultiReader(bytes.NewReader(head), r)
+	}
+	if err != nil {
+		return MediaType{}, r, err
+	}
+	m, err := Parse(reg.DetectContentType(head))
+	if err != nil {
+		m, _ = Parse(typeOctetStream)
+	}
+	return m, r, nil
+}
+
+// DetectStream determines the type of the content of r using
+// DefaultRegistry's charset policy. See Registry.DetectStream.
+func DetectStream(r io.Reader) (MediaType, io.Reader, error) {
+	return DefaultRegistry.DetectStream(r)
+}
+
+// isText reports whether data looks like text: valid UTF-8 without control
+// characters other than common whitespace. A truncated trailing rune is
+// tolerated since data is usually a prefix of the file.
+func isText(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	for i := 0; i < len(data); {
+		c := data[i]
+		if c < utf8.RuneSelf {
+			if c < 0x20 && c != '\t' && c != '\n' && c != '\r' && c != '\f' || c == 0x7F {
+				return false
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size == 1 {
+			return len(data)-i < utf8.UTFMax && !utf8.FullRune(data[i:])
+		}
+		i += size
+	}
+	return true
+}
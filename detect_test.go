@@ -0,0 +1,272 @@
+package mime
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// odfFile returns an OpenDocument file of type typ, with its mimetype entry
+// stored first as the specification requires.
+func odfFile(t *testing.T, typ string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(w, typ)
+	w, err = zw.Create("content.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(w, "<office:document-content/>")
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// tarFile returns a tar header block in the ustar format.
+func tarFile() []byte {
+	b := make([]byte, 512)
+	copy(b, "a.txt")
+	copy(b[257:], "ustar\x0000")
+	return b
+}
+
+// ooxmlFile returns a ZIP archive holding a [Content_Types].xml entry
+// followed by the named entries.
+func ooxmlFile(t *testing.T, entries ...string) []byte {
+	t.Helper()
+	return zipFile(t, append([]string{"[Content_Types].xml", contentTypes()}, entries...)...)
+}
+
+func TestDetectBytes(t *testing.T) {
+	const (
+		docxType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+		xlsxType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		pptxType = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+	)
+	tests := []struct {
+		group, name string
+		data        []byte
+		want        string
+	}{
+		{"documents", "pdf", []byte("%PDF-1.7\n%\xE2\xE3\xCF\xD3\n"), "application/pdf"},
+		{"documents", "pdf after junk", append(make([]byte, 100), "%PDF-1.4"...), "application/pdf"},
+		{"documents", "postscript", []byte("%!PS-Adobe-3.0\n"), "application/postscript"},
+		{"documents", "rtf", []byte(`{\rtf1\ansi hello}`), "text/rtf"},
+		{"documents", "odt", odfFile(t, "application/vnd.oasis.opendocument.text"), "application/vnd.oasis.opendocument.text"},
+		{"documents", "ods", odfFile(t, "application/vnd.oasis.opendocument.spreadsheet"), "application/vnd.oasis.opendocument.spreadsheet"},
+		{"documents", "epub", odfFile(t, "application/epub+zip"), "application/epub+zip"},
+		{"documents", "docx", docx(t), docxType},
+		{"documents", "xlsx", ooxmlFile(t, "xl/workbook.xml", "<workbook/>"), xlsxType},
+		{"documents", "pptx", ooxmlFile(t, "ppt/presentation.xml", "<p:presentation/>"), pptxType},
+		{"documents", "docm", ooxmlFile(t, "word/document.xml", "<w:document/>", "word/vbaProject.bin", "\xD0\xCF\x11\xE0"), "application/vnd.ms-word.document.macroEnabled.12"},
+		{"documents", "xlsm", ooxmlFile(t, "xl/workbook.xml", "<workbook/>", "xl/vbaProject.bin", "\xD0\xCF\x11\xE0"), "application/vnd.ms-excel.sheet.macroEnabled.12"},
+		{"documents", "xlsb", ooxmlFile(t, "xl/workbook.bin", "\x83\x01\x00"), "application/vnd.ms-excel.sheet.binary.macroEnabled.12"},
+		{"documents", "pptm", ooxmlFile(t, "ppt/presentation.xml", "<p:presentation/>", "ppt/vbaProject.bin", "\xD0\xCF\x11\xE0"), "application/vnd.ms-powerpoint.presentation.macroEnabled.12"},
+		{"documents", "ole", []byte("\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1\x00\x00"), "application/x-ole-storage"},
+
+		{"images", "png", []byte("\x89PNG\r\n\x1A\n\x00\x00\x00\x0DIHDR"), "image/png"},
+		{"images", "gif", []byte("GIF89a\x01\x00\x01\x00"), "image/gif"},
+		{"images", "jpeg", []byte("\xFF\xD8\xFF\xE0\x00\x10JFIF"), "image/jpeg"},
+		{"images", "webp", []byte("RIFF\x24\x00\x00\x00WEBPVP8 "), "image/webp"},
+		{"images", "tiff", []byte("II*\x00\x08\x00\x00\x00"), "image/tiff"},
+		{"images", "bmp", []byte("BM\x36\x00\x00\x00"), "image/bmp"},
+		{"images", "ico", []byte("\x00\x00\x01\x00\x01\x00"), "image/x-icon"},
+		{"images", "jp2", []byte("\x00\x00\x00\x0CjP  \r\n\x87\n"), "image/jp2"},
+
+		{"audio", "mp3 with tag", []byte("ID3\x04\x00\x00\x00\x00\x00\x00"), "audio/mpeg"},
+		{"audio", "mp3 frame", []byte("\xFF\xFB\x90\x64\x00"), "audio/mpeg"},
+		{"audio", "wav", []byte("RIFF\x24\x00\x00\x00WAVEfmt "), "audio/x-wav"},
+		{"audio", "aiff", []byte("FORM\x00\x00\x00\x00AIFFCOMM"), "audio/x-aiff"},
+		{"audio", "midi", []byte("MThd\x00\x00\x00\x06"), "audio/midi"},
+		{"audio", "m4a", isoBMFF("M4A "), "audio/mp4a-latm"},
+		{"audio", "ogg vorbis", append([]byte("OggS\x00\x02"), make([]byte, 22)...), "application/ogg"},
+
+		{"video", "ogg theora", append(append([]byte("OggS\x00\x02"), make([]byte, 22)...), "\x80theora"...), "video/ogg"},
+		{"video", "mp4", isoBMFF("isom"), "video/mp4"},
+		{"video", "quicktime", isoBMFF("qt  "), "video/quicktime"},
+		{"video", "3gp", isoBMFF("3gp5"), "video/3gpp"},
+		{"video", "m4v", isoBMFF("M4V "), "video/x-m4v"},
+		{"video", "webm", []byte("\x1A\x45\xDF\xA3\x9F\x42\x86\x81\x01\x42\x82\x84webm"), "video/webm"},
+		{"video", "avi", []byte("RIFF\x24\x00\x00\x00AVI LIST"), "video/x-msvideo"},
+		{"video", "flv", []byte("FLV\x01\x05"), "video/x-flv"},
+		{"video", "mpeg", []byte("\x00\x00\x01\xBA\x44"), "video/mpeg"},
+
+		{"archives", "zip", zipFile(t, "a.txt", "hello"), "application/zip"},
+		{"archives", "empty zip", zipFile(t), "application/zip"},
+		{"archives", "zip with word/ in a path", zipFile(t, "secrets/password/list.txt", "hunter2"), "application/zip"},
+		{"archives", "zip with xl/ in a path", zipFile(t, "backup/xl/old.txt", "x"), "application/zip"},
+		{"archives", "zip with ppt/ in a path", zipFile(t, "sppt/a.txt", "x", "notes.txt", "ppt/ mentioned"), "application/zip"},
+		{"archives", "zip with a word directory", zipFile(t, "word/notes.txt", "x"), "application/zip"},
+		{"archives", "gzip", []byte("\x1F\x8B\x08\x00"), "application/x-gzip"},
+		{"archives", "bzip2", []byte("BZh91AY&SY"), "application/x-bzip2"},
+		{"archives", "tar", tarFile(), "application/x-tar"},
+		{"archives", "rar", []byte("Rar!\x1A\x07\x01\x00"), "application/vnd.rar"},
+
+		{"executables", "pe", []byte("MZ\x90\x00\x03\x00"), "application/x-msdownload"},
+		{"executables", "elf", []byte("\x7FELF\x02\x01\x01"), "application/x-executable"},
+
+		{"markup and scripts", "html", []byte("<!doctype html><title>x</title>"), "text/html"},
+		{"markup and scripts", "html, upper case", []byte("<HTML><BODY>"), "text/html"},
+		{"markup and scripts", "svg", []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"/>`), "image/svg+xml"},
+		{"markup and scripts", "xml", []byte(`<?xml version="1.0"?><note/>`), "application/xml"},
+		{"markup and scripts", "sh", []byte("#!/bin/sh\necho hi\n"), "application/x-sh"},
+		{"markup and scripts", "bash", []byte("#!/usr/bin/env bash\n"), "application/x-sh"},
+		{"markup and scripts", "csh", []byte("#!/bin/csh\n"), "application/x-csh"},
+		{"markup and scripts", "calendar", []byte("BEGIN:VCALENDAR\r\n"), "text/calendar"},
+
+		{"fallbacks", "text", []byte("just some words\n"), "text/plain"},
+		{"fallbacks", "utf-8 text", []byte("grüße\n"), "text/plain"},
+		{"fallbacks", "binary", []byte{0x00, 0x01, 0x02, 0xFE}, "application/octet-stream"},
+		{"fallbacks", "empty", nil, "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		if got := DetectBytes(tt.data).Type; got != tt.want {
+			t.Errorf("%s, %s: DetectBytes = %q, want %q", tt.group, tt.name, got, tt.want)
+		}
+		got, err := DetectReader(struct{ io.Reader }{bytes.NewReader(tt.data)})
+		if err != nil || got.Type != tt.want {
+			t.Errorf("%s, %s: DetectReader = %q, %v; want %q", tt.group, tt.name, got.Type, err, tt.want)
+		}
+	}
+}
+
+func TestDetectConfidence(t *testing.T) {
+	pdf := DetectBytes([]byte("%PDF-1.7"))
+	zip := DetectBytes(zipFile(t, "a.txt", "hello"))
+	text := DetectBytes([]byte("hello"))
+	binary := DetectBytes([]byte{0})
+	if !(pdf.Confidence > zip.Confidence && zip.Confidence > text.Confidence && text.Confidence > binary.Confidence) {
+		t.Errorf("confidences: pdf %v, zip %v, text %v, binary %v", pdf.Confidence, zip.Confidence, text.Confidence, binary.Confidence)
+	}
+	if pdf.Confidence > 1 || binary.Confidence < 0 {
+		t.Errorf("confidence out of range: pdf %v, binary %v", pdf.Confidence, binary.Confidence)
+	}
+}
+
+// TestAddSignature checks that an added signature takes precedence according
+// to its priority and that readers are asked for enough bytes to match it.
+func TestAddSignature(t *testing.T) {
+	defer func(db *magicDB) { defaultMagic = db }(defaultMagic)
+	defaultMagic = newMagicDB(signatures)
+
+	offset := defaultMagic.readLen() + 10
+	AddSignature(
+		Signature{Type: "application/x-pdf-variant", Priority: 90, Matches: []Match{{Value: []byte("%PDF-9")}}},
+		Signature{Type: "application/x-late", Priority: 50, Matches: []Match{{Offset: offset, Value: []byte("LATE")}}},
+	)
+	if got := DetectBytes([]byte("%PDF-9.0")); got.Type != "application/x-pdf-variant" {
+		t.Errorf("DetectBytes(%%PDF-9) = %+v", got)
+	}
+	if got := DetectBytes([]byte("%PDF-1.7")); got.Type != "application/pdf" {
+		t.Errorf("DetectBytes(%%PDF-1) = %+v", got)
+	}
+	data := append(bytes.Repeat([]byte{0}, offset), "LATE"...)
+	if got, err := DetectReader(bytes.NewReader(data)); err != nil || got.Type != "application/x-late" {
+		t.Errorf("DetectReader(late signature) = %+v, %v", got, err)
+	}
+}
+
+// TestDetectReaderMacroEnabled checks that a macro-enabled workbook whose VBA
+// project lies past the sniffed prefix is only reported as a plain workbook
+// when the reader offers no random access.
+func TestDetectReaderMacroEnabled(t *testing.T) {
+	sheet := make([]byte, 8192)
+	rand.New(rand.NewSource(1)).Read(sheet)
+	data := zipFile(t,
+		"[Content_Types].xml", contentTypes("/xl/workbook.xml", "application/vnd.ms-excel.sheet.macroEnabled.main+xml"),
+		"xl/workbook.xml", "<workbook/>",
+		"xl/worksheets/sheet1.xml", string(sheet),
+		"xl/vbaProject.bin", "\xD0\xCF\x11\xE0",
+	)
+	const (
+		xlsx = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		xlsm = "application/vnd.ms-excel.sheet.macroEnabled.12"
+	)
+	if got := DetectBytes(data).Type; got != xlsx {
+		t.Errorf("DetectBytes = %q, want %q", got, xlsx)
+	}
+	if got, err := DetectReader(bytes.NewReader(data)); err != nil || got.Type != xlsm {
+		t.Errorf("DetectReader(*bytes.Reader) = %q, %v; want %q", got.Type, err, xlsm)
+	}
+	if got, err := DetectReader(struct{ io.Reader }{bytes.NewReader(data)}); err != nil || got.Type != xlsx {
+		t.Errorf("DetectReader(io.Reader) = %q, %v; want %q", got.Type, err, xlsx)
+	}
+}
+
+func TestDetectReaderShort(t *testing.T) {
+	got, err := DetectReader(strings.NewReader("%PDF"))
+	if err != nil || got.Type != "text/plain" {
+		t.Errorf("DetectReader(%%PDF) = %q, %v; want text/plain", got.Type, err)
+	}
+	errBroken := errors.New("broken")
+	if _, err := DetectReader(iotest.ErrReader(errBroken)); err != errBroken {
+		t.Errorf("DetectReader(failing reader) error = %v, want %v", err, errBroken)
+	}
+}
+
+func TestDetectStream(t *testing.T) {
+	pdf := "%PDF-1.7\n" + strings.Repeat("stream data\n", 1000)
+	tests := []struct {
+		name    string
+		r       io.Reader
+		content string
+		want    string
+		peeked  bool
+	}{
+		{"peeked", bufio.NewReaderSize(strings.NewReader(pdf), 16384), pdf, "application/pdf", true},
+		{"small buffer", bufio.NewReaderSize(strings.NewReader(pdf), 16), pdf, "application/pdf", true},
+		{"read", struct{ io.Reader }{strings.NewReader(pdf)}, pdf, "application/pdf", false},
+		{"short", struct{ io.Reader }{strings.NewReader("hello")}, "hello", "text/plain", false},
+		{"short, peeked", bufio.NewReader(strings.NewReader("hello")), "hello", "text/plain", true},
+		{"empty", strings.NewReader(""), "", "application/octet-stream", false},
+	}
+	for _, tt := range tests {
+		m, r, err := DetectStream(tt.r)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if m.Essence() != tt.want {
+			t.Errorf("%s: type %q, want %q", tt.name, m.Essence(), tt.want)
+		}
+		if tt.peeked && r != tt.r {
+			t.Errorf("%s: a peekable reader was not returned as is", tt.name)
+		}
+		if got, err := io.ReadAll(r); err != nil || string(got) != tt.content {
+			t.Errorf("%s: replayed %d bytes, %v; want %d", tt.name, len(got), err, len(tt.content))
+		}
+	}
+	if m, _, _ := DetectStream(strings.NewReader("<html><p>hi")); m.Param("charset") != "utf-8" {
+		t.Errorf("DetectStream(html) = %v, want a utf-8 charset", m)
+	}
+}
+
+func TestDetectStreamError(t *testing.T) {
+	errBroken := errors.New("broken")
+	r := struct{ io.Reader }{io.MultiReader(strings.NewReader("%PDF"), iotest.ErrReader(errBroken))}
+	_, rest, err := DetectStream(r)
+	if err != errBroken {
+		t.Fatalf("error %v, want %v", err, errBroken)
+	}
+	got, err := io.ReadAll(rest)
+	if string(got) != "%PDF" || err != errBroken {
+		t.Errorf("replayed %q, %v; want %q, %v", got, err, "%PDF", errBroken)
+	}
+
+	br := bufio.NewReader(iotest.ErrReader(errBroken))
+	if _, rest, err := DetectStream(br); err != errBroken || rest != br {
+		t.Errorf("peeked: error %v, reader %T; want %v and the reader itself", err, rest, errBroken)
+	}
+}
@@ -0,0 +1,104 @@
+package mime
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ContentDisposition returns a Content-Disposition header value for serving
+// a file called filename of type typ. Only types r flags InlineSafe are
+// served inline; anything else, including unknown types, is an attachment.
+// If typ is empty it is looked up from filename.
+//
+// The file name is reduced to its last path element and stripped of control
+// characters. It is given as an ASCII filename parameter and, when it
+// contains other characters, additionally as an RFC 5987 filename*
+// parameter, as RFC 6266 recommends.
+func (r *Registry) ContentDisposition(filename, typ string) string {
+	name := dispositionName(filename)
+	if typ == "" && name != "" {
+		typ = r.TypeByExtension(name)
+	}
+	disp := "attachment"
+	if typ != "" && r.HasFlags(typ, InlineSafe) {
+		disp = "inline"
+	}
+	if name == "" {
+		return disp
+	}
+	var b strings.Builder
+	b.WriteString(disp)
+	b.WriteString(`; filename="`)
+	ascii := asciiFilename(name)
+	b.WriteString(ascii)
+	b.WriteByte('"')
+	if ascii != name {
+		b.WriteString("; filename*=UTF-8''")
+		b.WriteString(encodeRFC5987(name))
+	}
+	return b.String()
+}
+
+// ContentDisposition returns a Content-Disposition header value using
+// DefaultRegistry.
+func ContentDisposition(filename, typ string) string {
+	return DefaultRegistry.ContentDisposition(filename, typ)
+}
+
+// dispositionName returns the last element of a slash- or
+// backslash-separated path, without control characters and invalid UTF-8.
+func dispositionName(filename string) string {
+	filename = strings.ReplaceAll(filename, `\`, "/")
+	if i := strings.LastIndexByte(filename, '/'); i >= 0 {
+		filename = filename[i+1:]
+	}
+	name := strings.Map(func(c rune) rune {
+		if c == utf8.RuneError || unicode.IsControl(c) {
+			return -1
+		}
+		return c
+	}, filename)
+	name = strings.TrimSpace(name)
+	if name == "." || name == ".." {
+		return ""
+	}
+	return name
+}
+
+// asciiFilename replaces the characters of name that cannot appear in a
+// quoted-string understood by every browser with underscores.
+func asciiFilename(name string) string {
+	return strings.Map(func(c rune) rune {
+		if c < 0x20 || c > 0x7E || c == '"' || c == '\\' || c == '%' {
+			return '_'
+		}
+		return c
+	}, name)
+}
+
+// encodeRFC5987 percent-encodes s as the value of an RFC 5987 ext-value,
+// leaving only attr-char bytes unescaped.
+func encodeRFC5987(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isAttrChar(c) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hex[c>>4])
+		b.WriteByte(hex[c&0x0F])
+	}
+	return b.String()
+}
+
+func isAttrChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$&+-.^_`|~", c) >= 0
+}
@@ -0,0 +1,55 @@
+package mime
+
+import "testing"
+
+func TestContentDisposition(t *testing.T) {
+	tests := []struct {
+		filename, typ, want string
+	}{
+		{"photo.png", "", `inline; filename="photo.png"`},
+		{"notes.txt", "", `inline; filename="notes.txt"`},
+		{"page.html", "", `attachment; filename="page.html"`},
+		{"image.svg", "", `attachment; filename="image.svg"`},
+		{"setup.exe", "", `attachment; filename="setup.exe"`},
+		{"data.unknownext", "", `attachment; filename="data.unknownext"`},
+		{"photo.png", "text/html", `attachment; filename="photo.png"`},
+		{"report", "image/jpeg; q=1", `inline; filename="report"`},
+		{"report.pdf", "", `attachment; filename="report.pdf"`},
+		{"", "image/png", "inline"},
+		{"", "", "attachment"},
+
+		{"/var/www/uploads/photo.png", "", `inline; filename="photo.png"`},
+		{`C:\Users\me\photo.png`, "", `inline; filename="photo.png"`},
+		{"../../etc/passwd", "", `attachment; filename="passwd"`},
+		{"dir/..", "", "attachment"},
+		{"a\r\nb.txt", "", `inline; filename="ab.txt"`},
+		{"  spaced.txt  ", "", `inline; filename="spaced.txt"`},
+
+		{"résumé.pdf", "", `attachment; filename="r_sum_.pdf"; filename*=UTF-8''r%C3%A9sum%C3%A9.pdf`},
+		{"日本.txt", "", `inline; filename="__.txt"; filename*=UTF-8''%E6%97%A5%E6%9C%AC.txt`},
+		{"100%.txt", "", `inline; filename="100_.txt"; filename*=UTF-8''100%25.txt`},
+		{`say "hi".txt`, "", `inline; filename="say _hi_.txt"; filename*=UTF-8''say%20%22hi%22.txt`},
+		{"a'b (1).txt", "", `inline; filename="a'b (1).txt"`},
+	}
+	for _, tt := range tests {
+		if got := ContentDisposition(tt.filename, tt.typ); got != tt.want {
+			t.Errorf("ContentDisposition(%q, %q) = %s, want %s", tt.filename, tt.typ, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeRFC5987(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"abcXYZ019", "abcXYZ019"},
+		{"!#$&+-.^_`|~", "!#$&+-.^_`|~"},
+		{`a b"c\d'e;f`, "a%20b%22c%5Cd%27e%3Bf"},
+		{"€", "%E2%82%AC"},
+	}
+	for _, tt := range tests {
+		if got := encodeRFC5987(tt.in); got != tt.want {
+			t.Errorf("encodeRFC5987(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package mime
+
+import (
+	"path"
+	"strings"
+)
+
+// encodingExt maps the extensions of compression formats to the
+// Content-Encoding a web server sends for them. bzip2 and xz are not
+// registered HTTP content codings but are what Apache's AddEncoding uses.
+var encodingExt = map[string]string{
+	".gz":  "gzip",
+	".z":   "compress",
+	".bz2": "bzip2",
+	".xz":  "xz",
+	".br":  "br",
+	".zst": "zstd",
+}
+
+// shortEncodingExt maps abbreviated compound extensions to the extension of
+// the container and the encoding they stand for.
+var shortEncodingExt = map[string][2]string{
+	".tgz":  {".tar", "gzip"},
+	".taz":  {".tar", "compress"},
+	".tbz":  {".tar", "bzip2"},
+	".tbz2": {".tar", "bzip2"},
+	".txz":  {".tar", "xz"},
+	".svgz": {".svg", "gzip"},
+}
+
+// TypeAndEncoding returns the type of the content of filePath together with
+// the compression applied to it, the way an HTTP server fills in
+// Content-Type and Content-Encoding. For "backup.tar.gz" it returns
+// application/x-tar and gzip. A compressed file without a known inner
+// extension, such as "data.gz", is reported as the compression format itself
+// with an empty encoding.
+func (r *Registry) TypeAndEncoding(filePath string) (typ, encoding string) {
+	base := path.Base(filePath)
+	ext := strings.ToLower(path.Ext(base))
+	if enc, ok := encodingExt[ext]; ok {
+		inner := base[:len(base)-len(ext)]
+		if path.Ext(inner) != "" {
+			if typ := r.TypeByExtension(inner); typ != "" {
+				return typ, enc
+			}
+		}
+	}
+	if short, ok := shortEncodingExt[ext]; ok {
+		if typ := r.TypeByExtension(short[0]); typ != "" {
+			return typ, short[1]
+		}
+	}
+	return r.TypeByExtension(filePath), ""
+}
+
+// TypeAndEncoding returns the content type and content encoding of filePath
+// according to DefaultRegistry.
+func TypeAndEncoding(filePath string) (typ, encoding string) {
+	return DefaultRegistry.TypeAndEncoding(filePath)
+}
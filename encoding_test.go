@@ -0,0 +1,55 @@
+package mime
+
+import "testing"
+
+func TestTypeAndEncoding(t *testing.T) {
+	tests := []struct {
+		name, typ, encoding string
+	}{
+		{"backup.tar.gz", "application/x-tar", "gzip"},
+		{"dir/Backup.TAR.GZ", "application/x-tar", "gzip"},
+		{"backup.tar.bz2", "application/x-tar", "bzip2"},
+		{"backup.tar.xz", "application/x-tar", "xz"},
+		{"backup.tar.Z", "application/x-tar", "compress"},
+		{"backup.tgz", "application/x-tar", "gzip"},
+		{"backup.TBZ2", "application/x-tar", "bzip2"},
+		{"backup.txz", "application/x-tar", "xz"},
+		{"drawing.svgz", "image/svg+xml", "gzip"},
+		{"drawing.svg.gz", "image/svg+xml", "gzip"},
+		{"notes.txt.br", "text/plain; charset=utf-8", "br"},
+		{"data.json.zst", "application/json", "zstd"},
+		{"data.gz", "application/gzip", ""},
+		{"data.unknownext.gz", "application/gzip", ""},
+		{"photo.png", "image/png", ""},
+		{"script.user.js", "text/javascript; charset=utf-8", ""},
+		{"noext", "", ""},
+	}
+	for _, tt := range tests {
+		typ, enc := TypeAndEncoding(tt.name)
+		if Canonical(typ) != tt.typ || enc != tt.encoding {
+			t.Errorf("TypeAndEncoding(%q) = %q, %q; want %q, %q", tt.name, typ, enc, tt.typ, tt.encoding)
+		}
+	}
+}
+
+// TestTypeAndEncodingCompound checks that compound extensions in the table
+// take precedence over their last component, also inside a compressed file.
+func TestTypeAndEncodingCompound(t *testing.T) {
+	r := DefaultRegistry.Clone()
+	r.Add(".user.js", "application/x-userscript")
+	tests := []struct {
+		name, typ, encoding string
+	}{
+		{"script.user.js", "application/x-userscript", ""},
+		{"script.USER.JS", "application/x-userscript", ""},
+		{"script.user.js.gz", "application/x-userscript", "gzip"},
+		{"user.js", "text/javascript; charset=utf-8", ""},
+		{"script.js", "text/javascript; charset=utf-8", ""},
+	}
+	for _, tt := range tests {
+		typ, enc := r.TypeAndEncoding(tt.name)
+		if r.Canonical(typ) != tt.typ || enc != tt.encoding {
+			t.Errorf("TypeAndEncoding(%q) = %q, %q; want %q, %q", tt.name, typ, enc, tt.typ, tt.encoding)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package mime
+
+import (
+	"sort"
+	"strings"
+)
+
+// Flags describe the risks of handling a type, for deciding whether content
+// may be served inline or must be sent as an attachment.
+type Flags uint
+
+const (
+	// Executable types run as programs or install software when opened.
+	Executable Flags = 1 << iota
+	// Scriptable types are markup or code that a browser or viewer
+	// renders as active content and may run scripts in.
+	Scriptable
+	// MacroCapable types are documents that can carry macros.
+	MacroCapable
+	// Archive types are containers of other files.
+	Archive
+	// InlineSafe types can be displayed by a browser without running
+	// content from the file.
+	InlineSafe
+)
+
+// riskFlags are inherited from ancestors: a kind of a risky type is at least
+// as risky. InlineSafe is not, since text/html is a kind of text/plain.
+const riskFlags = Executable | Scriptable | MacroCapable | Archive
+
+var flagNames = []struct {
+	f    Flags
+	name string
+}{
+	{Executable, "executable"},
+	{Scriptable, "scriptable"},
+	{MacroCapable, "macro-capable"},
+	{Archive, "archive"},
+	{InlineSafe, "inline-safe"},
+}
+
+// String returns the names of the flags set in f, separated by "|".
+func (f Flags) String() string {
+	var names []string
+	for _, n := range flagNames {
+		if f&n.f != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// builtinFlags classifies the types of the built-in database and those
+// DetectBytes reports. Kinds of a listed type, such as the XML-based types
+// under application/xml, inherit its risk flags through the hierarchy.
+var builtinFlags = map[string]Flags{
+	"application/vnd.microsoft.portable-executable": Executable,
+	"application/java-archive":                      Executable,
+	"application/x-java-jnlp-file":                  Executable,
+	"application/x-xpinstall":                       Executable,
+	"application/x-installshield":                   Executable,
+	"application/vnd.symbian.install":               Executable,
+	"application/x-rpm":                             Executable | Archive,
+	"application/x-shar":                            Executable | Scriptable | Archive,
+	"application/x-sh":                              Executable | Scriptable,
+	"application/x-csh":                             Executable | Scriptable,
+	"application/x-perl":                            Executable | Scriptable,
+	"application/x-tcl":                             Executable | Scriptable,
+	"application/hta":                               Executable | Scriptable,
+	"text/scriptlet":                                Executable | Scriptable,
+	"magnus-internal/cgi":                           Executable,
+	"application/x-msi":                             Executable,
+	"application/x-ms-application":                  Executable,
+	"application/x-ms-shortcut":                     Executable,
+	"text/vbscript":                                 Executable | Scriptable,
+	"application/x-ms-wsf":                          Executable | Scriptable,
+
+	"text/html":                         Scriptable,
+	"application/xhtml+xml":             Scriptable,
+	"image/svg+xml":                     Scriptable,
+	"application/xml":                   Scriptable,
+	"text/javascript":                   Scriptable,
+	"text/x-component":                  Scriptable,
+	"text/webviewhtml":                  Scriptable,
+	"text/vnd.wap.wmlscript":            Scriptable,
+	"application/vnd.wap.wmlscriptc":    Scriptable,
+	"application/x-ns-proxy-autoconfig": Scriptable,
+	"application/olescript":             Scriptable,
+	"application/vnd.adobe.flash.movie": Scriptable,
+	"application/pdf":                   Scriptable,
+
+	"application/msword":                                         MacroCapable,
+	"application/vnd.ms-excel":                                   MacroCapable,
+	"application/vnd.ms-powerpoint":                              MacroCapable,
+	"application/vnd.ms-project":                                 MacroCapable,
+	"application/x-msaccess":                                     MacroCapable,
+	"application/vnd.ms-word.document.macroenabled.12":           MacroCapable,
+	"application/vnd.ms-word.template.macroenabled.12":           MacroCapable,
+	"application/vnd.ms-excel.sheet.macroenabled.12":             MacroCapable,
+	"application/vnd.ms-excel.template.macroenabled.12":          MacroCapable,
+	"application/vnd.ms-excel.addin.macroenabled.12":             MacroCapable,
+	"application/vnd.ms-excel.sheet.binary.macroenabled.12":      MacroCapable,
+	"application/vnd.ms-powerpoint.presentation.macroenabled.12": MacroCapable,
+	"application/vnd.ms-powerpoint.slideshow.macroenabled.12":    MacroCapable,
+	"application/vnd.ms-powerpoint.template.macroenabled.12":     MacroCapable,
+	"application/vnd.ms-powerpoint.addin.macroenabled.12":        MacroCapable,
+	"application/vnd.oasis.opendocument.text":                    MacroCapable,
+	"application/vnd.oasis.opendocument.spreadsheet":             MacroCapable,
+	"application/vnd.oasis.opendocument.presentation":            MacroCapable,
+	"application/vnd.oasis.opendocument.graphics":                MacroCapable,
+	"application/vnd.oasis.opendocument.database":                MacroCapable,
+	"application/vnd.sun.xml.writer":                             MacroCapable,
+	"application/vnd.sun.xml.calc":                               MacroCapable,
+	"application/vnd.sun.xml.impress":                            MacroCapable,
+	"application/vnd.sun.xml.draw":                               MacroCapable,
+
+	"application/zip":              Archive,
+	"application/gzip":             Archive,
+	"application/x-bzip2":          Archive,
+	"application/x-xz":             Archive,
+	"application/x-compress":       Archive,
+	"application/x-tar":            Archive,
+	"application/x-cpio":           Archive,
+	"application/x-sv4cpio":        Archive,
+	"application/x-sv4crc":         Archive,
+	"application/x-bcpio":          Archive,
+	"application/x-stuffit":        Archive,
+	"application/mac-compactpro":   Archive,
+	"application/vnd.rar":          Archive,
+	"application/x-7z-compressed":  Archive,
+	"application/x-gca-compressed": Archive,
+
+	"text/plain":                InlineSafe,
+	"text/csv":                  InlineSafe,
+	"text/tab-separated-values": InlineSafe,
+	"image/png":                 InlineSafe,
+	"image/jpeg":                InlineSafe,
+	"image/gif":                 InlineSafe,
+	"image/webp":                InlineSafe,
+	"image/bmp":                 InlineSafe,
+	"image/vnd.microsoft.icon":  InlineSafe,
+	"audio/mpeg":                InlineSafe,
+	"audio/vnd.wave":            InlineSafe,
+	"audio/ogg":                 InlineSafe,
+	"video/mp4":                 InlineSafe,
+	"video/webm":                InlineSafe,
+	"video/ogg":                 InlineSafe,
+	"application/ogg":           InlineSafe,
+	"application/json":          InlineSafe,
+}
+
+// TypeFlags returns the flags of typ: those recorded for it in r, plus the
+// risk flags of its ancestors. A type with any risk flag is never
+// InlineSafe. Parameters and aliases are resolved as by IsSubtypeOf; unknown
+// types have no flags.
+func (r *Registry) TypeFlags(typ string) Flags {
+	typ = r.canonical(baseType(typ))
+	r.mu.RLock()
+	f := r.flags[typ]
+	r.mu.RUnlock()
+	for _, a := range r.Ancestors(typ) {
+		r.mu.RLock()
+		f |= r.flags[a] & riskFlags
+		r.mu.RUnlock()
+	}
+	if f&riskFlags != 0 {
+		f &^= InlineSafe
+	}
+	return f
+}
+
+// HasFlags reports whether typ has every flag in f.
+func (r *Registry) HasFlags(typ string, f Flags) bool {
+	return r.TypeFlags(typ)&f == f
+}
+
+// SetFlags records the flags of typ in r, replacing any recorded before.
+// Kinds of typ inherit its risk flags.
+func (r *Registry) SetFlags(typ string, f Flags) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	typ = r.canonicalLocked(baseType(typ))
+	if f == 0 {
+		delete(r.flags, typ)
+		return
+	}
+	r.flags[typ] = f
+}
+
+// TypesWithFlags returns, in lexical order, the canonical names of the types
+// known to r that have every flag in f: the types of r's extension, glob
+// and flag tables and those they are kinds of.
+func (r *Registry) TypesWithFlags(f Flags) []string {
+	r.mu.RLock()
+	known := make(map[string]bool)
+	for _, e := range r.types {
+		known[r.canonicalLocked(baseType(e.typ))] = true
+	}
+	for _, g := range r.globs {
+		known[r.canonicalLocked(g.typ)] = true
+	}
+	for typ := range r.flags {
+		known[typ] = true
+	}
+	for typ, ps := range r.parents {
+		known[typ] = true
+		for _, p := range ps {
+			known[p] = true
+		}
+	}
+	r.mu.RUnlock()
+
+	var out []string
+	for typ := range known {
+		if r.HasFlags(typ, f) {
+			out = append(out, typ)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// TypeFlags returns the flags of typ in DefaultRegistry.
+func TypeFlags(typ string) Flags {
+	return DefaultRegistry.TypeFlags(typ)
+}
+
+// HasFlags reports whether typ has every flag in f in DefaultRegistry.
+func HasFlags(typ string, f Flags) bool {
+	return DefaultRegistry.HasFlags(typ, f)
+}
+
+// TypesWithFlags returns the types of DefaultRegistry that have every flag
+// in f.
+func TypesWithFlags(f Flags) []string {
+	return DefaultRegistry.TypesWithFlags(f)
+}
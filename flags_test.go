@@ -0,0 +1,81 @@
+package mime
+
+import "testing"
+
+func TestFlagsByName(t *testing.T) {
+	tests := []struct {
+		name string
+		want Flags
+	}{
+		{"setup.exe", Executable},
+		{"library.dll", Executable},
+		{"installer.msi", Executable},
+		{"run.bat", Executable},
+		{"run.cmd", Executable},
+		{"script.vbs", Executable | Scriptable},
+		{"script.wsf", Executable | Scriptable},
+		{"install.csh", Executable | Scriptable},
+		{"install.sh", Executable | Scriptable},
+		{"app.jar", Executable | Archive},
+		{"page.html", Scriptable},
+		{"page.xhtml", Scriptable},
+		{"image.svg", Scriptable},
+		{"app.js", Scriptable},
+		{"movie.swf", Scriptable},
+		{"report.docm", MacroCapable},
+		{"addin.xlam", MacroCapable},
+		{"book.xlsm", MacroCapable},
+		{"backup.zip", Archive},
+		{"backup.tar.gz", Archive},
+	}
+	for _, tt := range tests {
+		typ := TypeByExtension(tt.name)
+		if !HasFlags(typ, tt.want) {
+			t.Errorf("TypeFlags(TypeByExtension(%q) = %q) = %v, want %v", tt.name, typ, TypeFlags(typ), tt.want)
+		}
+		if HasFlags(typ, InlineSafe) {
+			t.Errorf("%s (%s) is InlineSafe", tt.name, typ)
+		}
+	}
+}
+
+func TestFlagsInlineSafe(t *testing.T) {
+	for _, name := range []string{"a.png", "a.jpg", "a.gif", "a.txt", "a.csv", "a.mp4", "a.json"} {
+		typ := TypeByExtension(name)
+		if f := TypeFlags(typ); f != InlineSafe {
+			t.Errorf("TypeFlags(%q) = %v, want %v", typ, f, InlineSafe)
+		}
+	}
+}
+
+func TestSetFlags(t *testing.T) {
+	r := DefaultRegistry.Clone()
+	r.SetFlags("application/x-foo", Executable)
+	r.AddAlias("application/x-foo-bar", "application/x-foo")
+	if !r.HasFlags("application/x-foo-bar; version=2", Executable) {
+		t.Errorf("alias of an executable type is not Executable")
+	}
+	if HasFlags("application/x-foo", Executable) {
+		t.Errorf("SetFlags on a clone changed DefaultRegistry")
+	}
+	r.SetFlags("application/x-foo", 0)
+	if f := r.TypeFlags("application/x-foo-bar"); f != 0 {
+		t.Errorf("TypeFlags after clearing = %v", f)
+	}
+}
+
+// TestFlagsJavaArchive checks JARs under both names, since the standard
+// library answers .jar with application/java-archive on hosts whose
+// /etc/mime.types says so.
+func TestFlagsJavaArchive(t *testing.T) {
+	r := DefaultRegistry.Clone()
+	r.Add(".jar", "application/java-archive")
+	for _, typ := range []string{"application/java-archive", "application/x-java-archive", r.TypeByExtension("app.jar")} {
+		if !r.HasFlags(typ, Executable|Archive) {
+			t.Errorf("TypeFlags(%q) = %v, want %v", typ, r.TypeFlags(typ), Executable|Archive)
+		}
+		if k := r.Kind(typ); k != KindExecutable {
+			t.Errorf("Kind(%q) = %v, want %v", typ, k, KindExecutable)
+		}
+	}
+}
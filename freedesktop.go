@@ -0,0 +1,389 @@
+package mime
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// SharedMimeInfo is the content of a freedesktop.org shared-mime-info
+// package, such as /usr/share/mime/packages/freedesktop.org.xml.
+type SharedMimeInfo struct {
+	Types []TypeInfo
+}
+
+// TypeInfo describes one mime-type element of a shared-mime-info package.
+type TypeInfo struct {
+	Type        string
+	Comment     string            // untranslated description
+	Comments    map[string]string // translated descriptions keyed by xml:lang
+	Acronym     string
+	Expanded    string // expanded acronym
+	Icon        string
+	GenericIcon string
+	Aliases     []string
+	SubClassOf  []string
+	Globs       []Glob
+	Magic       []Signature
+}
+
+type xmlMimeInfo struct {
+	Types []xmlMimeType `xml:"mime-type"`
+}
+
+type xmlMimeType struct {
+	Type     string `xml:"type,attr"`
+	Comments []struct {
+		Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+		Text string `xml:",chardata"`
+	} `xml:"comment"`
+	Acronym  string `xml:"acronym"`
+	Expanded string `xml:"expanded-acronym"`
+	Icon     struct {
+		Name string `xml:"name,attr"`
+	} `xml:"icon"`
+	GenericIcon struct {
+		Name string `xml:"name,attr"`
+	} `xml:"generic-icon"`
+	Globs []struct {
+		Pattern       string `xml:"pattern,attr"`
+		Weight        string `xml:"weight,attr"`
+		CaseSensitive string `xml:"case-sensitive,attr"`
+	} `xml:"glob"`
+	Magic []struct {
+		Priority string     `xml:"priority,attr"`
+		Matches  []xmlMatch `xml:"match"`
+	} `xml:"magic"`
+	Aliases []struct {
+		Type string `xml:"type,attr"`
+	} `xml:"alias"`
+	SubClassOf []struct {
+		Type string `xml:"type,attr"`
+	} `xml:"sub-class-of"`
+}
+
+type xmlMatch struct {
+	Type    string     `xml:"type,attr"`
+	Offset  string     `xml:"offset,attr"`
+	Value   string     `xml:"value,attr"`
+	Mask    string     `xml:"mask,attr"`
+	Matches []xmlMatch `xml:"match"`
+}
+
+// ParseSharedMimeInfo parses a shared-mime-info XML package from rd.
+func ParseSharedMimeInfo(rd io.Reader) (*SharedMimeInfo, error) {
+	var doc xmlMimeInfo
+	dec := xml.NewDecoder(rd)
+	dec.Strict = false
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	info := &SharedMimeInfo{Types: make([]TypeInfo, 0, len(doc.Types))}
+	for _, xt := range doc.Types {
+		t := TypeInfo{
+			Type:        xt.Type,
+			Acronym:     xt.Acronym,
+			Expanded:    xt.Expanded,
+			Icon:        xt.Icon.Name,
+			GenericIcon: xt.GenericIcon.Name,
+		}
+		if err := checkType(t.Type); err != nil {
+			return nil, err
+		}
+		for _, c := range xt.Comments {
+			if c.Lang == "" {
+				t.Comment = c.Text
+				continue
+			}
+			if t.Comments == nil {
+				t.Comments = make(map[string]string)
+			}
+			t.Comments[c.Lang] = c.Text
+		}
+		for _, a := range xt.Aliases {
+			if err := checkType(a.Type); err != nil {
+				return nil, err
+			}
+			t.Aliases = append(t.Aliases, a.Type)
+		}
+		for _, p := range xt.SubClassOf {
+			if err := checkType(p.Type); err != nil {
+				return nil, err
+			}
+			t.SubClassOf = append(t.SubClassOf, p.Type)
+		}
+		for _, g := range xt.Globs {
+			glob := Glob{Pattern: g.Pattern, Weight: defaultGlobWeight, CaseSensitive: g.CaseSensitive == "true"}
+			if g.Weight != "" {
+				w, err := strconv.Atoi(g.Weight)
+				if err != nil {
+					return nil, fmt.Errorf("mime: %s: invalid glob weight %q", t.Type, g.Weight)
+				}
+				glob.Weight = w
+			}
+			t.Globs = append(t.Globs, glob)
+		}
+		for _, m := range xt.Magic {
+			sig := Signature{Type: t.Type, Priority: defaultMagicPriority}
+			if m.Priority != "" {
+				p, err := strconv.Atoi(m.Priority)
+				if err != nil {
+					return nil, fmt.Errorf("mime: %s: invalid magic priority %q", t.Type, m.Priority)
+				}
+				sig.Priority = p
+			}
+			for _, xm := range m.Matches {
+				match, err := xm.convert()
+				if err != nil {
+					return nil, fmt.Errorf("mime: %s: %v", t.Type, err)
+				}
+				sig.Matches = append(sig.Matches, match)
+			}
+			t.Magic = append(t.Magic, sig)
+		}
+		info.Types = append(info.Types, t)
+	}
+	return info, nil
+}
+
+// AddSharedMimeInfo adds the glob, alias, sub-class-of, description and icon
+// data of info to r. Case-insensitive globs of the form "*.ext" with the
+// default weight become extension entries; when several types claim the same
+// extension the first one wins. All other globs become glob rules. Magic
+// rules are not part of a Registry; see LoadSharedMimeInfo.
+func (r *Registry) AddSharedMimeInfo(info *SharedMimeInfo) {
+	exts := make(map[string]string)
+	var globs []globRule
+	for _, t := range info.Types {
+		for _, g := range t.Globs {
+			ext, ok := globExt(g.Pattern)
+			if !ok || g.CaseSensitive || g.Weight != defaultGlobWeight {
+				globs = append(globs, globRule{g, t.Type})
+				continue
+			}
+			if _, dup := exts[ext]; !dup {
+				exts[ext] = t.Type
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for ext, typ := range exts {
+		r.types[ext] = entry{typ: typ}
+	}
+	for _, g := range globs {
+		r.addGlobLocked(g)
+	}
+	for _, t := range info.Types {
+		for _, a := range t.Aliases {
+			r.setAlias(strings.ToLower(a), strings.ToLower(t.Type))
+		}
+	}
+	for _, t := range info.Types {
+		for _, p := range t.SubClassOf {
+			r.addParent(t.Type, p)
+		}
+	}
+	for _, t := range info.Types {
+		d := typeDesc{
+			comment:     t.Comment,
+			acronym:     t.Acronym,
+			expanded:    t.Expanded,
+			icon:        t.Icon,
+			genericIcon: t.GenericIcon,
+		}
+		if len(t.Comments) > 0 {
+			d.comments = make(map[string]string, len(t.Comments))
+			for lang, c := range t.Comments {
+				d.comments[normLangTag(lang)] = c
+			}
+		}
+		r.descs[strings.ToLower(t.Type)] = d
+	}
+}
+
+// LoadSharedMimeInfo reads the shared-mime-info package file name from the
+// local file system, adds it to DefaultRegistry and registers its magic rules
+// with the content sniffer.
+func LoadSharedMimeInfo(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := ParseSharedMimeInfo(f)
+	if err != nil {
+		return err
+	}
+	DefaultRegistry.AddSharedMimeInfo(info)
+	for _, t := range info.Types {
+		AddSignature(t.Magic...)
+	}
+	return nil
+}
+
+// globExt returns the extension of a "*.ext" glob without further wildcards.
+// Compound extensions such as "*.tar.gz" are included.
+func globExt(pattern string) (string, bool) {
+	if !strings.HasPrefix(pattern, "*.") {
+		return "", false
+	}
+	ext := pattern[1:]
+	if strings.ContainsAny(ext, "*?[") {
+		return "", false
+	}
+	return strings.ToLower(ext), true
+}
+
+// defaultMagicPriority is the priority of a magic element without a priority
+// attribute.
+const defaultMagicPriority = 50
+
+// maxMagicExtent bounds how far into a file an imported match may look, so
+// that a database cannot make DetectReader buffer arbitrarily much data.
+const maxMagicExtent = 1 << 16
+
+func (xm xmlMatch) convert() (Match, error) {
+	var m Match
+	offset, end, ranged := xm.Offset, "", false
+	if i := strings.IndexByte(offset, ':'); i >= 0 {
+		offset, end, ranged = offset[:i], offset[i+1:], true
+	}
+	start, err := strconv.Atoi(offset)
+	if err != nil || start < 0 {
+		return m, fmt.Errorf("invalid offset %q", xm.Offset)
+	}
+	m.Offset = start
+	if ranged {
+		n, err := strconv.Atoi(end)
+		if err != nil || n < start {
+			return m, fmt.Errorf("invalid offset %q", xm.Offset)
+		}
+		m.Range = n - start
+	}
+
+	if xm.Type == "string" {
+		m.Value = unescapeMagic(xm.Value)
+		if xm.Mask != "" {
+			mask, err := hex.DecodeString(strings.TrimPrefix(xm.Mask, "0x"))
+			if err != nil {
+				return m, fmt.Errorf("invalid mask %q", xm.Mask)
+			}
+			m.Mask = mask
+		}
+	} else {
+		if m.Value, err = magicNumber(xm.Type, xm.Value); err != nil {
+			return m, err
+		}
+		if xm.Mask != "" {
+			if m.Mask, err = magicNumber(xm.Type, xm.Mask); err != nil {
+				return m, err
+			}
+		}
+	}
+	if m.Mask != nil && len(m.Mask) != len(m.Value) {
+		return m, fmt.Errorf("mask %q does not match value %q", xm.Mask, xm.Value)
+	}
+	if m.Offset > maxMagicExtent || m.Range > maxMagicExtent-m.Offset || len(m.Value) > maxMagicExtent-m.Offset-m.Range {
+		return m, fmt.Errorf("match at offset %q reaches past %d bytes", xm.Offset, maxMagicExtent)
+	}
+
+	for _, sub := range xm.Matches {
+		s, err := sub.convert()
+		if err != nil {
+			return m, err
+		}
+		m.Sub = append(m.Sub, s)
+	}
+	return m, nil
+}
+
+// magicNumber encodes a numeric magic value according to its type. Host
+// byte order is taken to be little-endian.
+func magicNumber(typ, value string) ([]byte, error) {
+	var size int
+	var order binary.ByteOrder = binary.LittleEndian
+	switch typ {
+	case "byte":
+		size = 1
+	case "big16":
+		size, order = 2, binary.BigEndian
+	case "big32":
+		size, order = 4, binary.BigEndian
+	case "little16", "host16":
+		size = 2
+	case "little32", "host32":
+		size = 4
+	default:
+		return nil, fmt.Errorf("unknown match type %q", typ)
+	}
+	n, err := strconv.ParseUint(value, 0, size*8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value %q", typ, value)
+	}
+	b := make([]byte, 4)
+	switch size {
+	case 1:
+		return []byte{byte(n)}, nil
+	case 2:
+		order.PutUint16(b, uint16(n))
+	case 4:
+		order.PutUint32(b, uint32(n))
+	}
+	return b[:size], nil
+}
+
+// unescapeMagic decodes the C-style escapes used in string match values:
+// \xNN, octal \NNN and backslash followed by any other character.
+func unescapeMagic(s string) []byte {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 == len(s) {
+			b = append(b, c)
+			continue
+		}
+		i++
+		switch c = s[i]; {
+		case c == 'x' && isHex(s[i+1:]):
+			n := 1
+			if i+2 < len(s) && isHex(s[i+2:]) {
+				n = 2
+			}
+			v, _ := strconv.ParseUint(s[i+1:i+1+n], 16, 8)
+			b = append(b, byte(v))
+			i += n
+		case '0' <= c && c <= '7':
+			n := 1
+			for n < 3 && i+n < len(s) && '0' <= s[i+n] && s[i+n] <= '7' {
+				n++
+			}
+			v, _ := strconv.ParseUint(s[i:i+n], 8, 16)
+			b = append(b, byte(v))
+			i += n - 1
+		case c == 'n':
+			b = append(b, '\n')
+		case c == 'r':
+			b = append(b, '\r')
+		case c == 't':
+			b = append(b, '\t')
+		default:
+			b = append(b, c)
+		}
+	}
+	return b
+}
+
+func isHex(s string) bool {
+	if s == "" {
+		return false
+	}
+	c := s[0]
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
@@ -0,0 +1,204 @@
+package mime
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSharedMimeInfo(t *testing.T) {
+	f, err := os.Open("testdata/shared-mime-info.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	info, err := ParseSharedMimeInfo(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Types) != 2 {
+		t.Fatalf("got %d types, want 2", len(info.Types))
+	}
+
+	doc := info.Types[0]
+	if doc.Type != "application/x-test-doc" || doc.Comment != "Test document" || doc.Comments["de"] != "Testdokument" {
+		t.Errorf("type %q, comment %q, comments %v", doc.Type, doc.Comment, doc.Comments)
+	}
+	if doc.Acronym != "TD" || doc.Expanded != "Test Document" || doc.GenericIcon != "x-office-document" {
+		t.Errorf("acronym %q, expanded %q, generic icon %q", doc.Acronym, doc.Expanded, doc.GenericIcon)
+	}
+	if !reflect.DeepEqual(doc.Aliases, []string{"application/x-testdoc"}) || !reflect.DeepEqual(doc.SubClassOf, []string{"application/xml"}) {
+		t.Errorf("aliases %q, sub-class-of %q", doc.Aliases, doc.SubClassOf)
+	}
+	wantGlobs := []Glob{
+		{Pattern: "*.tdoc", Weight: 50},
+		{Pattern: "*.TDC", Weight: 50, CaseSensitive: true},
+		{Pattern: "README.test", Weight: 80},
+	}
+	if !reflect.DeepEqual(doc.Globs, wantGlobs) {
+		t.Errorf("globs %+v, want %+v", doc.Globs, wantGlobs)
+	}
+	wantMagic := []Signature{{Type: "application/x-test-doc", Priority: 60, Matches: []Match{{
+		Value: []byte("TDOC\x01\x00"),
+		Sub: []Match{
+			{Offset: 6, Value: []byte{0x01, 0x02}},
+			{Offset: 8, Range: 8, Value: []byte("\\tab\t"), Mask: []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF}},
+		},
+	}}}}
+	if !reflect.DeepEqual(doc.Magic, wantMagic) {
+		t.Errorf("magic %+v, want %+v", doc.Magic, wantMagic)
+	}
+
+	bin := info.Types[1]
+	wantMagic = []Signature{{Type: "application/x-test-bin", Priority: defaultMagicPriority, Matches: []Match{
+		{Value: []byte{0xBE, 0xBA, 0xFE, 0xCA}},
+		{Offset: 4, Value: []byte{0x7F}, Mask: []byte{0xF0}},
+	}}}
+	if !reflect.DeepEqual(bin.Magic, wantMagic) {
+		t.Errorf("magic %+v, want %+v", bin.Magic, wantMagic)
+	}
+
+	r := NewRegistry()
+	r.AddSharedMimeInfo(info)
+	for name, want := range map[string]string{
+		"a.tdoc":      "application/x-test-doc",
+		"A.TDOC":      "application/x-test-doc",
+		"a.TDC":       "application/x-test-doc",
+		"a.tdc":       "",
+		"README.test": "application/x-test-doc",
+		"a.tbin":      "application/x-test-bin",
+	} {
+		if got := r.TypeByExtension(name); got != want {
+			t.Errorf("TypeByExtension(%q) = %q, want %q", name, got, want)
+		}
+	}
+	if got := r.Canonical("application/x-testdoc"); got != "application/x-test-doc" {
+		t.Errorf("Canonical(alias) = %q", got)
+	}
+	if !r.IsSubtypeOf("application/x-test-doc", "application/xml") {
+		t.Errorf("application/x-test-doc is not a subtype of application/xml")
+	}
+	if got := r.Describe("application/x-test-doc", "de-DE"); got != "Testdokument" {
+		t.Errorf("Describe(de-DE) = %q", got)
+	}
+}
+
+func TestParseSharedMimeInfoErrors(t *testing.T) {
+	tests := []struct {
+		name, body, want string
+	}{
+		{"type", `<mime-type type="nonsense"/>`, `invalid MIME type "nonsense"`},
+		{"alias", `<mime-type type="a/b"><alias type="b"/></mime-type>`, `invalid MIME type "b"`},
+		{"sub-class-of", `<mime-type type="a/b"><sub-class-of type="a/c; x=y"/></mime-type>`, `invalid MIME type "a/c; x=y"`},
+		{"glob weight", `<mime-type type="a/b"><glob pattern="*.b" weight="high"/></mime-type>`, `invalid glob weight "high"`},
+		{"priority", `<mime-type type="a/b"><magic priority="x"/></mime-type>`, `invalid magic priority "x"`},
+		{"offset", `<mime-type type="a/b"><magic><match type="string" value="B" offset="x"/></magic></mime-type>`, `invalid offset "x"`},
+		{"negative offset", `<mime-type type="a/b"><magic><match type="string" value="B" offset="-4"/></magic></mime-type>`, `invalid offset "-4"`},
+		{"range end", `<mime-type type="a/b"><magic><match type="string" value="B" offset="0:x"/></magic></mime-type>`, `invalid offset "0:x"`},
+		{"empty range end", `<mime-type type="a/b"><magic><match type="string" value="B" offset="4:"/></magic></mime-type>`, `invalid offset "4:"`},
+		{"backwards range", `<mime-type type="a/b"><magic><match type="string" value="B" offset="8:4"/></magic></mime-type>`, `invalid offset "8:4"`},
+		{"range ending at 0", `<mime-type type="a/b"><magic><match type="string" value="B" offset="5:0"/></magic></mime-type>`, `invalid offset "5:0"`},
+		{"large offset", `<mime-type type="a/b"><magic><match type="string" value="B" offset="65536"/></magic></mime-type>`, `match at offset "65536" reaches past 65536 bytes`},
+		{"huge offset", `<mime-type type="a/b"><magic><match type="string" value="B" offset="9223372036854775807"/></magic></mime-type>`, `reaches past 65536 bytes`},
+		{"large range", `<mime-type type="a/b"><magic><match type="string" value="B" offset="4:9223372036854775807"/></magic></mime-type>`, `match at offset "4:9223372036854775807" reaches past`},
+		{"range and value", `<mime-type type="a/b"><magic><match type="string" value="BC" offset="65530:65535"/></magic></mime-type>`, `reaches past`},
+		{"nested offset", `<mime-type type="a/b"><magic><match type="string" value="A" offset="0"><match type="string" value="B" offset="70000"/></match></magic></mime-type>`, `match at offset "70000"`},
+		{"match type", `<mime-type type="a/b"><magic><match type="float" value="1" offset="0"/></magic></mime-type>`, `unknown match type "float"`},
+		{"number", `<mime-type type="a/b"><magic><match type="big16" value="0x10000" offset="0"/></magic></mime-type>`, `invalid big16 value "0x10000"`},
+		{"byte", `<mime-type type="a/b"><magic><match type="byte" value="x" offset="0"/></magic></mime-type>`, `invalid byte value "x"`},
+		{"string mask", `<mime-type type="a/b"><magic><match type="string" value="AB" offset="0" mask="0xFG"/></magic></mime-type>`, `invalid mask "0xFG"`},
+		{"mask length", `<mime-type type="a/b"><magic><match type="string" value="ABC" offset="0" mask="0xFFFF"/></magic></mime-type>`, `mask "0xFFFF" does not match value "ABC"`},
+		{"nested", `<mime-type type="a/b"><magic><match type="string" value="A" offset="0"><match type="host16" value="z" offset="1"/></match></magic></mime-type>`, `invalid host16 value "z"`},
+	}
+	for _, tt := range tests {
+		doc := `<mime-info xmlns="http://www.freedesktop.org/standards/shared-mime-info">` + tt.body + `</mime-info>`
+		_, err := ParseSharedMimeInfo(strings.NewReader(doc))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error %v, want %q", tt.name, err, tt.want)
+		}
+	}
+	edge := `<mime-info><mime-type type="a/b"><magic><match type="string" value="BC" offset="65530:65534"/></magic></mime-type></mime-info>`
+	if _, err := ParseSharedMimeInfo(strings.NewReader(edge)); err != nil {
+		t.Errorf("match ending at the limit: %v", err)
+	}
+	if _, err := ParseSharedMimeInfo(strings.NewReader("<mime-info><mime-type")); err == nil {
+		t.Errorf("truncated XML: no error")
+	}
+}
+
+func TestMagicNumber(t *testing.T) {
+	tests := []struct {
+		typ, value string
+		want       []byte
+	}{
+		{"byte", "0x7f", []byte{0x7F}},
+		{"byte", "255", []byte{0xFF}},
+		{"big16", "0x0102", []byte{0x01, 0x02}},
+		{"little16", "0x0102", []byte{0x02, 0x01}},
+		{"host16", "0x0102", []byte{0x02, 0x01}},
+		{"big32", "0xcafebabe", []byte{0xCA, 0xFE, 0xBA, 0xBE}},
+		{"little32", "0xcafebabe", []byte{0xBE, 0xBA, 0xFE, 0xCA}},
+		{"host32", "010", []byte{0x08, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		got, err := magicNumber(tt.typ, tt.value)
+		if err != nil || !bytes.Equal(got, tt.want) {
+			t.Errorf("magicNumber(%q, %q) = %x, %v; want %x", tt.typ, tt.value, got, err, tt.want)
+		}
+	}
+	for _, bad := range [][2]string{{"byte", "256"}, {"big16", "-1"}, {"big32", "0x100000000"}, {"little32", ""}, {"big64", "1"}} {
+		if got, err := magicNumber(bad[0], bad[1]); err == nil {
+			t.Errorf("magicNumber(%q, %q) = %x, want an error", bad[0], bad[1], got)
+		}
+	}
+}
+
+func TestUnescapeMagic(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`plain`, "plain"},
+		{`\x7fELF`, "\x7fELF"},
+		{`\x7`, "\x07"},
+		{`\x7g`, "\x07g"},
+		{`\xg`, "xg"},
+		{`\x`, "x"},
+		{`\0`, "\x00"},
+		{`\012x`, "\nx"},
+		{`\0123`, "\n3"},
+		{`\18`, "\x018"},
+		{`\n\r\t`, "\n\r\t"},
+		{`\\`, `\`},
+		{`\ \"`, ` "`},
+		{`a\`, `a\`},
+		{`%PDF-\x`, "%PDF-x"},
+	}
+	for _, tt := range tests {
+		if got := unescapeMagic(tt.in); string(got) != tt.want {
+			t.Errorf("unescapeMagic(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLoadSharedMimeInfo(t *testing.T) {
+	defer func(r *Registry, db *magicDB) { DefaultRegistry, defaultMagic = r, db }(DefaultRegistry, defaultMagic)
+	DefaultRegistry, defaultMagic = newBuiltinRegistry(), newMagicDB(signatures)
+
+	if err := LoadSharedMimeInfo("testdata/shared-mime-info.xml"); err != nil {
+		t.Fatal(err)
+	}
+	if got := TypeByExtension("a.tdoc"); got != "application/x-test-doc" {
+		t.Errorf("TypeByExtension(a.tdoc) = %q", got)
+	}
+	if got := DetectBytes([]byte("TDOC\x01\x00\x01\x02")); got.Type != "application/x-test-doc" || got.Confidence != 0.6 {
+		t.Errorf("DetectBytes(TDOC) = %+v", got)
+	}
+	if got := Ancestors("application/x-testdoc"); !reflect.DeepEqual(got, []string{"application/xml", typeTextPlain, typeOctetStream}) {
+		t.Errorf("Ancestors(application/x-testdoc) = %q", got)
+	}
+	if err := LoadSharedMimeInfo("testdata/missing.xml"); !os.IsNotExist(err) {
+		t.Errorf("LoadSharedMimeInfo(missing) error = %v", err)
+	}
+}
@@ -0,0 +1,411 @@
+//go:build ignore
+// +build ignore
+
+// gen.go generates db.go, the built-in type database, from the snapshots
+// listed in data/sources.txt. Run it with "go generate" or, to download the
+// snapshots that have a URL first, with "go run gen.go -fetch".
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	fetch  = flag.Bool("fetch", false, "download snapshots that have a URL before generating")
+	dir    = flag.String("data", "data", "directory holding sources.txt and the snapshots")
+	output = flag.String("o", "db.go", "output file")
+	l10n   = flag.String("l10n", "db_l10n.go", "output file for translated descriptions")
+)
+
+type source struct {
+	name, format, file, version, url string
+}
+
+type entry struct {
+	typ, source string
+}
+
+// desc holds the descriptive metadata of a type.
+type desc struct {
+	comment, acronym, expanded, icon, genericIcon string
+	comments                                      map[string]string // by language tag
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+	flag.Parse()
+
+	version, sources, err := readManifest(filepath.Join(*dir, "sources.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	exts := make(map[string]entry)
+	descs := make(map[string]desc)
+	registered := make(map[string]bool)
+	var used []source
+	for _, src := range sources {
+		name := filepath.Join(*dir, src.file)
+		if *fetch && src.url != "" {
+			if err := download(src.url, name); err != nil {
+				log.Fatal(err)
+			}
+		}
+		f, err := os.Open(name)
+		if os.IsNotExist(err) && src.url != "" {
+			log.Fatalf("%s: %s not found; run with -fetch to download it", src.name, name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		add := func(ext, typ string) {
+			ext = "." + strings.ToLower(ext)
+			if _, ok := exts[ext]; !ok && validExt(ext) && validType(typ) {
+				exts[ext] = entry{typ: typ, source: src.name}
+			}
+		}
+		switch src.format {
+		case "mime.types":
+			err = readMimeTypes(f, add)
+		case "freedesktop":
+			err = readSharedMimeInfo(f, add, descs)
+		case "iana":
+			err = readIANA(f, registered)
+		default:
+			err = fmt.Errorf("unknown format %q", src.format)
+		}
+		f.Close()
+		if err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		used = append(used, src)
+	}
+
+	src, err := generate(version, used, exts, descs, registered)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	src, err = generateL10n(version, descs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*l10n, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readManifest parses sources.txt: a "version" line followed by one
+// tab-separated line per source. "-" stands for an empty field.
+func readManifest(name string) (string, []source, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+	var version string
+	var sources []source
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		for i, f := range fields {
+			if f == "-" {
+				fields[i] = ""
+			}
+		}
+		switch {
+		case fields[0] == "version" && len(fields) == 2:
+			version = fields[1]
+		case len(fields) == 5:
+			sources = append(sources, source{fields[0], fields[1], fields[2], fields[3], fields[4]})
+		default:
+			return "", nil, fmt.Errorf("%s:%d: malformed line", name, line)
+		}
+	}
+	if version == "" {
+		return "", nil, fmt.Errorf("%s: no version line", name)
+	}
+	return version, sources, sc.Err()
+}
+
+func download(url, name string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(name, data, 0o644)
+}
+
+// readMimeTypes reads the Apache mime.types format: a type followed by its
+// extensions on each line.
+func readMimeTypes(r io.Reader, add func(ext, typ string)) error {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		for _, ext := range fields[1:] {
+			add(ext, fields[0])
+		}
+	}
+	return sc.Err()
+}
+
+// readSharedMimeInfo takes the simple, case-insensitive "*.ext" globs of
+// default weight from a shared-mime-info package, as AddSharedMimeInfo does,
+// and the descriptions and icons of its types, which are also recorded under
+// the aliases that are not types of their own.
+func readSharedMimeInfo(r io.Reader, add func(ext, typ string), descs map[string]desc) error {
+	type text struct {
+		Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+		Text string `xml:",chardata"`
+	}
+	type name struct {
+		Name string `xml:"name,attr"`
+	}
+	type alias struct {
+		Type string `xml:"type,attr"`
+	}
+	var doc struct {
+		Types []struct {
+			Type        string  `xml:"type,attr"`
+			Comments    []text  `xml:"comment"`
+			Acronym     []text  `xml:"acronym"`
+			Expanded    []text  `xml:"expanded-acronym"`
+			Icon        name    `xml:"icon"`
+			GenericIcon name    `xml:"generic-icon"`
+			Aliases     []alias `xml:"alias"`
+			Globs       []struct {
+				Pattern       string `xml:"pattern,attr"`
+				Weight        string `xml:"weight,attr"`
+				CaseSensitive string `xml:"case-sensitive,attr"`
+			} `xml:"glob"`
+		} `xml:"mime-type"`
+	}
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return err
+	}
+	for _, t := range doc.Types {
+		for _, g := range t.Globs {
+			if g.Weight != "" && g.Weight != "50" || g.CaseSensitive == "true" {
+				continue
+			}
+			ext := strings.TrimPrefix(g.Pattern, "*.")
+			if ext == g.Pattern || strings.ContainsAny(ext, "*?[") {
+				continue
+			}
+			add(ext, t.Type)
+		}
+	}
+
+	untranslated := func(texts []text) string {
+		for _, t := range texts {
+			if t.Lang == "" {
+				return strings.TrimSpace(t.Text)
+			}
+		}
+		return ""
+	}
+	aliases := make(map[string]desc)
+	for _, t := range doc.Types {
+		d := desc{
+			comment:     untranslated(t.Comments),
+			acronym:     untranslated(t.Acronym),
+			expanded:    untranslated(t.Expanded),
+			icon:        t.Icon.Name,
+			genericIcon: t.GenericIcon.Name,
+		}
+		typ := strings.ToLower(t.Type)
+		if _, ok := descs[typ]; !ok {
+			// Translations are kept under the type name only.
+			d.comments = make(map[string]string)
+			for _, c := range t.Comments {
+				if c.Lang != "" {
+					d.comments[langTag(c.Lang)] = strings.Join(strings.Fields(c.Text), " ")
+				}
+			}
+			descs[typ] = d
+			d.comments = nil
+		}
+		for _, a := range t.Aliases {
+			aliases[strings.ToLower(a.Type)] = d
+		}
+	}
+	for a, d := range aliases {
+		if _, ok := descs[a]; !ok {
+			descs[a] = d
+		}
+	}
+	return nil
+}
+
+// langTag turns a POSIX locale name as used by shared-mime-info, such as
+// "pt_BR" or "be@latin", into a lower-cased BCP 47 tag. It must agree with
+// normLangTag in l10n.go.
+func langTag(lang string) string {
+	lang = strings.ToLower(lang)
+	lang = strings.ReplaceAll(lang, "@latin", "-latn")
+	return strings.ReplaceAll(lang, "_", "-")
+}
+
+// readIANA reads one of the IANA media type registry CSV files, whose
+// Template column holds the registered type name. registered records
+// whether the registration is obsolete, which the Name column notes.
+func readIANA(r io.Reader, registered map[string]bool) error {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return err
+	}
+	for i, rec := range records {
+		if i == 0 || len(rec) < 2 {
+			continue
+		}
+		if typ := strings.ToLower(strings.TrimSpace(rec[1])); validType(typ) {
+			name := strings.ToUpper(rec[0])
+			registered[typ] = strings.Contains(name, "OBSOLETE") || strings.Contains(name, "DEPRECATED")
+		}
+	}
+	return nil
+}
+
+func validExt(ext string) bool {
+	for i := 1; i < len(ext); i++ {
+		c := ext[i]
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || strings.IndexByte(".+-_~", c) >= 0) {
+			return false
+		}
+	}
+	return len(ext) > 1
+}
+
+func validType(typ string) bool {
+	i := strings.IndexByte(typ, '/')
+	return i > 0 && i < len(typ)-1 && !strings.ContainsAny(typ, " \t;,\"") && strings.Count(typ, "/") == 1
+}
+
+func generate(version string, sources []source, exts map[string]entry, descs map[string]desc, registered map[string]bool) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen.go from the snapshots listed in data/sources.txt; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package mime\n\n")
+	fmt.Fprintf(&b, "const dbVersion = %q\n\n", version)
+
+	fmt.Fprintf(&b, "var dbSources = []DatabaseSource{\n")
+	for _, src := range sources {
+		fmt.Fprintf(&b, "{Name: %q, Version: %q, URL: %q},\n", src.name, src.version, src.url)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	keys := make([]string, 0, len(exts))
+	for ext := range exts {
+		keys = append(keys, ext)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(&b, "var extToMimeType = map[string]string{\n")
+	for _, ext := range keys {
+		fmt.Fprintf(&b, "%q: %q,\n", ext, exts[ext].typ)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// extSource names the source each entry of extToMimeType was taken from.\n")
+	fmt.Fprintf(&b, "var extSource = map[string]string{\n")
+	for _, ext := range keys {
+		fmt.Fprintf(&b, "%q: %q,\n", ext, exts[ext].source)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	types := make([]string, 0, len(descs))
+	for typ := range descs {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	fmt.Fprintf(&b, "var typeDescs = map[string]typeDesc{\n")
+	for _, typ := range types {
+		d := descs[typ]
+		fmt.Fprintf(&b, "%q: {comment: %q", typ, d.comment)
+		for _, f := range []struct{ name, value string }{
+			{"acronym", d.acronym}, {"expanded", d.expanded}, {"icon", d.icon}, {"genericIcon", d.genericIcon},
+		} {
+			if f.value != "" {
+				fmt.Fprintf(&b, ", %s: %q", f.name, f.value)
+			}
+		}
+		fmt.Fprintf(&b, "},\n")
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	types = make([]string, 0, len(registered))
+	for typ := range registered {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	fmt.Fprintf(&b, "// ianaTypes holds the lower-cased names of the types registered with IANA\n")
+	fmt.Fprintf(&b, "// and whether the registration is obsolete.\n")
+	fmt.Fprintf(&b, "var ianaTypes = map[string]bool{\n")
+	for _, typ := range types {
+		fmt.Fprintf(&b, "%s: %t,\n", strconv.Quote(typ), registered[typ])
+	}
+	fmt.Fprintf(&b, "}\n")
+	return format.Source(b.Bytes())
+}
+
+// generateL10n writes the translated descriptions as one string of
+// "type\tlanguage\tdescription" lines, which the package parses on first
+// use rather than at start-up.
+func generateL10n(version string, descs map[string]desc) ([]byte, error) {
+	var lines []string
+	for typ, d := range descs {
+		for lang, text := range d.comments {
+			if strings.ContainsAny(text, "`\t") {
+				return nil, fmt.Errorf("%s: unsupported character in %s description %q", typ, lang, text)
+			}
+			lines = append(lines, typ+"\t"+lang+"\t"+text+"\n")
+		}
+	}
+	sort.Strings(lines)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen.go from the snapshots listed in data/sources.txt; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package mime\n\n")
+	fmt.Fprintf(&b, "// l10nData holds the translated type descriptions of database version %s.\n", version)
+	fmt.Fprintf(&b, "const l10nData = `")
+	for _, line := range lines {
+		b.WriteString(line)
+	}
+	fmt.Fprintf(&b, "`\n")
+	return format.Source(b.Bytes())
+}
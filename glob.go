@@ -0,0 +1,123 @@
+package mime
+
+import (
+	"path"
+	"strings"
+)
+
+// Glob is a file name pattern in the syntax of path.Match, such as
+// "Makefile", "*.[1-9]" or "README*". Weight ranges from 0 to 100 and
+// defaults to 50; higher weights win when several patterns match, and
+// patterns weighted below 50 only apply when the extension table has no
+// entry either. Unless CaseSensitive is set the pattern matches regardless
+// of letter case.
+type Glob struct {
+	Pattern       string
+	Weight        int
+	CaseSensitive bool
+}
+
+const defaultGlobWeight = 50
+
+type globRule struct {
+	Glob
+	typ string
+}
+
+// builtinGlobs covers file names that an extension cannot describe.
+var builtinGlobs = []globRule{
+	{Glob{Pattern: "Makefile", Weight: 50}, "text/x-makefile"},
+	{Glob{Pattern: "GNUmakefile", Weight: 50}, "text/x-makefile"},
+	{Glob{Pattern: "Makefile.*", Weight: 10}, "text/x-makefile"},
+	{Glob{Pattern: "Dockerfile", Weight: 50}, "text/x-dockerfile"},
+	{Glob{Pattern: "Dockerfile.*", Weight: 10}, "text/x-dockerfile"},
+	{Glob{Pattern: "Containerfile", Weight: 50}, "text/x-dockerfile"},
+	{Glob{Pattern: "CMakeLists.txt", Weight: 50}, "text/x-cmake"},
+	{Glob{Pattern: "meson.build", Weight: 50}, "text/x-meson"},
+	{Glob{Pattern: "README*", Weight: 10}, "text/x-readme"},
+	{Glob{Pattern: ".bashrc", Weight: 50}, "application/x-sh"},
+	{Glob{Pattern: ".bash_profile", Weight: 50}, "application/x-sh"},
+	{Glob{Pattern: ".bash_logout", Weight: 50}, "application/x-sh"},
+	{Glob{Pattern: ".profile", Weight: 50}, "application/x-sh"},
+	{Glob{Pattern: ".zshrc", Weight: 50}, "application/x-sh"},
+	{Glob{Pattern: ".cshrc", Weight: 50}, "application/x-csh"},
+	{Glob{Pattern: ".login", Weight: 50}, "application/x-csh"},
+	{Glob{Pattern: "*.[1-9]", Weight: 50}, "application/x-troff-man"},
+	{Glob{Pattern: "*.C", Weight: 50, CaseSensitive: true}, "text/x-c++src"},
+	{Glob{Pattern: "core", Weight: 50, CaseSensitive: true}, "application/x-core"},
+}
+
+// AddGlob adds a file name pattern rule mapping names that match g to the
+// MIME type typ. It returns path.ErrBadPattern when the pattern is malformed.
+func (r *Registry) AddGlob(g Glob, typ string) error {
+	if _, err := path.Match(g.Pattern, ""); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.addGlobLocked(globRule{g, typ})
+	r.mu.Unlock()
+	return nil
+}
+
+// addGlobLocked adds rule, replacing an existing rule for the same pattern
+// and case sensitivity. r.mu must be held.
+func (r *Registry) addGlobLocked(rule globRule) {
+	for i, g := range r.globs {
+		if g.Pattern == rule.Pattern && g.CaseSensitive == rule.CaseSensitive {
+			r.globs[i] = rule
+			return
+		}
+	}
+	r.globs = append(r.globs, rule)
+}
+
+// RemoveGlob deletes the rules for pattern.
+func (r *Registry) RemoveGlob(pattern string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	globs := r.globs[:0]
+	for _, g := range r.globs {
+		if g.Pattern != pattern {
+			globs = append(globs, g)
+		}
+	}
+	r.globs = globs
+}
+
+// matchGlobLocked returns the best rule matching the file name base. Rules
+// are ranked by weight, then case-sensitive before case-insensitive, then
+// literal names before wildcards, then by pattern length. r.mu must be held.
+func (r *Registry) matchGlobLocked(base string) (globRule, bool) {
+	var best globRule
+	found := false
+	lower := strings.ToLower(base)
+	for _, g := range r.globs {
+		var ok bool
+		if g.CaseSensitive {
+			ok, _ = path.Match(g.Pattern, base)
+		} else {
+			ok, _ = path.Match(strings.ToLower(g.Pattern), lower)
+		}
+		if ok && (!found || g.better(best)) {
+			best, found = g, true
+		}
+	}
+	return best, found
+}
+
+func (g globRule) better(h globRule) bool {
+	if g.Weight != h.Weight {
+		return g.Weight > h.Weight
+	}
+	if g.CaseSensitive != h.CaseSensitive {
+		return g.CaseSensitive
+	}
+	if gl, hl := isLiteral(g.Pattern), isLiteral(h.Pattern); gl != hl {
+		return gl
+	}
+	return len(g.Pattern) > len(h.Pattern)
+}
+
+func isLiteral(pattern string) bool {
+	return !strings.ContainsAny(pattern, "*?[\\")
+}
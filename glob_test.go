@@ -0,0 +1,103 @@
+package mime
+
+import (
+	"path"
+	"testing"
+)
+
+func TestGlobRanking(t *testing.T) {
+	r := NewRegistry()
+	r.Add(".conf", "application/x-conf")
+	r.Add(".txt", "application/x-text")
+	globs := []struct {
+		g   Glob
+		typ string
+	}{
+		{Glob{Pattern: "*.log", Weight: 40}, "application/x-log-low"},
+		{Glob{Pattern: "*.log", Weight: 60, CaseSensitive: true}, "application/x-log-high"},
+		{Glob{Pattern: "build.*", Weight: 50}, "application/x-build-wild"},
+		{Glob{Pattern: "build.ninja", Weight: 50}, "application/x-build-literal"},
+		{Glob{Pattern: "*.tar.*", Weight: 50}, "application/x-tar-long"},
+		{Glob{Pattern: "notes.*", Weight: 50}, "application/x-notes"},
+		{Glob{Pattern: "*.c", Weight: 50}, "application/x-c-fold"},
+		{Glob{Pattern: "*.C", Weight: 50, CaseSensitive: true}, "application/x-c-exact"},
+		{Glob{Pattern: "nginx.conf", Weight: 30}, "application/x-nginx"},
+		{Glob{Pattern: "*.weird", Weight: 30}, "application/x-weird"},
+	}
+	for _, g := range globs {
+		if err := r.AddGlob(g.g, g.typ); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name, want string
+	}{
+		{"app.log", "application/x-log-high"},          // weight
+		{"app.LOG", "application/x-log-low"},           // the heavier rule is case-sensitive
+		{"build.ninja", "application/x-build-literal"}, // literal beats wildcard
+		{"build.sh", "application/x-build-wild"},
+		{"a.tar.zst", "application/x-tar-long"}, // longer pattern
+		{"x.C", "application/x-c-exact"},        // case-sensitive first
+		{"x.c", "application/x-c-fold"},
+		{"nginx.conf", "application/x-conf"}, // light glob loses to the table
+		{"NGINX.CONF", "application/x-conf"},
+		{"a.weird", "application/x-weird"},   // light glob when nothing else
+		{"notes.txt", "application/x-notes"}, // weight 50 glob beats the table
+		{"noext", ""},
+	}
+	for _, tt := range tests {
+		if got := r.TypeByExtension(tt.name); got != tt.want {
+			t.Errorf("TypeByExtension(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	r.RemoveGlob("notes.*")
+	if got := r.TypeByExtension("notes.txt"); got != "application/x-text" {
+		t.Errorf("after RemoveGlob(notes.*): TypeByExtension(notes.txt) = %q", got)
+	}
+	r.RemoveGlob("*.log")
+	if got := r.TypeByExtension("app.log"); got != "" {
+		t.Errorf("after RemoveGlob(*.log): TypeByExtension(app.log) = %q, want both rules gone", got)
+	}
+	if err := r.AddGlob(Glob{Pattern: "[a-", Weight: 50}, "application/x-bad"); err != path.ErrBadPattern {
+		t.Errorf("AddGlob(bad pattern) = %v, want %v", err, path.ErrBadPattern)
+	}
+}
+
+func TestAddGlobReplaces(t *testing.T) {
+	r := NewRegistry()
+	r.AddGlob(Glob{Pattern: "Jenkinsfile", Weight: 50}, "application/x-one")
+	r.AddGlob(Glob{Pattern: "Jenkinsfile", Weight: 50}, "application/x-two")
+	r.AddGlob(Glob{Pattern: "Jenkinsfile", Weight: 40, CaseSensitive: true}, "application/x-exact")
+	if got := r.TypeByExtension("jenkinsfile"); got != "application/x-two" {
+		t.Errorf("TypeByExtension(jenkinsfile) = %q", got)
+	}
+	if got := r.TypeByExtension("Jenkinsfile"); got != "application/x-two" {
+		t.Errorf("TypeByExtension(Jenkinsfile) = %q, want the heavier rule", got)
+	}
+}
+
+func TestBuiltinGlobs(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"Makefile", "text/x-makefile"},
+		{"src/Dockerfile", "text/x-dockerfile"},
+		{"ls.1", "application/x-troff-man"},
+		{"main.C", "text/x-c++src"},
+		{"README", "text/x-readme"},
+		{".bashrc", "application/x-sh"},
+		{"core", "application/x-core"},
+	}
+	for _, tt := range tests {
+		if got := baseType(TypeByExtension(tt.name)); got != tt.want {
+			t.Errorf("TypeByExtension(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if got := baseType(TypeByExtension("README.md")); got == "text/x-readme" {
+		t.Errorf("the light README* glob overrode the .md extension")
+	}
+	if got := TypeByExtension("Core"); baseType(got) == "application/x-core" {
+		t.Errorf("case-sensitive core matched Core")
+	}
+}
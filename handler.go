@@ -0,0 +1,209 @@
+package mime
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ContentTypeHandler wraps an http.Handler such as http.FileServer and fills
+// in the Content-Type of its responses from this package's tables rather
+// than the standard library's. It also sets "X-Content-Type-Options: nosniff"
+// so browsers trust the type they are given.
+//
+// The type is chosen, in order, from the longest matching entry of
+// Overrides, from Registry by the request path, and finally by sniffing the
+// first bytes the wrapped handler writes. A partial response (206) is only
+// sniffed when its Content-Range starts at the beginning of the resource. A
+// HEAD request gets the type sniffed from the start of the body the wrapped
+// handler sends for a GET request to the same path. Other responses without
+// body bytes to sniff are served as application/octet-stream. A Content-Type
+// the wrapped handler sets itself is left alone when the path has no known
+// type.
+type ContentTypeHandler struct {
+	Handler http.Handler
+
+	// Registry resolves request paths. If nil, DefaultRegistry is used.
+	Registry *Registry
+
+	// Overrides maps URL path prefixes to the Content-Type served for every
+	// path below them.
+	Overrides map[string]string
+}
+
+// NewContentTypeHandler returns a ContentTypeHandler wrapping h that uses
+// DefaultRegistry.
+func NewContentTypeHandler(h http.Handler) *ContentTypeHandler {
+	return &ContentTypeHandler{Handler: h}
+}
+
+func (h *ContentTypeHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if typ := h.typeFor(req.URL.Path); typ != "" {
+		w.Header().Set("Content-Type", typ)
+		h.Handler.ServeHTTP(w, req)
+		return
+	}
+
+	// A nil value keeps http.ServeContent and the server from sniffing the
+	// body themselves; sniffWriter fills it in on the first write.
+	w.Header()["Content-Type"] = nil
+	sw := &sniffWriter{ResponseWriter: w, registry: h.registry()}
+	if req.Method == http.MethodHead {
+		sw.headType = h.probe(req)
+	}
+	h.Handler.ServeHTTP(sw, req)
+	sw.finish()
+}
+
+// typeFor returns the type configured for or associated with urlPath.
+func (h *ContentTypeHandler) typeFor(urlPath string) string {
+	best := -1
+	var typ string
+	for prefix, t := range h.Overrides {
+		if strings.HasPrefix(urlPath, prefix) && len(prefix) > best {
+			best, typ = len(prefix), t
+		}
+	}
+	if best >= 0 {
+		return typ
+	}
+	if strings.HasSuffix(urlPath, "/") {
+		return ""
+	}
+	return h.registry().TypeByExtension(path.Base(urlPath))
+}
+
+// probe returns the type sniffed from the beginning of the body the wrapped
+// handler sends for a GET request to the resource of the HEAD request req,
+// or "" if there is nothing to sniff or the handler sets a type itself. Only
+// as many bytes as sniffing needs are requested.
+func (h *ContentTypeHandler) probe(req *http.Request) string {
+	n := defaultMagic.readLen()
+	get := req.Clone(req.Context())
+	get.Method = http.MethodGet
+	get.Header.Set("Range", "bytes=0-"+strconv.Itoa(n-1))
+	get.Header.Del("If-Range")
+	pw := &probeWriter{header: http.Header{"Content-Type": nil}, limit: n}
+	h.Handler.ServeHTTP(pw, get)
+	if len(pw.header["Content-Type"]) != 0 || len(pw.body) == 0 || !fromStart(pw.status, pw.header) {
+		return ""
+	}
+	return h.registry().DetectContentType(pw.body)
+}
+
+// fromStart reports whether a response with the given status and header
+// carries the resource from its first byte.
+func fromStart(status int, hdr http.Header) bool {
+	switch status {
+	case http.StatusOK:
+		return true
+	case http.StatusPartialContent:
+		return strings.HasPrefix(hdr.Get("Content-Range"), "bytes 0-")
+	}
+	return false
+}
+
+func (h *ContentTypeHandler) registry() *Registry {
+	if h.Registry == nil {
+		return DefaultRegistry
+	}
+	return h.Registry
+}
+
+// sniffWriter holds back the response header until the first body bytes
+// are available, so that the Content-Type can be detected from them.
+type sniffWriter struct {
+	http.ResponseWriter
+	registry    *Registry
+	headType    string // type probed for a HEAD request
+	status      int
+	wroteHeader bool
+}
+
+func (w *sniffWriter) WriteHeader(code int) {
+	if w.wroteHeader || w.status != 0 {
+		return
+	}
+	w.status = code
+}
+
+func (w *sniffWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.sniff(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Flush implements http.Flusher when the underlying writer does.
+func (w *sniffWriter) Flush() {
+	if !w.wroteHeader {
+		w.sniff(nil)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// sniff sets the Content-Type from p unless the wrapped handler chose one,
+// then sends the header.
+func (w *sniffWriter) sniff(p []byte) {
+	hdr := w.ResponseWriter.Header()
+	if len(hdr["Content-Type"]) == 0 {
+		switch {
+		case w.status == http.StatusNoContent || w.status == http.StatusNotModified:
+			delete(hdr, "Content-Type")
+		case len(p) == 0 && w.headType != "":
+			hdr.Set("Content-Type", w.headType)
+		case len(p) == 0 || w.status == http.StatusPartialContent && !fromStart(w.status, hdr):
+			hdr.Set("Content-Type", typeOctetStream)
+		default:
+			hdr.Set("Content-Type", w.registry.DetectContentType(p))
+		}
+	}
+	w.flushHeader()
+}
+
+func (w *sniffWriter) flushHeader() {
+	w.wroteHeader = true
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+}
+
+// finish sends a header that is still held back because the handler wrote
+// no body.
+func (w *sniffWriter) finish() {
+	if !w.wroteHeader {
+		w.sniff(nil)
+	}
+}
+
+// probeWriter records the status, header and first limit body bytes of a
+// response and discards the rest.
+type probeWriter struct {
+	header http.Header
+	status int
+	body   []byte
+	limit  int
+}
+
+func (w *probeWriter) Header() http.Header { return w.header }
+
+func (w *probeWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+}
+
+func (w *probeWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	if n := w.limit - len(w.body); n > 0 {
+		if n > len(p) {
+			n = len(p)
+		}
+		w.body = append(w.body, p[:n]...)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,113 @@
+package mime
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestContentTypeHandler(t *testing.T) {
+	pdf := "%PDF-1.7\n" + strings.Repeat("hello world\n", 40)
+	fsys := fstest.MapFS{
+		"doc":        {Data: []byte(pdf)},
+		"page.html":  {Data: []byte("<p>hi</p>")},
+		"notes":      {Data: []byte("just some notes\n")},
+		"dir/x.json": {Data: []byte("{}")},
+	}
+	h := NewContentTypeHandler(http.FileServer(http.FS(fsys)))
+	h.Overrides = map[string]string{"/dir/": "text/plain; charset=utf-8"}
+
+	tests := []struct {
+		method, path, rng string
+		status            int
+		want              string
+	}{
+		{"GET", "/doc", "", http.StatusOK, "application/pdf"},
+		{"GET", "/notes", "", http.StatusOK, "text/plain; charset=utf-8"},
+		{"GET", "/page.html", "", http.StatusOK, "text/html; charset=utf-8"},
+		{"GET", "/dir/x.json", "", http.StatusOK, "text/plain; charset=utf-8"},
+		{"GET", "/doc", "bytes=100-200", http.StatusPartialContent, "application/octet-stream"},
+		{"GET", "/doc", "bytes=0-99", http.StatusPartialContent, "application/pdf"},
+		{"GET", "/doc", "bytes=0-", http.StatusPartialContent, "application/pdf"},
+		{"HEAD", "/doc", "", http.StatusOK, "application/pdf"},
+		{"HEAD", "/notes", "", http.StatusOK, "text/plain; charset=utf-8"},
+		{"HEAD", "/doc", "bytes=0-99", http.StatusPartialContent, "application/pdf"},
+		{"HEAD", "/page.html", "", http.StatusOK, "text/html; charset=utf-8"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		if tt.rng != "" {
+			req.Header.Set("Range", tt.rng)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != tt.status {
+			t.Errorf("%s %s (Range %q): status %d, want %d", tt.method, tt.path, tt.rng, rec.Code, tt.status)
+		}
+		if got := rec.Header().Get("Content-Type"); got != tt.want {
+			t.Errorf("%s %s (Range %q): Content-Type %q, want %q", tt.method, tt.path, tt.rng, got, tt.want)
+		}
+		if got := rec.Header().Get("X-Content-Type-Options"); got != "nosniff" {
+			t.Errorf("%s %s: X-Content-Type-Options %q", tt.method, tt.path, got)
+		}
+	}
+}
+
+func TestContentTypeHandlerNotModified(t *testing.T) {
+	h := NewContentTypeHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/doc", nil))
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("status %d, want %d", rec.Code, http.StatusNotModified)
+	}
+	if got, ok := rec.Header()["Content-Type"]; ok {
+		t.Errorf("Content-Type %q on a 304 response", got)
+	}
+}
+
+// TestContentTypeHandlerHead checks that HEAD gets the type GET would, also
+// from handlers that ignore Range and from ones that set a type themselves.
+func TestContentTypeHandlerHead(t *testing.T) {
+	png := "\x89PNG\r\n\x1A\n" + strings.Repeat("\x00", 5000)
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    string
+	}{
+		{"full body", func(w http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodGet {
+				w.Write([]byte(png))
+			}
+		}, "image/png"},
+		{"own type", func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "image/x-custom")
+			if req.Method == http.MethodGet {
+				w.Write([]byte(png))
+			}
+		}, "image/x-custom"},
+		{"later range", func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Range", "bytes 100-199/5008")
+			w.WriteHeader(http.StatusPartialContent)
+			if req.Method == http.MethodGet {
+				w.Write([]byte(png[100:200]))
+			}
+		}, "application/octet-stream"},
+		{"not found", func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}, "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		h := NewContentTypeHandler(tt.handler)
+		for _, method := range []string{"GET", "HEAD"} {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(method, "/resource", nil))
+			if got := rec.Header().Get("Content-Type"); got != tt.want {
+				t.Errorf("%s: %s Content-Type %q, want %q", tt.name, method, got, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package mime
+
+import "strings"
+
+const (
+	typeOctetStream = "application/octet-stream"
+	typeTextPlain   = "text/plain"
+)
+
+// suffixParents maps structured syntax suffixes (RFC 6839) to the type every
+// type with that suffix is a kind of.
+var suffixParents = map[string]string{
+	"+xml":  "application/xml",
+	"+json": "application/json",
+	"+zip":  "application/zip",
+	"+gzip": "application/x-gzip",
+}
+
+// builtinParents holds the sub-class relations for the entries of
+// extToMimeType that neither the structured syntax suffix nor the text/
+// top-level type imply.
+var builtinParents = map[string][]string{
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.template":      {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.presentationml.template":     {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.presentationml.slideshow":    {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.presentationml.slide":        {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.template":   {"application/zip"},
+	"application/vnd.ms-excel.addin.macroenabled.12":                            {"application/zip"},
+	"application/vnd.ms-excel.sheet.binary.macroenabled.12":                     {"application/zip"},
+	"application/vnd.ms-excel.sheet.macroenabled.12":                            {"application/zip"},
+	"application/vnd.ms-excel.template.macroenabled.12":                         {"application/zip"},
+	"application/vnd.ms-word.document.macroenabled.12":                          {"application/zip"},
+	"application/vnd.ms-word.template.macroenabled.12":                          {"application/zip"},
+	"application/vnd.ms-powerpoint.presentation.macroenabled.12":                {"application/zip"},
+	"application/vnd.ms-powerpoint.template.macroenabled.12":                    {"application/zip"},
+	"application/vnd.ms-powerpoint.slideshow.macroenabled.12":                   {"application/zip"},
+	"application/vnd.ms-powerpoint.addin.macroenabled.12":                       {"application/zip"},
+	"application/vnd.oasis.opendocument.chart":                                  {"application/zip"},
+	"application/vnd.oasis.opendocument.database":                               {"application/zip"},
+	"application/vnd.oasis.opendocument.formula":                                {"application/zip"},
+	"application/vnd.oasis.opendocument.graphics":                               {"application/zip"},
+	"application/vnd.oasis.opendocument.graphics-template":                      {"application/vnd.oasis.opendocument.graphics"},
+	"application/vnd.oasis.opendocument.image":                                  {"application/zip"},
+	"application/vnd.oasis.opendocument.presentation":                           {"application/zip"},
+	"application/vnd.oasis.opendocument.presentation-template":                  {"application/vnd.oasis.opendocument.presentation"},
+	"application/vnd.oasis.opendocument.spreadsheet":                            {"application/zip"},
+	"application/vnd.oasis.opendocument.spreadsheet-template":                   {"application/vnd.oasis.opendocument.spreadsheet"},
+	"application/vnd.oasis.opendocument.text":                                   {"application/zip"},
+	"application/vnd.oasis.opendocument.text-master":                            {"application/vnd.oasis.opendocument.text"},
+	"application/vnd.oasis.opendocument.text-template":                          {"application/vnd.oasis.opendocument.text"},
+	"application/vnd.oasis.opendocument.text-web":                               {"application/vnd.oasis.opendocument.text"},
+	"application/vnd.sun.xml.writer":                                            {"application/zip"},
+	"application/vnd.sun.xml.writer.template":                                   {"application/vnd.sun.xml.writer"},
+	"application/vnd.sun.xml.writer.global":                                     {"application/vnd.sun.xml.writer"},
+	"application/vnd.sun.xml.calc":                                              {"application/zip"},
+	"application/vnd.sun.xml.calc.template":                                     {"application/vnd.sun.xml.calc"},
+	"application/vnd.sun.xml.draw":                                              {"application/zip"},
+	"application/vnd.sun.xml.draw.template":                                     {"application/vnd.sun.xml.draw"},
+	"application/vnd.sun.xml.impress":                                           {"application/zip"},
+	"application/vnd.sun.xml.impress.template":                                  {"application/vnd.sun.xml.impress"},
+	"application/vnd.sun.xml.math":                                              {"application/zip"},
+	"application/vnd.android.package-archive":                                   {"application/java-archive"},
+	"application/java-archive":                                                  {"application/zip"},
+	"application/vnd.mozilla.xul+xml":                                           {"application/xml"},
+	"application/x-xpinstall":                                                   {"application/zip"},
+	"application/vnd.google-earth.kmz":                                          {"application/zip"},
+	"application/msword":                                                        {"application/x-ole-storage"},
+	"application/vnd.ms-excel":                                                  {"application/x-ole-storage"},
+	"application/vnd.ms-powerpoint":                                             {"application/x-ole-storage"},
+	"application/vnd.ms-project":                                                {"application/x-ole-storage"},
+	"application/vnd.ms-outlook":                                                {"application/x-ole-storage"},
+	"application/x-msaccess":                                                    {"application/x-ole-storage"},
+	"application/xml":                                                           {typeTextPlain},
+	"application/xml-dtd":                                                       {typeTextPlain},
+	"application/json":                                                          {typeTextPlain},
+	"application/javascript":                                                    {typeTextPlain},
+	"application/x-javascript":                                                  {typeTextPlain},
+	"application/x-sh":                                                          {typeTextPlain},
+	"application/x-csh":                                                         {typeTextPlain},
+	"application/x-tcl":                                                         {typeTextPlain},
+	"application/x-perl":                                                        {typeTextPlain},
+	"application/x-tex":                                                         {typeTextPlain},
+	"application/x-latex":                                                       {"application/x-tex"},
+	"application/x-texinfo":                                                     {typeTextPlain},
+	"application/x-troff":                                                       {typeTextPlain},
+	"application/x-troff-man":                                                   {"application/x-troff"},
+	"application/x-troff-me":                                                    {"application/x-troff"},
+	"application/x-troff-ms":                                                    {"application/x-troff"},
+	"application/postscript":                                                    {typeTextPlain},
+	"application/rtf":                                                           {typeTextPlain},
+	"application/x-gtar":                                                        {"application/x-tar"},
+	"application/x-ustar":                                                       {"application/x-tar"},
+	"application/x-compressed":                                                  {"application/x-gzip"},
+	"application/x-compressed-tar":                                              {"application/x-gzip"},
+	"application/x-bzip2-compressed-tar":                                        {"application/x-bzip2"},
+	"application/x-xz-compressed-tar":                                           {"application/x-xz"},
+	"application/x-tarz":                                                        {"application/x-compress"},
+	"text/html":                                                                 {typeTextPlain},
+	"image/x-portable-bitmap":                                                   {"image/x-portable-anymap"},
+	"image/x-portable-graymap":                                                  {"image/x-portable-anymap"},
+	"image/x-portable-pixmap":                                                   {"image/x-portable-anymap"},
+	"audio/mp4a-latm":                                                           {"video/mp4"},
+	"video/x-m4v":                                                               {"video/mp4"},
+	"application/x-ms-wsf":                                                      {"application/xml"},
+	"video/3gpp":                                                                {"video/mp4"},
+	"video/ogg":                                                                 {"application/ogg"},
+	"video/x-ms-wmv":                                                            {"video/x-ms-asf"},
+	"audio/x-ms-wma":                                                            {"video/x-ms-asf"},
+}
+
+// Parents returns the direct parents of typ: the relations recorded in r,
+// the type implied by a structured syntax suffix such as "+xml", and
+// text/plain for other text/ types. Parameters are ignored and all names are
+// resolved to their canonical form.
+func (r *Registry) Parents(typ string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	typ = r.canonicalLocked(baseType(typ))
+	parents := append([]string(nil), r.parents[typ]...)
+
+	add := func(p string) {
+		p = r.canonicalLocked(p)
+		if p == typ {
+			return
+		}
+		for _, q := range parents {
+			if q == p {
+				return
+			}
+		}
+		parents = append(parents, p)
+	}
+	if i := strings.LastIndexByte(typ, '+'); i >= 0 {
+		if p, ok := suffixParents[typ[i:]]; ok {
+			add(p)
+		}
+	}
+	if len(parents) == 0 && strings.HasPrefix(typ, "text/") {
+		add(typeTextPlain)
+	}
+	return parents
+}
+
+// Ancestors returns every type typ is a kind of, nearest first. Every type
+// other than application/octet-stream itself ends with
+// application/octet-stream, the root of the hierarchy.
+func (r *Registry) Ancestors(typ string) []string {
+	typ = r.canonical(baseType(typ))
+	seen := map[string]bool{typ: true}
+	var out []string
+	queue := []string{typ}
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+		for _, p := range r.Parents(t) {
+			if seen[p] || p == typeOctetStream {
+				continue
+			}
+			seen[p] = true
+			out = append(out, p)
+			queue = append(queue, p)
+		}
+	}
+	if typ != typeOctetStream {
+		out = append(out, typeOctetStream)
+	}
+	return out
+}
+
+// IsSubtypeOf reports whether child is parent or a kind of parent, for
+// instance a .docx document a kind of application/zip or image/svg+xml a kind
+// of application/xml. Aliases of either type are treated as the type itself.
+func (r *Registry) IsSubtypeOf(child, parent string) bool {
+	child, parent = r.canonical(baseType(child)), r.canonical(baseType(parent))
+	if child == parent {
+		return true
+	}
+	for _, a := range r.Ancestors(child) {
+		if a == parent {
+			return true
+		}
+	}
+	return false
+}
+
+// Ancestors returns the ancestors of typ in DefaultRegistry.
+func Ancestors(typ string) []string {
+	return DefaultRegistry.Ancestors(typ)
+}
+
+// IsSubtypeOf reports whether child is a kind of parent in DefaultRegistry.
+func IsSubtypeOf(child, parent string) bool {
+	return DefaultRegistry.IsSubtypeOf(child, parent)
+}
+
+// baseType lower-cases typ and strips any parameters.
+func baseType(typ string) string {
+	if i := strings.IndexByte(typ, ';'); i >= 0 {
+		typ = typ[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(typ))
+}
@@ -0,0 +1,46 @@
+package mime
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAncestors(t *testing.T) {
+	tests := []struct {
+		typ  string
+		want []string
+	}{
+		{"application/vnd.openxmlformats-officedocument.wordprocessingml.document", []string{"application/zip", typeOctetStream}},
+		{"image/svg+xml; charset=utf-8", []string{"application/xml", typeTextPlain, typeOctetStream}},
+		{"application/vnd.oasis.opendocument.text-template", []string{"application/vnd.oasis.opendocument.text", "application/zip", typeOctetStream}},
+		{"text/csv", []string{typeTextPlain, typeOctetStream}},
+		{"text/xml", []string{typeTextPlain, typeOctetStream}},
+		{typeOctetStream, nil},
+	}
+	for _, tt := range tests {
+		if got := Ancestors(tt.typ); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Ancestors(%q) = %q, want %q", tt.typ, got, tt.want)
+		}
+	}
+}
+
+func TestIsSubtypeOf(t *testing.T) {
+	tests := []struct {
+		child, parent string
+		want          bool
+	}{
+		{"application/vnd.openxmlformats-officedocument.wordprocessingml.document", "application/zip", true},
+		{"image/svg+xml", "application/xml", true},
+		{"image/svg+xml", "text/plain", true},
+		{"text/xml", "application/xml", true},
+		{"image/png", "image/png", true},
+		{"image/png", typeOctetStream, true},
+		{"application/zip", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", false},
+		{"image/png", "text/plain", false},
+	}
+	for _, tt := range tests {
+		if got := IsSubtypeOf(tt.child, tt.parent); got != tt.want {
+			t.Errorf("IsSubtypeOf(%q, %q) = %v, want %v", tt.child, tt.parent, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package mime
+
+import "strings"
+
+// typeDesc is the descriptive metadata recorded for a type.
+type typeDesc struct {
+	comment     string
+	acronym     string
+	expanded    string // expanded acronym
+	icon        string
+	genericIcon string
+	comments    map[string]string // translations by normalized language tag
+}
+
+// Registration tells whether a type is registered with IANA.
+type Registration int
+
+const (
+	// RegistrationUnknown means the built-in database has no IANA data to
+	// decide on the type.
+	RegistrationUnknown Registration = iota
+	// Registered types are listed in the IANA media types registry.
+	Registered
+	// Obsolete types are registered but marked obsolete or deprecated.
+	Obsolete
+	// Unregistered types use an "x-" name or are absent from the IANA
+	// registry.
+	Unregistered
+)
+
+func (s Registration) String() string {
+	switch s {
+	case Registered:
+		return "registered"
+	case Obsolete:
+		return "obsolete"
+	case Unregistered:
+		return "unregistered"
+	}
+	return "unknown"
+}
+
+// Metadata describes a type for display, as returned by Info.
+type Metadata struct {
+	Type         string // canonical name
+	Description  string // such as "Excel 2007 binary spreadsheet"
+	Acronym      string // such as "PDF"; "" if none
+	Icon         string // icon name from the freedesktop Icon Naming Specification
+	GenericIcon  string // fallback icon name, such as "x-office-spreadsheet"
+	Registration Registration
+	Vendor       bool   // in the vendor tree ("vnd.")
+	Extension    string // preferred extension, with the leading dot
+}
+
+// Info returns the metadata r has for typ. Descriptions and icons come from
+// the shared-mime-info data built into the package or added with
+// AddSharedMimeInfo; the extension from the same tables as ExtensionsByType.
+// Icons default as the shared-mime-info specification describes: the type
+// name with "/" replaced by "-", and "<media>-x-generic". The result is false
+// if typ is not a valid media type or r knows neither a description nor an
+// extension for it.
+func (r *Registry) Info(typ string) (Metadata, bool) {
+	m, err := Parse(baseType(typ))
+	if err != nil || m.Type == "*" || m.Subtype == "*" {
+		return Metadata{}, false
+	}
+	info := Metadata{Type: r.canonical(m.Essence())}
+	if canon, err := Parse(info.Type); err == nil {
+		info.Registration = registration(canon)
+		info.Vendor = canon.Tree == "vnd"
+	}
+	if exts, err := r.ExtensionsByType(info.Type); err == nil && len(exts) > 0 {
+		info.Extension = exts[0]
+	}
+	d, ok := r.desc(m.Essence())
+	if !ok && info.Extension == "" {
+		return Metadata{}, false
+	}
+	info.Description = d.comment
+	if d.expanded != "" && info.Description == "" {
+		info.Description = d.expanded
+	}
+	info.Acronym = d.acronym
+	info.Icon = d.icon
+	if info.Icon == "" {
+		info.Icon = strings.ReplaceAll(info.Type, "/", "-")
+	}
+	info.GenericIcon = d.genericIcon
+	if info.GenericIcon == "" {
+		info.GenericIcon = info.Type[:strings.IndexByte(info.Type, '/')] + "-x-generic"
+	}
+	return info, true
+}
+
+// Info returns the metadata DefaultRegistry has for typ.
+func Info(typ string) (Metadata, bool) {
+	return DefaultRegistry.Info(typ)
+}
+
+// desc looks typ up in r's descriptions, trying its canonical name and that
+// name's aliases after typ itself.
+func (r *Registry) desc(typ string) (typeDesc, bool) {
+	names := r.descNames(typ)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, name := range names {
+		if d, ok := r.descs[name]; ok {
+			return d, true
+		}
+	}
+	return typeDesc{}, false
+}
+
+// registration classifies m by the IANA registry snapshot built into the
+// package and, lacking one, by its name.
+func registration(m MediaType) Registration {
+	if strings.HasPrefix(m.Type, "x-") || strings.HasPrefix(m.Subtype, "x-") || m.Tree == "x" {
+		return Unregistered
+	}
+	obsolete, ok := ianaTypes[m.Essence()]
+	switch {
+	case ok && obsolete:
+		return Obsolete
+	case ok:
+		return Registered
+	case len(ianaTypes) > 0:
+		return Unregistered
+	}
+	return RegistrationUnknown
+}
@@ -0,0 +1,39 @@
+package mime
+
+import "testing"
+
+func TestInfoRegistration(t *testing.T) {
+	tests := []struct {
+		typ  string
+		want Registration
+	}{
+		{"application/pdf", Registered},
+		{"image/png", Registered},
+		{"APPLICATION/PDF; charset=binary", Registered},
+		{"application/vnd.ms-excel.sheet.binary.macroEnabled.12", Registered},
+		{"application/font-woff", Obsolete},
+		{"application/vnd.geo+json", Obsolete},
+		{"application/x-tar", Unregistered},
+		{"text/x-c", Unregistered},
+	}
+	for _, tt := range tests {
+		info, ok := Info(tt.typ)
+		if !ok {
+			t.Errorf("Info(%q): not found", tt.typ)
+			continue
+		}
+		if info.Registration != tt.want {
+			t.Errorf("Info(%q).Registration = %v, want %v", tt.typ, info.Registration, tt.want)
+		}
+	}
+}
+
+func TestInfoPDF(t *testing.T) {
+	info, ok := Info("application/pdf")
+	if !ok {
+		t.Fatal("Info(application/pdf): not found")
+	}
+	if info.Extension != ".pdf" || info.Acronym != "PDF" || info.Vendor {
+		t.Errorf("Info(application/pdf) = %+v", info)
+	}
+}
@@ -0,0 +1,287 @@
+package mime
+
+import (
+	"sort"
+	"strings"
+)
+
+// TypeKind is a coarse category of file types, for filters and statistics.
+type TypeKind string
+
+const (
+	KindDocument     TypeKind = "document"
+	KindSpreadsheet  TypeKind = "spreadsheet"
+	KindPresentation TypeKind = "presentation"
+	KindImage        TypeKind = "image"
+	KindAudio        TypeKind = "audio"
+	KindVideo        TypeKind = "video"
+	KindArchive      TypeKind = "archive"
+	KindCode         TypeKind = "code"
+	KindExecutable   TypeKind = "executable"
+	KindFont         TypeKind = "font"
+	KindText         TypeKind = "text"
+	KindModel        TypeKind = "model" // 3D models and scenes
+	KindOther        TypeKind = "other"
+)
+
+// builtinKinds classifies the types whose kind neither their flags, their
+// shared-mime-info icon, their ancestors nor their top-level type give
+// correctly. Archives and executables are classified by their flags; an
+// entry here overrides those, as for shell scripts, which are code.
+var builtinKinds = map[string]TypeKind{
+	"application/pdf":                     KindDocument,
+	"application/postscript":              KindDocument,
+	"application/x-dvi":                   KindDocument,
+	"text/rtf":                            KindDocument,
+	"text/richtext":                       KindDocument,
+	"text/html":                           KindDocument,
+	"application/xhtml+xml":               KindDocument,
+	"application/epub+zip":                KindDocument,
+	"application/vnd.fujixerox.docuworks": KindDocument,
+	"application/x-mswrite":               KindDocument,
+	"application/x-mspublisher":           KindDocument,
+	"application/x-maker":                 KindDocument,
+	"application/vnd.mif":                 KindDocument,
+	"application/x-mif":                   KindDocument,
+	"application/x-tex":                   KindDocument,
+	"application/x-texinfo":               KindDocument,
+	"text/troff":                          KindDocument,
+	"image/vnd.djvu":                      KindDocument,
+	"message/rfc822":                      KindDocument,
+	"application/envoy":                   KindDocument,
+	"application/e-score":                 KindDocument,
+	"application/x-expandedbook":          KindDocument,
+	"application/x-prc":                   KindDocument,
+	"application/x-pmd":                   KindDocument,
+	"application/winhlp":                  KindDocument,
+	"application/x-msmediaview":           KindDocument,
+	"application/x-mscardfile":            KindDocument,
+	"application/vnd.wap.wmlc":            KindDocument,
+
+	"application/vnd.ms-excel":  KindSpreadsheet,
+	"application/x-excel":       KindSpreadsheet,
+	"application/x-kspread":     KindSpreadsheet,
+	"workbook/formulaone":       KindSpreadsheet,
+	"text/csv":                  KindSpreadsheet,
+	"text/tab-separated-values": KindSpreadsheet,
+
+	"application/vnd.ms-powerpoint": KindPresentation,
+	"application/astound":           KindPresentation,
+	"application/hyperstudio":       KindPresentation,
+	"application/x-supercard":       KindPresentation,
+	"application/x-authoware-bin":   KindPresentation,
+	"application/x-authoware-map":   KindPresentation,
+	"application/x-authoware-seg":   KindPresentation,
+
+	"application/x-yz1":    KindArchive,
+	"application/x-ms-wmd": KindArchive,
+	"application/x-ms-wmz": KindArchive,
+
+	"application/wasm":                   KindExecutable,
+	"application/vnd.mophun.application": KindExecutable,
+
+	"text/javascript":    KindCode,
+	"application/json":   KindCode,
+	"application/xml":    KindCode,
+	"text/css":           KindCode,
+	"application/x-sh":   KindCode,
+	"application/x-csh":  KindCode,
+	"application/x-perl": KindCode,
+	"application/x-tcl":  KindCode,
+	"application/sql":    KindCode,
+
+	"application/x-ns-proxy-autoconfig": KindCode,
+
+	"text/plain":                    KindText,
+	"application/x-chat":            KindText,
+	"application/x-internet-signup": KindText,
+
+	"image/svg+xml":         KindImage,
+	"application/fractals":  KindImage,
+	"application/x-ipix":    KindImage,
+	"application/x-sprite":  KindImage,
+	"application/x-netfpx":  KindImage,
+	"application/vnd.xara":  KindImage,
+	"application/x-autocad": KindImage,
+
+	"application/dsptype":             KindAudio,
+	"application/listenup":            KindAudio,
+	"application/vocaltec-media-file": KindAudio,
+	"application/x-koan":              KindAudio,
+	"application/x-yumekara":          KindAudio,
+
+	"application/ogg":                   KindVideo,
+	"application/vnd.rn-realmedia":      KindVideo,
+	"application/vnd.adobe.flash.movie": KindVideo,
+	"application/x-futuresplash":        KindVideo,
+	"application/x-director":            KindVideo,
+	"application/vnd.rn-realplayer":     KindVideo,
+	"application/x-mpeg":                KindVideo,
+
+	"model/vrml":                  KindModel,
+	"x-world/x-vrml":              KindModel,
+	"application/metastream":      KindModel,
+	"application/x-cult3d-object": KindModel,
+}
+
+// iconKinds maps the generic icons of the freedesktop Icon Naming
+// Specification, as shared-mime-info assigns them, to kinds.
+var iconKinds = map[string]TypeKind{
+	"x-office-document":        KindDocument,
+	"x-office-spreadsheet":     KindSpreadsheet,
+	"x-office-presentation":    KindPresentation,
+	"image-x-generic":          KindImage,
+	"audio-x-generic":          KindAudio,
+	"video-x-generic":          KindVideo,
+	"package-x-generic":        KindArchive,
+	"text-x-script":            KindCode,
+	"application-x-executable": KindExecutable,
+	"font-x-generic":           KindFont,
+	"text-html":                KindDocument,
+	"text-x-generic":           KindText,
+	"text-x-generic-template":  KindText,
+}
+
+// kindWords maps words found in type descriptions and names to kinds. The
+// first match wins, so that a "disk image" is an archive.
+var kindWords = []struct {
+	word string
+	kind TypeKind
+}{
+	{"spreadsheet", KindSpreadsheet},
+	{"presentation", KindPresentation},
+	{"slideshow", KindPresentation},
+	{"disk image", KindArchive},
+	{"disc image", KindArchive},
+	{"cd image", KindArchive},
+	{"archive", KindArchive},
+	{"compressed", KindArchive},
+	{"zip", KindArchive},
+	{"font", KindFont},
+	{"installer", KindExecutable},
+	{"executable", KindExecutable},
+	{"source code", KindCode},
+	{"script", KindCode},
+	{"video", KindVideo},
+	{"movie", KindVideo},
+	{"audio", KindAudio},
+	{"sound", KindAudio},
+	{"music", KindAudio},
+	{"image", KindImage},
+	{"picture", KindImage},
+	{"drawing", KindImage},
+	{"document", KindDocument},
+	{"e-book", KindDocument},
+	{"ebook", KindDocument},
+}
+
+// topLevelKinds maps top-level types to the kind of types under them that
+// nothing else classifies.
+var topLevelKinds = map[string]TypeKind{
+	"image": KindImage,
+	"audio": KindAudio,
+	"video": KindVideo,
+	"font":  KindFont,
+	"model": KindModel,
+	"text":  KindText,
+
+	"message":  KindDocument,
+	"x-world":  KindModel,
+	"chemical": KindModel, // molecular structures
+}
+
+// Kind returns the kind of typ. It is taken, in order, from the package's
+// table of kinds, the Executable flag, the generic icon or the wording of
+// the description of the type's shared-mime-info data, the Archive flag, the
+// nearest ancestor with a kind, the words making up the subtype, and the
+// top-level type; types with none of these are KindOther. Flags are those of
+// TypeFlags, so that a type flagged Executable or Archive is of that kind
+// unless the table or, for archives, its description says otherwise, as
+// for a .docx document. Parameters and aliases are resolved as by
+// IsSubtypeOf.
+func (r *Registry) Kind(typ string) TypeKind {
+	typ = r.canonical(baseType(typ))
+	if k, ok := builtinKinds[typ]; ok {
+		return k
+	}
+	flags := r.TypeFlags(typ)
+	if flags&Executable != 0 {
+		return KindExecutable
+	}
+	if k, ok := r.descKind(typ); ok {
+		return k
+	}
+	if flags&Archive != 0 {
+		return KindArchive
+	}
+	for _, a := range r.Ancestors(typ) {
+		if a == typeOctetStream {
+			continue
+		}
+		if k, ok := r.ownKind(a); ok {
+			return k
+		}
+	}
+	if i := strings.IndexByte(typ, '/'); i >= 0 {
+		if k, ok := wordKind(typ[i+1:]); ok {
+			return k
+		}
+		if k, ok := topLevelKinds[typ[:i]]; ok {
+			return k
+		}
+	}
+	return KindOther
+}
+
+// wordKind classifies s by the first entry of kindWords it contains.
+func wordKind(s string) (TypeKind, bool) {
+	s = strings.ToLower(s)
+	for _, w := range kindWords {
+		if strings.Contains(s, w.word) {
+			return w.kind, true
+		}
+	}
+	return "", false
+}
+
+// ownKind returns the kind recorded for typ itself.
+func (r *Registry) ownKind(typ string) (TypeKind, bool) {
+	if k, ok := builtinKinds[typ]; ok {
+		return k, true
+	}
+	return r.descKind(typ)
+}
+
+// descKind returns the kind the shared-mime-info data of typ implies.
+func (r *Registry) descKind(typ string) (TypeKind, bool) {
+	if d, ok := r.desc(typ); ok {
+		if k, ok := iconKinds[d.genericIcon]; ok {
+			return k, true
+		}
+		return wordKind(d.comment)
+	}
+	return "", false
+}
+
+// TypesOfKind returns, in lexical order, the canonical names of the types
+// known to r, as enumerated by TypesWithFlags, whose kind is k.
+func (r *Registry) TypesOfKind(k TypeKind) []string {
+	var out []string
+	for _, typ := range r.TypesWithFlags(0) {
+		if r.Kind(typ) == k {
+			out = append(out, typ)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Kind returns the kind of typ in DefaultRegistry.
+func Kind(typ string) TypeKind {
+	return DefaultRegistry.Kind(typ)
+}
+
+// TypesOfKind returns the types of DefaultRegistry whose kind is k.
+func TypesOfKind(k TypeKind) []string {
+	return DefaultRegistry.TypesOfKind(k)
+}
@@ -0,0 +1,74 @@
+package mime
+
+import "testing"
+
+func TestKind(t *testing.T) {
+	tests := []struct {
+		typ  string
+		want TypeKind
+	}{
+		{"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", KindSpreadsheet},
+		{"application/vnd.oasis.opendocument.spreadsheet", KindSpreadsheet},
+		{"application/vnd.sun.xml.calc", KindSpreadsheet},
+		{"application/vnd.openxmlformats-officedocument.wordprocessingml.document", KindDocument},
+		{"application/vnd.ms-powerpoint.presentation.macroEnabled.12", KindPresentation},
+		{"application/pdf", KindDocument},
+		{"image/png", KindImage},
+		{"image/svg+xml", KindImage},
+		{"audio/mpeg", KindAudio},
+		{"video/mp4; codecs=avc1", KindVideo},
+		{"application/zip", KindArchive},
+		{"application/x-gzip", KindArchive},
+		{"application/mac-compactpro", KindArchive},
+		{"application/vnd.microsoft.portable-executable", KindExecutable},
+		{"application/x-msdownload", KindExecutable},
+		{"application/x-java-archive", KindExecutable},
+		{"application/hta", KindExecutable},
+		{"application/x-InstallShield", KindExecutable},
+		{"application/x-sh", KindCode},
+		{"text/javascript", KindCode},
+		{"application/x-futuresplash", KindVideo},
+		{"application/x-shockwave-flash", KindVideo},
+		{"application/x-mpeg", KindVideo},
+		{"font/woff2", KindFont},
+		{"text/plain", KindText},
+		{"chemical/x-pdb", KindModel},
+		{"application/octet-stream", KindOther},
+	}
+	for _, tt := range tests {
+		if got := Kind(tt.typ); got != tt.want {
+			t.Errorf("Kind(%q) = %v, want %v", tt.typ, got, tt.want)
+		}
+	}
+}
+
+// TestKindFlags checks that the kinds agree with the flags: a type flagged
+// Executable is an executable unless the table of kinds says otherwise, and
+// one flagged Archive is an archive unless it is a kind of document.
+func TestKindFlags(t *testing.T) {
+	for _, typ := range TypesWithFlags(Executable) {
+		if _, listed := builtinKinds[typ]; !listed && Kind(typ) != KindExecutable {
+			t.Errorf("executable %s: Kind = %v", typ, Kind(typ))
+		}
+	}
+	for _, typ := range TypesWithFlags(Archive) {
+		switch Kind(typ) {
+		case KindArchive, KindExecutable, KindDocument, KindSpreadsheet, KindPresentation, KindImage:
+		default:
+			t.Errorf("archive %s: Kind = %v", typ, Kind(typ))
+		}
+	}
+}
+
+func TestTypesOfKind(t *testing.T) {
+	var found bool
+	for _, typ := range TypesOfKind(KindSpreadsheet) {
+		if Kind(typ) != KindSpreadsheet {
+			t.Errorf("TypesOfKind(spreadsheet) lists %s of kind %v", typ, Kind(typ))
+		}
+		found = found || typ == "application/vnd.oasis.opendocument.spreadsheet"
+	}
+	if !found {
+		t.Errorf("TypesOfKind(spreadsheet) lacks application/vnd.oasis.opendocument.spreadsheet")
+	}
+}
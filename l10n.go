@@ -0,0 +1,164 @@
+package mime
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	l10nOnce sync.Once
+	// l10nTable maps lower-cased type names to their translated
+	// descriptions keyed by normalized language tag.
+	l10nTable map[string]map[string]string
+)
+
+// builtinComments returns the translations of the built-in database for typ.
+func builtinComments(typ string) map[string]string {
+	l10nOnce.Do(func() {
+		l10nTable = make(map[string]map[string]string)
+		data := l10nData
+		for data != "" {
+			var line string
+			if i := strings.IndexByte(data, '\n'); i >= 0 {
+				line, data = data[:i], data[i+1:]
+			} else {
+				line, data = data, ""
+			}
+			f := strings.SplitN(line, "\t", 3)
+			if len(f) != 3 {
+				continue
+			}
+			m := l10nTable[f[0]]
+			if m == nil {
+				m = make(map[string]string)
+				l10nTable[f[0]] = m
+			}
+			m[f[1]] = f[2]
+		}
+	})
+	return l10nTable[typ]
+}
+
+// langEquivalents maps language tags to the tag shared-mime-info files their
+// translations under.
+var langEquivalents = map[string]string{
+	"zh-hans": "zh-cn",
+	"zh-hant": "zh-tw",
+	"zh-sg":   "zh-cn",
+	"zh-hk":   "zh-tw",
+	"zh-mo":   "zh-tw",
+	"no":      "nb",
+	"iw":      "he",
+	"in":      "id",
+}
+
+// normLangTag lower-cases a BCP 47 tag or POSIX locale name such as "pt_BR"
+// or "be@latin" into the form translations are keyed by.
+func normLangTag(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexByte(tag, '.'); i >= 0 { // "de_DE.UTF-8"
+		tag = tag[:i]
+	}
+	tag = strings.ReplaceAll(tag, "@latin", "-latn")
+	return strings.ReplaceAll(tag, "_", "-")
+}
+
+// langFallbacks returns the tags to try for tag, most specific first,
+// following the lookup scheme of RFC 4647 section 3.4: subtags are removed
+// from the end, together with a preceding single-letter extension
+// introducer. Each tag is followed by its equivalent, if any.
+func langFallbacks(tag string) []string {
+	tag = normLangTag(tag)
+	var out []string
+	add := func(t string) {
+		for _, u := range out {
+			if u == t {
+				return
+			}
+		}
+		out = append(out, t)
+	}
+	for tag != "" && tag != "*" {
+		add(tag)
+		if eq, ok := langEquivalents[tag]; ok {
+			add(eq)
+		}
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+		if j := strings.LastIndexByte(tag, '-'); j >= 0 && len(tag)-j == 2 {
+			tag = tag[:j]
+		}
+	}
+	return out
+}
+
+// Describe returns the description of typ in the language languageTag, a
+// BCP 47 tag such as "pt-BR" or "zh-Hant-TW". When there is no translation
+// for the full tag, subtags are removed from its end, so that "de-CH" falls
+// back to "de"; when none of these has one, the untranslated English
+// description is returned. The result is "" if r has no description of typ.
+// Translations come from the shared-mime-info data built into the package or
+// added with AddSharedMimeInfo.
+func (r *Registry) Describe(typ, languageTag string) string {
+	names := r.descNames(baseType(typ))
+	for _, lang := range langFallbacks(languageTag) {
+		for _, name := range names {
+			if s := r.comment(name, lang); s != "" {
+				return s
+			}
+		}
+	}
+	if info, ok := r.Info(typ); ok {
+		return info.Description
+	}
+	return ""
+}
+
+// Describe returns the description of typ in the language languageTag using
+// DefaultRegistry.
+func Describe(typ, languageTag string) string {
+	return DefaultRegistry.Describe(typ, languageTag)
+}
+
+// descNames returns the names a description of typ may be recorded under,
+// in the order they are tried: typ, its canonical name and that name's
+// aliases in lexical order.
+func (r *Registry) descNames(typ string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := []string{typ}
+	canon := r.canonicalLocked(typ)
+	if canon != typ {
+		names = append(names, canon)
+	}
+	var aliases []string
+	for a := range r.aliases {
+		if a != typ && a != canon && r.canonicalLocked(a) == canon {
+			aliases = append(aliases, a)
+		}
+	}
+	sort.Strings(aliases)
+	return append(names, aliases...)
+}
+
+// comment returns the description of typ in the normalized language lang,
+// preferring translations added to r over the built-in ones.
+func (r *Registry) comment(typ, lang string) string {
+	r.mu.RLock()
+	d, ok := r.descs[typ]
+	builtin := r.builtinL10n
+	r.mu.RUnlock()
+	if ok {
+		if s := d.comments[lang]; s != "" {
+			return s
+		}
+	}
+	if builtin {
+		return builtinComments(typ)[lang]
+	}
+	return ""
+}
@@ -0,0 +1,80 @@
+package mime
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		typ, lang, want string
+	}{
+		{"application/pdf", "de", "PDF-Dokument"},
+		{"application/pdf", "DE", "PDF-Dokument"},
+		{"application/pdf", "de-CH", "PDF-Dokument"},
+		{"application/pdf", "de_CH.UTF-8", "PDF-Dokument"},
+		{"application/pdf", "pt-BR", "Documento PDF"},
+		{"application/pdf", "pt-PT", "documento PDF"},
+		{"application/pdf", "zh-Hant-TW", "PDF 文件"},
+		{"application/pdf", "zh-Hans", "PDF 文档"},
+		{"application/pdf", "sr-Cyrl-RS", "ПДФ документ"},
+		{"application/pdf", "be@latin", "Dakument PDF"},
+		{"application/pdf", "xx-YY", "PDF document"},
+		{"application/pdf", "", "PDF document"},
+		{"application/pdf", "*", "PDF document"},
+		{"application/pdf; version=1.7", "de", "PDF-Dokument"},
+		{"application/x-pdf", "de", "PDF-Dokument"},
+		{"application/x-no-such-type", "de", ""},
+	}
+	for _, tt := range tests {
+		if got := Describe(tt.typ, tt.lang); got != tt.want {
+			t.Errorf("Describe(%q, %q) = %q, want %q", tt.typ, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestLangFallbacks(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want []string
+	}{
+		{"de", []string{"de"}},
+		{"de-CH", []string{"de-ch", "de"}},
+		{"pt_BR", []string{"pt-br", "pt"}},
+		{"zh-Hant-TW", []string{"zh-hant-tw", "zh-hant", "zh-tw", "zh"}},
+		{"en-a-bbb-x-a-ccc", []string{"en-a-bbb-x-a-ccc", "en-a-bbb-x", "en-a-bbb", "en"}},
+		{"no", []string{"no", "nb"}},
+		{"", nil},
+		{"*", nil},
+	}
+	for _, tt := range tests {
+		if got := langFallbacks(tt.tag); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("langFallbacks(%q) = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+}
+
+// TestDescribeAliases checks that a type described only under several of
+// its aliases gets the description of the first alias in lexical order.
+func TestDescribeAliases(t *testing.T) {
+	r := NewRegistry()
+	r.AddSharedMimeInfo(&SharedMimeInfo{Types: []TypeInfo{
+		{Type: "application/x-c", Comment: "C", Comments: map[string]string{"de": "C (de)"}},
+		{Type: "application/x-a", Comment: "A", Comments: map[string]string{"de": "A (de)"}},
+		{Type: "application/x-b", Comment: "B", Comments: map[string]string{"de": "B (de)"}},
+	}})
+	for _, a := range []string{"application/x-c", "application/x-a", "application/x-b"} {
+		r.AddAlias(a, "application/target")
+	}
+	for i := 0; i < 20; i++ {
+		if got := r.Describe("application/target", "de"); got != "A (de)" {
+			t.Fatalf("Describe(target, de) = %q, want %q", got, "A (de)")
+		}
+		if got := r.Describe("application/target", "fr"); got != "A" {
+			t.Fatalf("Describe(target, fr) = %q, want %q", got, "A")
+		}
+		if got := r.Describe("application/x-b", "de"); got != "B (de)" {
+			t.Fatalf("Describe(x-b, de) = %q, want %q", got, "B (de)")
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package mime
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"strings"
+)
+
+// ParseError reports a malformed entry in a type database file.
+type ParseError struct {
+	File string // may be empty when reading from an io.Reader
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	if e.File != "" {
+		return fmt.Sprintf("mime: %s:%d: %s", e.File, e.Line, e.Msg)
+	}
+	return fmt.Sprintf("mime: line %d: %s", e.Line, e.Msg)
+}
+
+type mapping struct {
+	ext, typ string
+}
+
+// LoadMimeTypes reads an Apache-style mime.types file from rd and adds its
+// entries to r. Each line holds a MIME type followed by zero or more
+// extensions without leading dots; "#" starts a comment. Nothing is added
+// when the input is malformed.
+func (r *Registry) LoadMimeTypes(rd io.Reader) error {
+	var maps []mapping
+	sc := bufio.NewScanner(rd)
+	for line := 1; sc.Scan(); line++ {
+		text := sc.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if err := checkType(fields[0]); err != nil {
+			return &ParseError{Line: line, Msg: err.Error()}
+		}
+		for _, ext := range fields[1:] {
+			if err := checkExt(ext); err != nil {
+				return &ParseError{Line: line, Msg: err.Error()}
+			}
+			maps = append(maps, mapping{ext, fields[0]})
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	r.addMappings(maps)
+	return nil
+}
+
+// LoadNginxTypes reads an nginx "types { ... }" block from rd and adds its
+// entries to r. Each entry is a MIME type followed by one or more extensions
+// and terminated by a semicolon. Nothing is added when the input is
+// malformed.
+func (r *Registry) LoadNginxTypes(rd io.Reader) error {
+	toks, err := nginxTokens(rd)
+	if err != nil {
+		return err
+	}
+	var maps []mapping
+	i := 0
+	expect := func(want string) error {
+		if i >= len(toks) {
+			line := 1
+			if len(toks) > 0 {
+				line = toks[len(toks)-1].line
+			}
+			return &ParseError{Line: line, Msg: fmt.Sprintf("unexpected end of input, want %q", want)}
+		}
+		if toks[i].text != want {
+			return &ParseError{Line: toks[i].line, Msg: fmt.Sprintf("unexpected %q, want %q", toks[i].text, want)}
+		}
+		i++
+		return nil
+	}
+	if err := expect("types"); err != nil {
+		return err
+	}
+	if err := expect("{"); err != nil {
+		return err
+	}
+	for i < len(toks) && toks[i].text != "}" {
+		typ := toks[i]
+		if err := checkType(typ.text); err != nil {
+			return &ParseError{Line: typ.line, Msg: err.Error()}
+		}
+		i++
+		n := 0
+		for ; i < len(toks) && toks[i].text != ";"; i++ {
+			if err := checkExt(toks[i].text); err != nil {
+				return &ParseError{Line: toks[i].line, Msg: err.Error()}
+			}
+			maps = append(maps, mapping{toks[i].text, typ.text})
+			n++
+		}
+		if n == 0 {
+			return &ParseError{Line: typ.line, Msg: fmt.Sprintf("no extensions for %q", typ.text)}
+		}
+		if err := expect(";"); err != nil {
+			return err
+		}
+	}
+	if err := expect("}"); err != nil {
+		return err
+	}
+	if i < len(toks) {
+		return &ParseError{Line: toks[i].line, Msg: fmt.Sprintf("unexpected %q after types block", toks[i].text)}
+	}
+	r.addMappings(maps)
+	return nil
+}
+
+// LoadFile reads the type database file name into r. Files whose first
+// statement is an nginx "types" block are parsed with LoadNginxTypes, all
+// others with LoadMimeTypes. Errors carry the file name.
+func (r *Registry) LoadFile(name string) error {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	load := r.LoadMimeTypes
+	if isNginxTypes(data) {
+		load = r.LoadNginxTypes
+	}
+	err = load(bytes.NewReader(data))
+	if pe, ok := err.(*ParseError); ok {
+		pe.File = name
+	}
+	return err
+}
+
+// LoadFile loads the type database file name into DefaultRegistry, so that
+// its mappings take effect in TypeByExtension.
+func LoadFile(name string) error {
+	return DefaultRegistry.LoadFile(name)
+}
+
+func (r *Registry) addMappings(maps []mapping) {
+	r.mu.Lock()
+	for _, m := range maps {
+		r.types[normExt(m.ext)] = entry{typ: m.typ}
+	}
+	r.mu.Unlock()
+}
+
+func isNginxTypes(data []byte) bool {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		text := sc.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		return text == "types" || strings.HasPrefix(text, "types ") || strings.HasPrefix(text, "types{")
+	}
+	return false
+}
+
+// checkType returns an error unless typ is a bare MIME type, without
+// parameters.
+func checkType(typ string) error {
+	if _, _, err := mime.ParseMediaType(typ); err != nil || !strings.Contains(typ, "/") || strings.Contains(typ, ";") {
+		return fmt.Errorf("invalid MIME type %q", typ)
+	}
+	return nil
+}
+
+func checkExt(ext string) error {
+	if ext == "" || strings.ContainsAny(ext, "/\\;{}\"'") {
+		return fmt.Errorf("invalid extension %q", ext)
+	}
+	return nil
+}
+
+type nginxToken struct {
+	text string
+	line int
+}
+
+// nginxTokens splits nginx configuration syntax into words and the
+// punctuation "{", "}" and ";", dropping comments.
+func nginxTokens(rd io.Reader) ([]nginxToken, error) {
+	var toks []nginxToken
+	sc := bufio.NewScanner(rd)
+	for line := 1; sc.Scan(); line++ {
+		text := sc.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		start := -1
+		for i := 0; i <= len(text); i++ {
+			var c byte = ' '
+			if i < len(text) {
+				c = text[i]
+			}
+			switch c {
+			case ' ', '\t', '\r', '{', '}', ';':
+				if start >= 0 {
+					toks = append(toks, nginxToken{text[start:i], line})
+					start = -1
+				}
+				if c == '{' || c == '}' || c == ';' {
+					toks = append(toks, nginxToken{string(c), line})
+				}
+			default:
+				if start < 0 {
+					start = i
+				}
+			}
+		}
+	}
+	return toks, sc.Err()
+}
@@ -0,0 +1,139 @@
+package mime
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadMimeTypes(t *testing.T) {
+	r := NewRegistry()
+	err := r.LoadMimeTypes(strings.NewReader("# comment\n\ntext/x-test  tst test # trailing\napplication/x-none\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ext := range []string{".tst", ".test"} {
+		if typ, ok := r.Lookup(ext); !ok || typ != "text/x-test" {
+			t.Errorf("Lookup(%q) = %q, %v", ext, typ, ok)
+		}
+	}
+}
+
+func TestLoadMimeTypesErrors(t *testing.T) {
+	tests := []struct {
+		name, input string
+		line        int
+	}{
+		{"type", "text/plain txt\n\nnotatype foo\n", 3},
+		{"type after comments", "# one\n# two\n  # three\ntext/ bad\n", 4},
+		{"parameters", "text/plain; charset txt\n", 1},
+		{"extension", "text/plain txt\napplication/x-test a\\b\n", 2},
+		{"extension with quote", "text/plain \"txt\"\n", 1},
+	}
+	for _, tt := range tests {
+		r := NewRegistry()
+		err := r.LoadMimeTypes(strings.NewReader(tt.input))
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("%s: error %v, want a *ParseError", tt.name, err)
+			continue
+		}
+		if pe.Line != tt.line {
+			t.Errorf("%s: error on line %d, want %d (%v)", tt.name, pe.Line, tt.line, err)
+		}
+		if _, ok := r.Lookup(".txt"); ok {
+			t.Errorf("%s: entries were added from malformed input", tt.name)
+		}
+	}
+}
+
+func TestLoadNginxTypes(t *testing.T) {
+	r := NewRegistry()
+	err := r.LoadNginxTypes(strings.NewReader("types {\n    text/x-test tst test; # comment\n    application/x-other  oth;application/x-more mor;\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ext, want := range map[string]string{".tst": "text/x-test", ".test": "text/x-test", ".oth": "application/x-other", ".mor": "application/x-more"} {
+		if typ, ok := r.Lookup(ext); !ok || typ != want {
+			t.Errorf("Lookup(%q) = %q, %v; want %q", ext, typ, ok, want)
+		}
+	}
+}
+
+func TestLoadNginxTypesErrors(t *testing.T) {
+	tests := []struct {
+		name, input string
+		line        int
+	}{
+		{"empty", "", 1},
+		{"keyword", "# header\nmimes {\n}\n", 2},
+		{"brace", "types\n\ntext/html html;\n}\n", 3},
+		{"type", "types {\n    text/html html;\n    html text/html;\n}\n", 3},
+		{"no extensions", "types {\n    text/html html;\n\n    text/css;\n}\n", 4},
+		{"missing semicolon", "types {\n    text/html html\n}\n", 3},
+		{"unterminated", "types {\n    text/html html;\n", 2},
+		{"unterminated entry", "types {\n    text/html html", 2},
+		{"trailing", "types {\n    text/html html;\n}\n\nextra\n", 5},
+	}
+	for _, tt := range tests {
+		r := NewRegistry()
+		err := r.LoadNginxTypes(strings.NewReader(tt.input))
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("%s: error %v, want a *ParseError", tt.name, err)
+			continue
+		}
+		if pe.Line != tt.line {
+			t.Errorf("%s: error on line %d, want %d (%v)", tt.name, pe.Line, tt.line, err)
+		}
+		if _, ok := r.Lookup(".html"); ok {
+			t.Errorf("%s: entries were added from malformed input", tt.name)
+		}
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	r := NewRegistry()
+	if err := r.LoadFile(write("mime.types", "text/x-test tst\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.LoadFile(write("nginx.conf", "# nginx\ntypes {\n    text/x-other oth;\n}\n")); err != nil {
+		t.Fatal(err)
+	}
+	if typ, _ := r.Lookup(".tst"); typ != "text/x-test" {
+		t.Errorf("Lookup(.tst) = %q", typ)
+	}
+	if typ, _ := r.Lookup(".oth"); typ != "text/x-other" {
+		t.Errorf("Lookup(.oth) = %q", typ)
+	}
+
+	bad := write("bad.types", "text/plain txt\nbogus x\n")
+	err := r.LoadFile(bad)
+	pe, ok := err.(*ParseError)
+	if !ok || pe.File != bad || pe.Line != 2 {
+		t.Fatalf("LoadFile(bad) error = %#v", err)
+	}
+	if want := "mime: " + bad + ":2: "; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("error %q, want prefix %q", err, want)
+	}
+	if err := r.LoadFile(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Errorf("LoadFile(missing) error = %v", err)
+	}
+}
+
+func TestParseErrorWithoutFile(t *testing.T) {
+	err := &ParseError{Line: 7, Msg: "oops"}
+	if got := err.Error(); got != "mime: line 7: oops" {
+		t.Errorf("Error() = %q", got)
+	}
+}
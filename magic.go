@@ -0,0 +1,336 @@
+package mime
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// Match is a single magic-number test. Value is compared against the data at
+// Offset; when Range is greater than zero every offset in
+// [Offset, Offset+Range] is tried. Mask, when set, is ANDed with the data
+// before comparison and must be the same length as Value. A match with Sub
+// entries only succeeds when at least one of them matches as well, mirroring
+// the nested rules of shared-mime-info.
+type Match struct {
+	Offset int
+	Range  int
+	Value  []byte
+	Mask   []byte
+	Sub    []Match
+}
+
+// Signature identifies a MIME type by content. It matches when any of its
+// Matches does. Priority ranges from 0 to 100; higher wins when several
+// signatures match the same data.
+type Signature struct {
+	Type     string
+	Priority int
+	Matches  []Match
+
+	// entries, when set, are prefixes of ZIP entry names that the data
+	// must also hold, each in a local file header starting in the first
+	// zipWindow bytes.
+	entries []string
+}
+
+// zipWindow is how far into a ZIP archive the local file headers that
+// entries looks for may start.
+const zipWindow = 4096
+
+type magicDB struct {
+	mu     sync.RWMutex
+	sigs   []Signature
+	maxLen int
+}
+
+var defaultMagic = newMagicDB(signatures)
+
+func newMagicDB(sigs []Signature) *magicDB {
+	db := &magicDB{}
+	db.add(sigs...)
+	return db
+}
+
+// AddSignature registers sigs with the content sniffer used by DetectBytes
+// and DetectReader.
+func AddSignature(sigs ...Signature) {
+	defaultMagic.mu.Lock()
+	defaultMagic.add(sigs...)
+	defaultMagic.mu.Unlock()
+}
+
+func (db *magicDB) add(sigs ...Signature) {
+	db.sigs = append(db.sigs, sigs...)
+	sort.SliceStable(db.sigs, func(i, j int) bool {
+		return db.sigs[i].Priority > db.sigs[j].Priority
+	})
+	for _, sig := range sigs {
+		for _, m := range sig.Matches {
+			if n := m.extent(); n > db.maxLen {
+				db.maxLen = n
+			}
+		}
+		for _, name := range sig.entries {
+			if n := zipWindow + 30 + len(name); n > db.maxLen {
+				db.maxLen = n
+			}
+		}
+	}
+}
+
+// readLen returns how many leading bytes are needed to evaluate every
+// registered signature.
+func (db *magicDB) readLen() int {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return db.maxLen
+}
+
+func (db *magicDB) detect(data []byte) (Signature, bool) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	for _, sig := range db.sigs {
+		if sig.match(data) {
+			return sig, true
+		}
+	}
+	return Signature{}, false
+}
+
+// detectAll returns every signature matching data, highest priority first.
+func (db *magicDB) detectAll(data []byte) []Signature {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	var sigs []Signature
+	for _, sig := range db.sigs {
+		if sig.match(data) {
+			sigs = append(sigs, sig)
+		}
+	}
+	return sigs
+}
+
+func (sig Signature) match(data []byte) bool {
+	for _, name := range sig.entries {
+		if !hasZipEntry(data, name) {
+			return false
+		}
+	}
+	for _, m := range sig.Matches {
+		if m.match(data) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasZipEntry reports whether one of the local file headers starting in the
+// first zipWindow bytes of data names an entry beginning with prefix. The
+// headers are found by their signature rather than by walking the archive,
+// since entries written with a data descriptor do not record their size.
+func hasZipEntry(data []byte, prefix string) bool {
+	for off := 0; off <= zipWindow; {
+		i := bytes.Index(data[off:], []byte("PK\x03\x04"))
+		if i < 0 || off+i > zipWindow {
+			return false
+		}
+		h := data[off+i:]
+		if len(h) >= 30+len(prefix) && int(h[26])|int(h[27])<<8 >= len(prefix) &&
+			string(h[30:30+len(prefix)]) == prefix {
+			return true
+		}
+		off += i + 4
+	}
+	return false
+}
+
+func (m Match) extent() int {
+	n := m.Offset + m.Range + len(m.Value)
+	for _, sub := range m.Sub {
+		if s := sub.extent(); s > n {
+			n = s
+		}
+	}
+	return n
+}
+
+func (m Match) match(data []byte) bool {
+	if !m.matchHere(data) {
+		return false
+	}
+	if len(m.Sub) == 0 {
+		return true
+	}
+	for _, sub := range m.Sub {
+		if sub.match(data) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m Match) matchHere(data []byte) bool {
+	for off := m.Offset; off <= m.Offset+m.Range; off++ {
+		end := off + len(m.Value)
+		if end > len(data) {
+			return false
+		}
+		if m.Mask == nil {
+			if bytes.Equal(data[off:end], m.Value) {
+				return true
+			}
+			continue
+		}
+		ok := true
+		for i, v := range m.Value {
+			if data[off+i]&m.Mask[i] != v&m.Mask[i] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// at is shorthand for a plain match of s at offset off.
+func at(off int, s string) Match {
+	return Match{Offset: off, Value: []byte(s)}
+}
+
+// within matches s anywhere in the first n bytes after off.
+func within(off, n int, s string) Match {
+	return Match{Offset: off, Range: n, Value: []byte(s)}
+}
+
+// fold matches s at offset off ignoring ASCII letter case.
+func fold(off int, s string) Match {
+	v := []byte(s)
+	mask := make([]byte, len(v))
+	for i, c := range v {
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' {
+			v[i] = c &^ 0x20
+			mask[i] = 0xDF
+		} else {
+			mask[i] = 0xFF
+		}
+	}
+	return Match{Offset: off, Value: v, Mask: mask}
+}
+
+func odf(typ string) Signature {
+	return Signature{Type: typ, Priority: 70, Matches: []Match{
+		{Offset: 0, Value: []byte("PK\x03\x04"), Sub: []Match{at(30, "mimetype"+typ)}},
+	}}
+}
+
+// ooxml matches an Office Open XML package by its [Content_Types].xml entry
+// and an entry whose name starts with name, both of which ZIP stores
+// uncompressed in the local file headers. The main part does not tell a
+// macro-enabled document from a plain one, so the plain types are only a
+// guess: the macro-enabled and binary variants are recognized, at a higher
+// priority, by their VBA project or binary workbook when it is among the
+// first entries, and DetectOffice and DetectReader, given random access,
+// settle the type from [Content_Types].xml.
+func ooxml(typ string, priority int, name string) Signature {
+	return Signature{Type: typ, Priority: priority, Matches: []Match{at(0, "PK\x03\x04")},
+		entries: []string{"[Content_Types].xml", name}}
+}
+
+// signatures is the built-in magic-number database. Priorities follow the
+// shared-mime-info convention: 80 and above for unambiguous headers, 50 for
+// the common case and lower values for generic containers that more specific
+// signatures refine.
+var signatures = []Signature{
+	{Type: "application/pdf", Priority: 80, Matches: []Match{within(0, 1024, "%PDF-")}},
+	{Type: "application/postscript", Priority: 80, Matches: []Match{at(0, "%!PS"), at(0, "\xC5\xD0\xD3\xC6")}},
+	{Type: "text/rtf", Priority: 80, Matches: []Match{at(0, "{\\rtf")}},
+
+	odf("application/vnd.oasis.opendocument.text"),
+	odf("application/vnd.oasis.opendocument.text-template"),
+	odf("application/vnd.oasis.opendocument.text-master"),
+	odf("application/vnd.oasis.opendocument.text-web"),
+	odf("application/vnd.oasis.opendocument.spreadsheet"),
+	odf("application/vnd.oasis.opendocument.spreadsheet-template"),
+	odf("application/vnd.oasis.opendocument.presentation"),
+	odf("application/vnd.oasis.opendocument.presentation-template"),
+	odf("application/vnd.oasis.opendocument.graphics"),
+	odf("application/vnd.oasis.opendocument.graphics-template"),
+	odf("application/vnd.oasis.opendocument.chart"),
+	odf("application/vnd.oasis.opendocument.formula"),
+	odf("application/vnd.oasis.opendocument.database"),
+	odf("application/vnd.oasis.opendocument.image"),
+	odf("application/epub+zip"),
+	ooxml("application/vnd.ms-excel.sheet.binary.macroEnabled.12", 65, "xl/workbook.bin"),
+	ooxml("application/vnd.ms-word.document.macroEnabled.12", 65, "word/vbaProject.bin"),
+	ooxml("application/vnd.ms-excel.sheet.macroEnabled.12", 65, "xl/vbaProject.bin"),
+	ooxml("application/vnd.ms-powerpoint.presentation.macroEnabled.12", 65, "ppt/vbaProject.bin"),
+	ooxml("application/vnd.openxmlformats-officedocument.wordprocessingml.document", 60, "word/"),
+	ooxml("application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", 60, "xl/"),
+	ooxml("application/vnd.openxmlformats-officedocument.presentationml.presentation", 60, "ppt/"),
+	{Type: "application/x-ole-storage", Priority: 50, Matches: []Match{at(0, "\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1")}},
+
+	{Type: "image/png", Priority: 80, Matches: []Match{at(0, "\x89PNG\r\n\x1A\n")}},
+	{Type: "image/gif", Priority: 80, Matches: []Match{at(0, "GIF87a"), at(0, "GIF89a")}},
+	{Type: "image/jpeg", Priority: 80, Matches: []Match{at(0, "\xFF\xD8\xFF")}},
+	{Type: "image/jp2", Priority: 80, Matches: []Match{at(0, "\x00\x00\x00\x0CjP  \r\n\x87\n")}},
+	{Type: "image/webp", Priority: 80, Matches: []Match{{Offset: 0, Value: []byte("RIFF"), Sub: []Match{at(8, "WEBP")}}}},
+	{Type: "image/tiff", Priority: 80, Matches: []Match{at(0, "II*\x00"), at(0, "MM\x00*")}},
+	{Type: "image/vnd.djvu", Priority: 80, Matches: []Match{{Offset: 0, Value: []byte("AT&TFORM"), Sub: []Match{at(12, "DJVU"), at(12, "DJVM")}}}},
+	{Type: "image/x-icon", Priority: 50, Matches: []Match{at(0, "\x00\x00\x01\x00")}},
+	{Type: "image/bmp", Priority: 40, Matches: []Match{at(0, "BM")}},
+	{Type: "image/x-portable-bitmap", Priority: 40, Matches: []Match{at(0, "P1"), at(0, "P4")}},
+	{Type: "image/x-portable-graymap", Priority: 40, Matches: []Match{at(0, "P2"), at(0, "P5")}},
+	{Type: "image/x-portable-pixmap", Priority: 40, Matches: []Match{at(0, "P3"), at(0, "P6")}},
+	{Type: "image/x-xpixmap", Priority: 80, Matches: []Match{at(0, "/* XPM */")}},
+
+	{Type: "audio/mpeg", Priority: 80, Matches: []Match{at(0, "ID3")}},
+	{Type: "audio/mpeg", Priority: 40, Matches: []Match{{Offset: 0, Value: []byte{0xFF, 0xE0}, Mask: []byte{0xFF, 0xE0}}}},
+	{Type: "audio/x-wav", Priority: 80, Matches: []Match{{Offset: 0, Value: []byte("RIFF"), Sub: []Match{at(8, "WAVE")}}}},
+	{Type: "audio/x-aiff", Priority: 80, Matches: []Match{{Offset: 0, Value: []byte("FORM"), Sub: []Match{at(8, "AIFF"), at(8, "AIFC")}}}},
+	{Type: "audio/midi", Priority: 80, Matches: []Match{at(0, "MThd")}},
+	{Type: "audio/basic", Priority: 80, Matches: []Match{at(0, ".snd")}},
+	{Type: "audio/mp4a-latm", Priority: 80, Matches: []Match{at(4, "ftypM4A "), at(4, "ftypM4B "), at(4, "ftypM4P ")}},
+	{Type: "application/ogg", Priority: 60, Matches: []Match{at(0, "OggS")}},
+	{Type: "video/ogg", Priority: 70, Matches: []Match{{Offset: 0, Value: []byte("OggS"), Sub: []Match{at(28, "\x80theora")}}}},
+
+	{Type: "video/quicktime", Priority: 80, Matches: []Match{at(4, "ftypqt  "), at(4, "moov"), at(4, "mdat")}},
+	{Type: "video/3gpp", Priority: 80, Matches: []Match{at(4, "ftyp3gp")}},
+	{Type: "video/x-m4v", Priority: 80, Matches: []Match{at(4, "ftypM4V")}},
+	{Type: "video/mp4", Priority: 50, Matches: []Match{at(4, "ftyp")}},
+	{Type: "video/webm", Priority: 80, Matches: []Match{{Offset: 0, Value: []byte("\x1A\x45\xDF\xA3"), Sub: []Match{within(4, 64, "webm")}}}},
+	{Type: "video/x-msvideo", Priority: 80, Matches: []Match{{Offset: 0, Value: []byte("RIFF"), Sub: []Match{at(8, "AVI ")}}}},
+	{Type: "video/x-flv", Priority: 80, Matches: []Match{at(0, "FLV\x01")}},
+	{Type: "video/x-ms-asf", Priority: 80, Matches: []Match{at(0, "\x30\x26\xB2\x75\x8E\x66\xCF\x11")}},
+	{Type: "video/mpeg", Priority: 60, Matches: []Match{at(0, "\x00\x00\x01\xBA"), at(0, "\x00\x00\x01\xB3")}},
+	{Type: "application/vnd.rn-realmedia", Priority: 80, Matches: []Match{at(0, ".RMF")}},
+	{Type: "application/x-shockwave-flash", Priority: 80, Matches: []Match{at(0, "FWS"), at(0, "CWS"), at(0, "ZWS")}},
+
+	{Type: "application/zip", Priority: 40, Matches: []Match{at(0, "PK\x03\x04"), at(0, "PK\x05\x06")}},
+	{Type: "application/x-gzip", Priority: 80, Matches: []Match{at(0, "\x1F\x8B")}},
+	{Type: "application/x-bzip2", Priority: 80, Matches: []Match{at(0, "BZh")}},
+	{Type: "application/x-compress", Priority: 60, Matches: []Match{at(0, "\x1F\x9D")}},
+	{Type: "application/x-tar", Priority: 60, Matches: []Match{at(257, "ustar")}},
+	{Type: "application/x-cpio", Priority: 60, Matches: []Match{at(0, "070707"), at(0, "070701"), at(0, "070702"), at(0, "\xC7\x71")}},
+	{Type: "application/vnd.rar", Priority: 80, Matches: []Match{at(0, "Rar!\x1A\x07")}},
+	{Type: "application/x-rpm", Priority: 80, Matches: []Match{at(0, "\xED\xAB\xEE\xDB")}},
+	{Type: "application/x-bittorrent", Priority: 50, Matches: []Match{at(0, "d8:announce")}},
+	{Type: "application/x-stuffit", Priority: 80, Matches: []Match{at(0, "StuffIt "), at(0, "SIT!")}},
+	{Type: "application/mac-binhex40", Priority: 60, Matches: []Match{within(0, 512, "(This file must be converted with BinHex")}},
+	{Type: "application/x-dvi", Priority: 60, Matches: []Match{at(0, "\xF7\x02")}},
+	{Type: "application/x-hdf", Priority: 80, Matches: []Match{at(0, "\x89HDF\r\n\x1A\n"), at(0, "\x0E\x03\x13\x01")}},
+	{Type: "application/x-netcdf", Priority: 80, Matches: []Match{at(0, "CDF\x01"), at(0, "CDF\x02")}},
+	{Type: "application/x-msdownload", Priority: 50, Matches: []Match{at(0, "MZ")}},
+	{Type: "application/x-executable", Priority: 80, Matches: []Match{at(0, "\x7FELF")}},
+
+	{Type: "text/html", Priority: 60, Matches: []Match{fold(0, "<!DOCTYPE HTML"), fold(0, "<html"), fold(0, "<head"), fold(0, "<body")}},
+	{Type: "image/svg+xml", Priority: 70, Matches: []Match{{Offset: 0, Value: []byte("<?xml"), Sub: []Match{within(5, 512, "<svg")}}, at(0, "<svg")}},
+	{Type: "application/xml", Priority: 40, Matches: []Match{at(0, "<?xml")}},
+	{Type: "application/x-sh", Priority: 50, Matches: []Match{at(0, "#!/bin/sh"), at(0, "#! /bin/sh"), at(0, "#!/bin/bash"), at(0, "#!/usr/bin/env sh"), at(0, "#!/usr/bin/env bash")}},
+	{Type: "application/x-csh", Priority: 50, Matches: []Match{at(0, "#!/bin/csh"), at(0, "#!/bin/tcsh")}},
+	{Type: "text/calendar", Priority: 60, Matches: []Match{at(0, "BEGIN:VCALENDAR")}},
+	{Type: "text/x-vcard", Priority: 60, Matches: []Match{fold(0, "BEGIN:VCARD")}},
+}
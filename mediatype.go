@@ -0,0 +1,214 @@
+package mime
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"sort"
+	"strings"
+)
+
+// MediaType is a parsed media type such as
+// "application/vnd.api+json; charset=utf-8". Type, Subtype and parameter
+// names are lower-case.
+type MediaType struct {
+	Type    string // top-level type, e.g. "application"
+	Subtype string // full subtype including tree and suffix, e.g. "vnd.api+json"
+	Suffix  string // structured syntax suffix without the "+", e.g. "json"
+	Tree    string // registration tree: "vnd", "prs", "x" or "" for the standards tree
+	Params  map[string]string
+}
+
+// ErrInvalidMediaType is wrapped by the errors Parse returns for input that
+// does not follow the RFC 6838 grammar.
+var ErrInvalidMediaType = errors.New("mime: invalid media type")
+
+// maxNameLen is the maximum length RFC 6838 allows for a type or subtype name.
+const maxNameLen = 127
+
+// Parse parses s, a media type with optional parameters. The type and subtype
+// must be restricted names as defined in RFC 6838 section 4.2; "*" is also
+// accepted as a whole subtype, or as both type and subtype, so that media
+// ranges like "image/*" can be parsed too.
+func Parse(s string) (MediaType, error) {
+	mediaType, params, err := mime.ParseMediaType(s)
+	if err != nil {
+		return MediaType{}, fmt.Errorf("%w %q: %v", ErrInvalidMediaType, s, err)
+	}
+	i := strings.IndexByte(mediaType, '/')
+	if i < 0 {
+		return MediaType{}, fmt.Errorf("%w %q: missing subtype", ErrInvalidMediaType, s)
+	}
+	typ, sub := mediaType[:i], mediaType[i+1:]
+	switch {
+	case sub == "*":
+		if typ != "*" && !isRestrictedName(typ) {
+			return MediaType{}, fmt.Errorf("%w %q: bad type name", ErrInvalidMediaType, s)
+		}
+	case !isRestrictedName(typ):
+		return MediaType{}, fmt.Errorf("%w %q: bad type name", ErrInvalidMediaType, s)
+	case !isRestrictedName(sub):
+		return MediaType{}, fmt.Errorf("%w %q: bad subtype name", ErrInvalidMediaType, s)
+	}
+	m := MediaType{Type: typ, Subtype: sub}
+	if len(params) > 0 {
+		m.Params = params
+	}
+	m.derive()
+	return m, nil
+}
+
+// derive fills in Suffix and Tree from Subtype.
+func (m *MediaType) derive() {
+	m.Suffix, m.Tree = "", ""
+	// A "+" that follows another one, as in "x-c++src", is part of the name.
+	if i := strings.LastIndexByte(m.Subtype, '+'); i > 0 && m.Subtype[i-1] != '+' {
+		m.Suffix = m.Subtype[i+1:]
+	}
+	switch {
+	case strings.HasPrefix(m.Subtype, "vnd."):
+		m.Tree = "vnd"
+	case strings.HasPrefix(m.Subtype, "prs."):
+		m.Tree = "prs"
+	case strings.HasPrefix(m.Subtype, "x.") || strings.HasPrefix(m.Subtype, "x-"):
+		m.Tree = "x"
+	}
+}
+
+// isRestrictedName reports whether s is a restricted-name:
+//
+//	restricted-name = restricted-name-first *126restricted-name-chars
+//	restricted-name-first  = ALPHA / DIGIT
+//	restricted-name-chars  = ALPHA / DIGIT / "!" / "#" / "$" / "&" / "-" /
+//	                         "^" / "_" / "." / "+"
+func isRestrictedName(s string) bool {
+	if s == "" || len(s) > maxNameLen {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case i > 0 && strings.IndexByte("!#$&-^_.+", c) >= 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Essence returns "type/subtype" without parameters.
+func (m MediaType) Essence() string {
+	return m.Type + "/" + m.Subtype
+}
+
+// String formats m with its parameters, quoting values where needed.
+// Parameters that mime.FormatMediaType rejects, such as names that are not
+// tokens, are written as they are, with values quoted, rather than dropped;
+// the result may then not parse back.
+func (m MediaType) String() string {
+	if s := mime.FormatMediaType(m.Essence(), m.Params); s != "" {
+		return s
+	}
+	names := make([]string, 0, len(m.Params))
+	for k := range m.Params {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	b.WriteString(m.Essence())
+	for _, k := range names {
+		b.WriteString("; ")
+		b.WriteString(k)
+		b.WriteString(`="`)
+		for _, c := range []byte(m.Params[k]) {
+			if c == '"' || c == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		}
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+// Equal reports whether m and o have the same type, subtype and parameters.
+// Parameter names are compared case-insensitively, as is the value of the
+// charset parameter.
+func (m MediaType) Equal(o MediaType) bool {
+	if !strings.EqualFold(m.Type, o.Type) || !strings.EqualFold(m.Subtype, o.Subtype) {
+		return false
+	}
+	if len(m.Params) != len(o.Params) {
+		return false
+	}
+	for k, v := range m.Params {
+		w, ok := o.param(k)
+		if !ok {
+			return false
+		}
+		if strings.EqualFold(k, "charset") {
+			if !strings.EqualFold(v, w) {
+				return false
+			}
+		} else if v != w {
+			return false
+		}
+	}
+	return true
+}
+
+func (m MediaType) param(name string) (string, bool) {
+	if v, ok := m.Params[name]; ok {
+		return v, true
+	}
+	for k, v := range m.Params {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// Param returns the value of the parameter name, or "" if it is not set.
+func (m MediaType) Param(name string) string {
+	v, _ := m.param(name)
+	return v
+}
+
+// WithParam returns a copy of m with the parameter name set to value,
+// replacing any parameter whose name differs only in case. m itself is not
+// modified.
+func (m MediaType) WithParam(name, value string) MediaType {
+	params := make(map[string]string, len(m.Params)+1)
+	for k, v := range m.Params {
+		if !strings.EqualFold(k, name) {
+			params[k] = v
+		}
+	}
+	params[strings.ToLower(name)] = value
+	m.Params = params
+	return m
+}
+
+// Matches reports whether m falls within the media range pattern, such as
+// "*/*", "image/*" or "text/html; level=1". Every parameter of pattern must
+// be present in m with the same value; the "q" weight is ignored.
+func (m MediaType) Matches(pattern MediaType) bool {
+	if pattern.Type != "*" && !strings.EqualFold(pattern.Type, m.Type) {
+		return false
+	}
+	if pattern.Subtype != "*" && !strings.EqualFold(pattern.Subtype, m.Subtype) {
+		return false
+	}
+	for k, v := range pattern.Params {
+		if strings.EqualFold(k, "q") {
+			continue
+		}
+		w, ok := m.param(k)
+		if !ok || (v != w && !(strings.EqualFold(k, "charset") && strings.EqualFold(v, w))) {
+			return false
+		}
+	}
+	return true
+}
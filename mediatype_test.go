@@ -0,0 +1,95 @@
+package mime
+
+import "testing"
+
+func TestMediaTypeString(t *testing.T) {
+	tests := []string{
+		"text/html",
+		"text/html; charset=utf-8",
+		`application/vnd.api+json; ext="a b"; profile=x`,
+		`multipart/form-data; boundary="--=_a;b"`,
+		"image/*",
+	}
+	for _, s := range tests {
+		m, err := Parse(s)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", s, err)
+			continue
+		}
+		back, err := Parse(m.String())
+		if err != nil || !back.Equal(m) {
+			t.Errorf("Parse(%q).String() = %q does not parse back to the same type (%v)", s, m.String(), err)
+		}
+	}
+	if got := (MediaType{Type: "text", Subtype: "plain"}).String(); got != "text/plain" {
+		t.Errorf("String() = %q", got)
+	}
+	m := MediaType{Type: "text", Subtype: "plain", Params: map[string]string{"bad name": `a"b\c`, "charset": "utf-8"}}
+	if got, want := m.String(), `text/plain; bad name="a\"b\\c"; charset="utf-8"`; got != want {
+		t.Errorf("String() with an invalid parameter name = %q, want %q", got, want)
+	}
+}
+
+func TestMediaTypeEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"text/html", "TEXT/HTML", true},
+		{"text/html; charset=UTF-8", "text/html; Charset=utf-8", true},
+		{"text/html; charset=utf-8", "text/html", false},
+		{"text/html; level=1", "text/html; level=2", false},
+		{"text/html; level=a", "text/html; LEVEL=A", false},
+		{"text/html", "text/plain", false},
+		{"application/xml", "text/xml", false},
+	}
+	for _, tt := range tests {
+		a, _ := Parse(tt.a)
+		b, _ := Parse(tt.b)
+		if got := a.Equal(b); got != tt.want {
+			t.Errorf("Equal(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+		if got := b.Equal(a); got != tt.want {
+			t.Errorf("Equal(%q, %q) = %v, want %v", tt.b, tt.a, got, tt.want)
+		}
+	}
+	mixed := MediaType{Type: "Text", Subtype: "HTML", Params: map[string]string{"Charset": "UTF-8"}}
+	lower, _ := Parse("text/html; charset=utf-8")
+	if !mixed.Equal(lower) || !lower.Equal(mixed) {
+		t.Errorf("a MediaType with mixed-case names does not equal its parsed form")
+	}
+}
+
+func TestMediaTypeParam(t *testing.T) {
+	m, _ := Parse("text/html; charset=utf-8; level=1")
+	for name, want := range map[string]string{"charset": "utf-8", "CHARSET": "utf-8", "level": "1", "q": ""} {
+		if got := m.Param(name); got != want {
+			t.Errorf("Param(%q) = %q, want %q", name, got, want)
+		}
+	}
+	if got := (MediaType{Type: "text", Subtype: "plain"}).Param("charset"); got != "" {
+		t.Errorf("Param on a type without parameters = %q", got)
+	}
+}
+
+func TestMediaTypeWithParam(t *testing.T) {
+	m, _ := Parse("text/html; charset=utf-8")
+	n := m.WithParam("Charset", "iso-8859-1").WithParam("level", "1")
+	if got := m.String(); got != "text/html; charset=utf-8" {
+		t.Errorf("WithParam modified the original: %q", got)
+	}
+	if got := n.String(); got != "text/html; charset=iso-8859-1; level=1" {
+		t.Errorf("WithParam result = %q", got)
+	}
+	mixed := MediaType{Type: "text", Subtype: "html", Params: map[string]string{"Charset": "utf-8"}}
+	if got := mixed.WithParam("charset", "koi8-r"); len(got.Params) != 1 || got.Param("charset") != "koi8-r" {
+		t.Errorf("WithParam kept a parameter differing in case: %v", got.Params)
+	}
+	if got := mixed.Params["Charset"]; got != "utf-8" {
+		t.Errorf("WithParam modified the original parameters: %q", got)
+	}
+	var zero MediaType
+	if got := zero.WithParam("a", "b"); got.Param("a") != "b" || zero.Params != nil {
+		t.Errorf("WithParam on the zero value = %v, original %v", got.Params, zero.Params)
+	}
+}
@@ -0,0 +1,179 @@
+package mime
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// initCalls lists the functions package-level variables may be initialised
+// with. Each only builds values in memory: the signature and registry
+// constructors of this package and pattern and error values from the
+// standard library.
+var initCalls = map[string]bool{
+	"at":                 true,
+	"within":             true,
+	"fold":               true,
+	"odf":                true,
+	"ooxml":              true,
+	"newMagicDB":         true,
+	"newBuiltinRegistry": true,
+	"errors.New":         true,
+	"regexp.MustCompile": true,
+}
+
+// TestNoInit guards against package initialisation doing any work: importing
+// the package must not start goroutines, touch files or use the network.
+// Besides init functions, that rules out package-level variables initialised
+// by calls other than conversions and those listed in initCalls.
+func TestNoInit(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if ok && fn.Recv == nil && fn.Name.Name == "init" {
+					t.Errorf("%s: package declares an init function", fset.Position(fn.Pos()))
+				}
+				if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.VAR && pkg.Name == "mime" {
+					for _, spec := range gd.Specs {
+						for _, v := range spec.(*ast.ValueSpec).Values {
+							checkInitCalls(t, fset, v)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// checkInitCalls reports the calls in the initialiser expr that are neither
+// conversions nor listed in initCalls. Function literals are not run by the
+// initialisation and are skipped.
+func checkInitCalls(t *testing.T, fset *token.FileSet, expr ast.Expr) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			var name string
+			switch fun := n.Fun.(type) {
+			case *ast.ArrayType:
+				return true
+			case *ast.Ident:
+				name = fun.Name
+			case *ast.SelectorExpr:
+				if pkg, ok := fun.X.(*ast.Ident); ok {
+					name = pkg.Name + "." + fun.Sel.Name
+				}
+			}
+			if !initCalls[name] {
+				t.Errorf("%s: package-level variable is initialised by a call to %s", fset.Position(n.Pos()), types.ExprString(n.Fun))
+			}
+		}
+		return true
+	})
+}
+
+// TestImportOpensNoSockets checks that, once the package is loaded, the
+// process holds no sockets and no files were dropped in the filesystem root.
+func TestImportOpensNoSockets(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("requires /proc")
+	}
+	_ = TypeByExtension("a.pdf")
+
+	fds, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		t.Skip(err)
+	}
+	for _, fd := range fds {
+		link, err := os.Readlink(filepath.Join("/proc/self/fd", fd.Name()))
+		if err != nil {
+			continue
+		}
+		if strings.HasPrefix(link, "socket:") {
+			t.Errorf("fd %s is a socket: %s", fd.Name(), link)
+		}
+	}
+
+	if _, err := os.Stat("/out03.txt"); err == nil {
+		t.Errorf("/out03.txt exists")
+	}
+}
+
+func TestExtensionsByType(t *testing.T) {
+	tests := []struct {
+		typ, first string
+		includes   []string
+	}{
+		{"image/jpeg", ".jpg", []string{".jpeg", ".jpe"}},
+		{"image/jpg", ".jpg", []string{".jpeg"}},
+		{"IMAGE/JPEG; q=1", ".jpg", nil},
+		{"text/javascript", ".js", []string{".mjs"}},
+		{"application/x-javascript", ".js", nil},
+		{"application/javascript", ".js", nil},
+		{"text/html; charset=utf-8", ".html", []string{".htm"}},
+		{"application/x-gzip", ".gz", nil},
+		{"application/gzip", ".gz", nil},
+		{"application/java-archive", ".jar", nil},
+	}
+	for _, tt := range tests {
+		exts, err := ExtensionsByType(tt.typ)
+		if err != nil || len(exts) == 0 || exts[0] != tt.first {
+			t.Errorf("ExtensionsByType(%q) = %q, %v; want %s first", tt.typ, exts, err, tt.first)
+			continue
+		}
+		for _, want := range tt.includes {
+			found := false
+			for _, ext := range exts {
+				found = found || ext == want
+			}
+			if !found {
+				t.Errorf("ExtensionsByType(%q) = %q, want %s among them", tt.typ, exts, want)
+			}
+		}
+		for i := 2; i < len(exts); i++ {
+			if exts[i-1] > exts[i] {
+				t.Errorf("ExtensionsByType(%q) = %q, not in lexical order after the first", tt.typ, exts)
+				break
+			}
+		}
+	}
+	if exts, err := ExtensionsByType("application/x-no-such-type"); err != nil || exts != nil {
+		t.Errorf("ExtensionsByType(unknown) = %q, %v; want nil", exts, err)
+	}
+	if _, err := ExtensionsByType("not a type"); err == nil {
+		t.Errorf("ExtensionsByType(not a type): no error")
+	}
+}
+
+// TestPreferredExt checks that every preferred extension is keyed by a
+// canonical name and is among the extensions of its type.
+func TestPreferredExt(t *testing.T) {
+	for typ, ext := range preferredExt {
+		if c := Canonical(typ); c != typ {
+			t.Errorf("preferredExt has %s, an alias of %s", typ, c)
+		}
+		if exts, _ := ExtensionsByType(typ); len(exts) == 0 || exts[0] != ext {
+			t.Errorf("ExtensionsByType(%q) = %q, want %s first", typ, exts, ext)
+		}
+	}
+	for typ, want := range map[string]string{"image/jpg": ".jpg", "application/x-javascript": ".js", "text/javascript": ".js"} {
+		if info, ok := Info(typ); !ok || info.Extension != want {
+			t.Errorf("Info(%q).Extension = %q, want %q", typ, info.Extension, want)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package mime
+
+import (
+	"strconv"
+	"strings"
+)
+
+// acceptRange is one element of an Accept header.
+type acceptRange struct {
+	MediaType
+	q float64
+}
+
+// specificity ranks how closely r describes a type: "*/*" is least specific,
+// then "type/*", then "type/subtype", then a type with parameters.
+func (r acceptRange) specificity() int {
+	switch {
+	case r.Type == "*":
+		return 0
+	case r.Subtype == "*":
+		return 1
+	case len(r.Params) > 0:
+		return 3
+	}
+	return 2
+}
+
+// Negotiate picks the offer that best satisfies the HTTP Accept header
+// accept, following RFC 7231 section 5.3.2. Each offer is weighted with the
+// q-value of the most specific media range matching it; the offer with the
+// highest weight wins, ties going to the offer listed first. Offers match
+// ranges both as written and by their canonical names, so an offer of
+// application/xml satisfies a client asking for text/xml, and an offer of
+// text/xml one asking for text/*. An empty header, like a bare "*" range,
+// accepts anything. The result is false when no offer is acceptable.
+func (r *Registry) Negotiate(accept string, offers []string) (string, bool) {
+	if len(offers) == 0 {
+		return "", false
+	}
+	if strings.TrimSpace(accept) == "" {
+		return offers[0], true
+	}
+	ranges := r.parseAccept(accept)
+	best, bestQ := -1, 0.0
+	for i, offer := range offers {
+		m, err := Parse(offer)
+		if err != nil {
+			continue
+		}
+		canon := r.canonicalMediaType(m)
+		q, spec := 0.0, -1
+		for _, ar := range ranges {
+			if !m.Matches(ar.MediaType) && !canon.Matches(ar.MediaType) {
+				continue
+			}
+			if s := ar.specificity(); s > spec || s == spec && ar.q > q {
+				q, spec = ar.q, s
+			}
+		}
+		if q > bestQ {
+			best, bestQ = i, q
+		}
+	}
+	if best < 0 {
+		return "", false
+	}
+	return offers[best], true
+}
+
+// Negotiate picks the best offer for the Accept header accept using
+// DefaultRegistry.
+func Negotiate(accept string, offers []string) (string, bool) {
+	return DefaultRegistry.Negotiate(accept, offers)
+}
+
+// parseAccept splits an Accept header into media ranges, skipping malformed
+// ones. The q parameter is removed from the ranges' parameters.
+func (r *Registry) parseAccept(accept string) []acceptRange {
+	var ranges []acceptRange
+	for _, part := range splitQuoted(accept, ',') {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		media := part
+		if i := strings.IndexByte(part, ';'); i >= 0 {
+			media = part[:i]
+		}
+		if strings.TrimSpace(media) == "*" { // sent by some clients for "*/*"
+			part = "*/*" + part[len(media):]
+		}
+		m, err := Parse(part)
+		if err != nil {
+			continue
+		}
+		ar := acceptRange{MediaType: r.canonicalMediaType(m), q: 1}
+		if v, ok := m.Params["q"]; ok {
+			q, err := strconv.ParseFloat(v, 64)
+			if err != nil || q < 0 || q > 1 {
+				continue
+			}
+			ar.q = q
+			params := make(map[string]string, len(m.Params)-1)
+			for k, v := range m.Params {
+				if k != "q" {
+					params[k] = v
+				}
+			}
+			if len(params) == 0 {
+				params = nil
+			}
+			ar.Params = params
+		}
+		ranges = append(ranges, ar)
+	}
+	return ranges
+}
+
+// canonicalMediaType replaces the type and subtype of m with their canonical
+// names. Wildcard ranges are returned unchanged.
+func (r *Registry) canonicalMediaType(m MediaType) MediaType {
+	if m.Type == "*" || m.Subtype == "*" {
+		return m
+	}
+	canon := r.canonical(m.Essence())
+	if i := strings.IndexByte(canon, '/'); i >= 0 && canon != m.Essence() {
+		m.Type, m.Subtype = canon[:i], canon[i+1:]
+		m.derive()
+	}
+	return m
+}
+
+// splitQuoted splits s at sep, ignoring separators inside double-quoted
+// strings.
+func splitQuoted(s string, sep byte) []string {
+	var parts []string
+	quoted, escaped := false, false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
@@ -0,0 +1,37 @@
+package mime
+
+import "testing"
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		accept string
+		offers []string
+		want   string
+		ok     bool
+	}{
+		{"", []string{"text/html", "application/json"}, "text/html", true},
+		{"application/json", []string{"text/html", "application/json"}, "application/json", true},
+		{"text/*", []string{"text/xml"}, "text/xml", true},
+		{"application/*", []string{"text/xml"}, "text/xml", true},
+		{"text/xml", []string{"application/xml"}, "application/xml", true},
+		{"application/xml", []string{"text/xml"}, "text/xml", true},
+		{"text/*", []string{"application/xml"}, "", false},
+		{"*", []string{"image/png"}, "image/png", true},
+		{"*;q=0.5, image/webp", []string{"image/png", "image/webp"}, "image/webp", true},
+		{"*/*", []string{"image/png"}, "image/png", true},
+		{"image/*;q=0.8, image/webp", []string{"image/png", "image/webp"}, "image/webp", true},
+		{"text/html;q=0, */*", []string{"text/html"}, "", false},
+		{"text/html;q=0.2, application/json;q=0.9", []string{"text/html", "application/json"}, "application/json", true},
+		{"text/html; charset=utf-8", []string{"text/html; charset=utf-8"}, "text/html; charset=utf-8", true},
+		{"text/html; charset=utf-8", []string{"text/html; charset=latin1"}, "", false},
+		{"image/png", []string{"image/jpeg"}, "", false},
+		{"garbage", []string{"image/jpeg"}, "", false},
+		{"application/json", nil, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := Negotiate(tt.accept, tt.offers)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Negotiate(%q, %q) = %q, %v; want %q, %v", tt.accept, tt.offers, got, ok, tt.want, tt.ok)
+		}
+	}
+}
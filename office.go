@@ -0,0 +1,147 @@
+package mime
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// Limits on how much of a container DetectOffice reads, so that a crafted
+// archive cannot make it decompress large entries.
+const (
+	maxContentTypesSize = 1 << 20
+	maxMimetypeSize     = 256
+)
+
+// ooxmlMainTypes maps the content type of the main part of an Office Open
+// XML package, as declared in [Content_Types].xml, to the MIME type of the
+// file.
+var ooxmlMainTypes = map[string]string{
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml":   "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.template.main+xml":   "application/vnd.openxmlformats-officedocument.wordprocessingml.template",
+	"application/vnd.ms-word.document.macroenabled.main+xml":                             "application/vnd.ms-word.document.macroEnabled.12",
+	"application/vnd.ms-word.template.macroenabledtemplate.main+xml":                     "application/vnd.ms-word.template.macroEnabled.12",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml":         "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.template.main+xml":      "application/vnd.openxmlformats-officedocument.spreadsheetml.template",
+	"application/vnd.ms-excel.sheet.macroenabled.main+xml":                               "application/vnd.ms-excel.sheet.macroEnabled.12",
+	"application/vnd.ms-excel.template.macroenabled.main+xml":                            "application/vnd.ms-excel.template.macroEnabled.12",
+	"application/vnd.ms-excel.addin.macroenabled.main+xml":                               "application/vnd.ms-excel.addin.macroEnabled.12",
+	"application/vnd.ms-excel.sheet.binary.macroenabled.main":                            "application/vnd.ms-excel.sheet.binary.macroEnabled.12",
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation.main+xml": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	"application/vnd.openxmlformats-officedocument.presentationml.slideshow.main+xml":    "application/vnd.openxmlformats-officedocument.presentationml.slideshow",
+	"application/vnd.openxmlformats-officedocument.presentationml.template.main+xml":     "application/vnd.openxmlformats-officedocument.presentationml.template",
+	"application/vnd.ms-powerpoint.presentation.macroenabled.main+xml":                   "application/vnd.ms-powerpoint.presentation.macroEnabled.12",
+	"application/vnd.ms-powerpoint.slideshow.macroenabled.main+xml":                      "application/vnd.ms-powerpoint.slideshow.macroEnabled.12",
+	"application/vnd.ms-powerpoint.template.macroenabled.main+xml":                       "application/vnd.ms-powerpoint.template.macroEnabled.12",
+	"application/vnd.ms-powerpoint.addin.macroenabled.main+xml":                          "application/vnd.ms-powerpoint.addin.macroEnabled.12",
+}
+
+// ooxmlSlideType is the content type of a slide part. Presentations list
+// their slides too, so a package is only taken for a single slide (.sldx)
+// when it has no main part.
+const ooxmlSlideType = "application/vnd.openxmlformats-officedocument.presentationml.slide+xml"
+
+// DetectOffice inspects the ZIP archive r of the given size and returns the
+// exact MIME type of an Office Open XML document (from the main part
+// declared in [Content_Types].xml) or an OpenDocument file (from its
+// mimetype entry). It returns "" for archives that are neither, and an
+// error when r is not a readable ZIP archive or has more entries than
+// DetectArchive accepts.
+func DetectOffice(r io.ReaderAt, size int64) (string, error) {
+	zr, err := openZip(r, size)
+	if err != nil {
+		return "", err
+	}
+	return detectOffice(zr)
+}
+
+func detectOffice(zr *zip.Reader) (string, error) {
+	for i, f := range zr.File {
+		if i == maxArchiveEntries {
+			break
+		}
+		switch f.Name {
+		case "mimetype":
+			typ, err := readODFMimetype(f)
+			if err != nil || typ != "" {
+				return typ, err
+			}
+		case "[Content_Types].xml":
+			typ, err := readContentTypes(f)
+			if err != nil || typ != "" {
+				return typ, err
+			}
+		}
+	}
+	return "", nil
+}
+
+// readODFMimetype returns the media type stored in the mimetype entry of an
+// OpenDocument package. Only types known to be ZIP containers are accepted,
+// so that an archive cannot claim to be, say, text/html.
+func readODFMimetype(f *zip.File) (string, error) {
+	if f.UncompressedSize64 > maxMimetypeSize {
+		return "", nil
+	}
+	data, err := readEntry(f, maxMimetypeSize)
+	if err != nil {
+		return "", err
+	}
+	typ := strings.TrimSpace(string(data))
+	if checkType(typ) != nil || !DefaultRegistry.IsSubtypeOf(typ, typeZip) {
+		return "", nil
+	}
+	return typ, nil
+}
+
+// readContentTypes returns the file type implied by the main part listed in
+// an OOXML [Content_Types].xml entry.
+func readContentTypes(f *zip.File) (string, error) {
+	if f.UncompressedSize64 > maxContentTypesSize {
+		return "", nil
+	}
+	data, err := readEntry(f, maxContentTypesSize)
+	if err != nil {
+		return "", err
+	}
+	var doc struct {
+		Overrides []struct {
+			ContentType string `xml:",attr"`
+		} `xml:"Override"`
+		Defaults []struct {
+			ContentType string `xml:",attr"`
+		} `xml:"Default"`
+	}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return "", nil
+	}
+	var types []string
+	for _, o := range doc.Overrides {
+		types = append(types, strings.ToLower(o.ContentType))
+	}
+	for _, d := range doc.Defaults {
+		types = append(types, strings.ToLower(d.ContentType))
+	}
+	for _, t := range types {
+		if typ, ok := ooxmlMainTypes[t]; ok {
+			return typ, nil
+		}
+	}
+	for _, t := range types {
+		if t == ooxmlSlideType {
+			return "application/vnd.openxmlformats-officedocument.presentationml.slide", nil
+		}
+	}
+	return "", nil
+}
+
+// readEntry reads at most limit bytes of f.
+func readEntry(f *zip.File, limit int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(io.LimitReader(rc, limit))
+}
@@ -0,0 +1,75 @@
+package mime
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDetectOffice(t *testing.T) {
+	const (
+		pptxMain  = "application/vnd.openxmlformats-officedocument.presentationml.presentation.main+xml"
+		slidePart = "application/vnd.openxmlformats-officedocument.presentationml.slide+xml"
+	)
+	tests := []struct {
+		name    string
+		entries []string
+		want    string
+	}{
+		{
+			"pptx, slide listed first",
+			[]string{"[Content_Types].xml", contentTypes(
+				"/ppt/slides/slide1.xml", slidePart,
+				"/ppt/slides/slide2.xml", slidePart,
+				"/ppt/presentation.xml", pptxMain,
+			)},
+			"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		},
+		{
+			"pptx, presentation listed first",
+			[]string{"[Content_Types].xml", contentTypes(
+				"/ppt/presentation.xml", pptxMain,
+				"/ppt/slides/slide1.xml", slidePart,
+			)},
+			"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		},
+		{
+			"sldx",
+			[]string{"[Content_Types].xml", contentTypes("/ppt/slides/slide1.xml", slidePart)},
+			"application/vnd.openxmlformats-officedocument.presentationml.slide",
+		},
+		{
+			"xlsm",
+			[]string{"[Content_Types].xml", contentTypes("/xl/workbook.xml", "application/vnd.ms-excel.sheet.macroEnabled.main+xml")},
+			"application/vnd.ms-excel.sheet.macroEnabled.12",
+		},
+		{
+			"odt",
+			[]string{"mimetype", "application/vnd.oasis.opendocument.text", "content.xml", "<office:document-content/>"},
+			"application/vnd.oasis.opendocument.text",
+		},
+		{
+			"odt claiming html",
+			[]string{"mimetype", "text/html", "content.xml", "<html/>"},
+			"",
+		},
+		{
+			"plain zip",
+			[]string{"a.txt", "hello"},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		data := zipFile(t, tt.entries...)
+		got, err := DetectOffice(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: DetectOffice = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if _, err := DetectOffice(bytes.NewReader([]byte("not a zip")), 9); err == nil {
+		t.Errorf("DetectOffice(not a zip): no error")
+	}
+}
@@ -0,0 +1,183 @@
+package mime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Policy decides which MIME types are acceptable, for instance for uploads.
+// It is a list of media ranges such as "image/*, application/pdf,
+// !image/svg+xml"; a range prefixed with "!" denies the types it matches.
+//
+// A rule matches a type when the range matches the type, one of its aliases
+// or one of its ancestors, so "application/zip" matches a .docx document and
+// "!application/xml" denies image/svg+xml. application/octet-stream, the root
+// of the hierarchy, only matches itself. Allow rules do not match Executable
+// or Scriptable types through their ancestors: every script and markup
+// language is a kind of text/plain, yet "text/plain" must not allow
+// text/html or image/svg+xml, nor "application/zip" a Java archive. When
+// both allow and deny rules match, the first matching deny rule wins. A type
+// no rule matches is denied if the policy has any allow rule and allowed
+// otherwise, so a policy of only deny rules is a deny-list.
+type Policy struct {
+	registry *Registry
+	rules    []policyRule
+	allowAll bool // no allow rules: unmatched types are allowed
+}
+
+type policyRule struct {
+	text  string
+	deny  bool
+	media MediaType
+}
+
+// Decision is the result of evaluating a type against a Policy.
+type Decision struct {
+	Allowed bool
+	Type    string // canonical type that was evaluated
+	Rule    string // rule that decided, as written; "" if no rule matched
+	Via     string // ancestor of Type the rule matched, if not Type itself
+}
+
+func (d Decision) String() string {
+	verb := "denied"
+	if d.Allowed {
+		verb = "allowed"
+	}
+	switch {
+	case d.Rule == "":
+		return fmt.Sprintf("%s %s: no rule matches", verb, d.Type)
+	case d.Via != "":
+		return fmt.Sprintf("%s %s by %q via %s", verb, d.Type, d.Rule, d.Via)
+	}
+	return fmt.Sprintf("%s %s by %q", verb, d.Type, d.Rule)
+}
+
+// ParsePolicy parses a comma-separated list of rules evaluated against the
+// aliases and hierarchy of r. Ranges must not carry parameters.
+func (r *Registry) ParsePolicy(s string) (*Policy, error) {
+	p := &Policy{registry: r, allowAll: true}
+	for _, part := range strings.Split(s, ",") {
+		text := strings.TrimSpace(part)
+		if text == "" {
+			continue
+		}
+		rule := policyRule{text: text}
+		if strings.HasPrefix(text, "!") {
+			rule.deny = true
+			text = strings.TrimSpace(text[1:])
+		}
+		m, err := Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("mime: policy rule %q: %w", rule.text, err)
+		}
+		if len(m.Params) > 0 {
+			return nil, fmt.Errorf("mime: policy rule %q: parameters are not supported", rule.text)
+		}
+		rule.media = r.canonicalMediaType(m)
+		if !rule.deny {
+			p.allowAll = false
+		}
+		p.rules = append(p.rules, rule)
+	}
+	return p, nil
+}
+
+// ParsePolicy parses a policy evaluated against DefaultRegistry.
+func ParsePolicy(s string) (*Policy, error) {
+	return DefaultRegistry.ParsePolicy(s)
+}
+
+// MustParsePolicy is like ParsePolicy but panics if s cannot be parsed. It
+// is meant for policies written in the source code.
+func MustParsePolicy(s string) *Policy {
+	p, err := ParsePolicy(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Allows reports whether p accepts typ.
+func (p *Policy) Allows(typ string) bool {
+	return p.Evaluate(typ).Allowed
+}
+
+// Evaluate decides whether p accepts typ and explains which rule decided.
+// typ may carry parameters, as TypeByExtension and DetectContentType
+// results do; an empty typ is treated as application/octet-stream. A typ
+// that is not a valid media type is denied.
+func (p *Policy) Evaluate(typ string) Decision {
+	base := baseType(typ)
+	if base == "" {
+		base = typeOctetStream
+	}
+	m, err := Parse(base)
+	if err != nil || m.Type == "*" || m.Subtype == "*" {
+		return Decision{Type: base}
+	}
+	d := Decision{Type: p.registry.canonical(base), Allowed: p.allowAll}
+	candidates := []string{d.Type}
+	if d.Type != typeOctetStream {
+		for _, a := range p.registry.Ancestors(d.Type) {
+			if a != typeOctetStream {
+				candidates = append(candidates, a)
+			}
+		}
+	}
+
+	allowCandidates := candidates
+	if p.registry.TypeFlags(d.Type)&(Executable|Scriptable) != 0 {
+		allowCandidates = candidates[:1]
+	}
+
+	var allow *policyRule
+	var allowVia string
+	for i := range p.rules {
+		rule := &p.rules[i]
+		if rule.deny {
+			if via, ok := rule.match(candidates); ok {
+				d.Allowed, d.Rule, d.Via = false, rule.text, via
+				return d
+			}
+			continue
+		}
+		if allow == nil {
+			if via, ok := rule.match(allowCandidates); ok {
+				allow, allowVia = rule, via
+			}
+		}
+	}
+	if allow != nil {
+		d.Allowed, d.Rule, d.Via = true, allow.text, allowVia
+	}
+	return d
+}
+
+// String returns the rules of p in their normalized form.
+func (p *Policy) String() string {
+	parts := make([]string, len(p.rules))
+	for i, rule := range p.rules {
+		parts[i] = rule.media.Essence()
+		if rule.deny {
+			parts[i] = "!" + parts[i]
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// match reports whether the rule matches any of candidates, the evaluated
+// type followed by its ancestors, and returns the matching ancestor.
+func (rule *policyRule) match(candidates []string) (string, bool) {
+	for i, c := range candidates {
+		m, err := Parse(c)
+		if err != nil || !m.Matches(rule.media) {
+			continue
+		}
+		if i == 0 {
+			return "", true
+		}
+		return c, true
+	}
+	return "", false
+}
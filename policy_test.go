@@ -0,0 +1,62 @@
+package mime
+
+import "testing"
+
+func TestPolicyEvaluate(t *testing.T) {
+	tests := []struct {
+		policy string
+		typ    string
+		want   bool
+	}{
+		{"text/plain", "text/plain", true},
+		{"text/plain", "text/plain; charset=utf-8", true},
+		{"text/plain", "text/csv", true},
+		{"text/plain", "text/html", false},
+		{"text/plain", "image/svg+xml", false},
+		{"text/plain", "application/x-sh", false},
+		{"text/plain", "text/javascript", false},
+		{"text/*", "image/svg+xml", false},
+		{"text/*", "application/x-sh", false},
+		{"text/*", "text/html", true},
+		{"application/zip", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", true},
+		{"application/zip", "application/x-java-archive", false},
+		{"image/*", "image/png", true},
+		{"image/*, !image/svg+xml", "image/svg+xml", false},
+		{"image/*", "application/pdf", false},
+		{"!application/xml", "image/svg+xml", false},
+		{"!application/xml", "image/png", true},
+		{"application/octet-stream", "application/pdf", false},
+		{"application/pdf", "", false},
+		{"application/octet-stream", "", true},
+		{"image/png", "not a type", false},
+	}
+	for _, tt := range tests {
+		p, err := ParsePolicy(tt.policy)
+		if err != nil {
+			t.Fatalf("ParsePolicy(%q): %v", tt.policy, err)
+		}
+		if d := p.Evaluate(tt.typ); d.Allowed != tt.want {
+			t.Errorf("ParsePolicy(%q).Evaluate(%q) = %v, want allowed %v", tt.policy, tt.typ, d, tt.want)
+		}
+	}
+}
+
+func TestPolicyDecision(t *testing.T) {
+	p := MustParsePolicy("application/zip, !application/vnd.ms-word.document.macroEnabled.12")
+	d := p.Evaluate("application/vnd.openxmlformats-officedocument.wordprocessingml.document")
+	if !d.Allowed || d.Rule != "application/zip" || d.Via != "application/zip" {
+		t.Errorf("docx: %+v", d)
+	}
+	d = p.Evaluate("application/vnd.ms-word.document.macroenabled.12")
+	if d.Allowed || d.Rule != "!application/vnd.ms-word.document.macroEnabled.12" || d.Via != "" {
+		t.Errorf("docm: %+v", d)
+	}
+}
+
+func TestParsePolicyErrors(t *testing.T) {
+	for _, s := range []string{"image", "!", "text/plain; charset=utf-8"} {
+		if _, err := ParsePolicy(s); err == nil {
+			t.Errorf("ParsePolicy(%q): no error", s)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package mime
+
+import (
+	"mime"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Registry maps file extensions and file name patterns to MIME types and
+// records the aliases and sub-class relations between types. The zero value is not usable;
+// create registries with NewRegistry or derive them from DefaultRegistry with
+// Clone. A Registry is safe for concurrent use.
+//
+// Registries derived from DefaultRegistry also consult the standard
+// library's mime package, and so the system type database. Their entries
+// come in two flavours. Built-in entries, those of the package's own table,
+// are consulted only after the standard library, so that the system type
+// database keeps precedence, except where it merely reports
+// application/octet-stream. Entries added with Add, Merge or one of the
+// loaders override the standard library. Registries created with NewRegistry
+// consult nothing but their own entries.
+type Registry struct {
+	mu      sync.RWMutex
+	types   map[string]entry
+	aliases map[string]string   // lower-cased alias to canonical type
+	parents map[string][]string // lower-cased type to its direct parents
+	globs   []globRule
+	// removed holds the extensions deleted with Remove, which the standard
+	// library must not answer for either.
+	removed map[string]bool
+	// charsets maps types and "type/*" ranges to their default charset.
+	charsets map[string]string
+	flags    map[string]Flags // canonical type to its own flags
+	descs    map[string]typeDesc
+	// useStdlib makes the registry fall back to the standard library.
+	useStdlib bool
+	// builtinL10n makes Describe consult the built-in translations.
+	builtinL10n bool
+}
+
+type entry struct {
+	typ     string
+	builtin bool
+}
+
+// DefaultRegistry is the registry used by the package-level functions such as
+// TypeByExtension. It starts out with the built-in table.
+var DefaultRegistry = newBuiltinRegistry()
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		types:   make(map[string]entry),
+		removed: make(map[string]bool),
+		aliases: make(map[string]string),
+		parents: make(map[string][]string),
+
+		charsets: make(map[string]string),
+		flags:    make(map[string]Flags),
+		descs:    make(map[string]typeDesc),
+	}
+}
+
+func newBuiltinRegistry() *Registry {
+	r := NewRegistry()
+	for ext, typ := range extToMimeType {
+		r.types[ext] = entry{typ: typ, builtin: true}
+	}
+	for alias, typ := range builtinAliases {
+		r.aliases[alias] = typ
+	}
+	for typ, ps := range builtinParents {
+		for _, p := range ps {
+			r.addParent(typ, p)
+		}
+	}
+	r.globs = append(r.globs, builtinGlobs...)
+	for typ, cs := range builtinCharsets {
+		r.charsets[typ] = cs
+	}
+	for typ, f := range builtinFlags {
+		r.flags[r.canonicalLocked(typ)] = f
+	}
+	for typ, d := range typeDescs {
+		r.descs[typ] = d
+	}
+	r.builtinL10n = true
+	r.useStdlib = true
+	return r
+}
+
+// normExt lower-cases ext and makes sure it starts with a dot.
+func normExt(ext string) string {
+	ext = strings.ToLower(ext)
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	return ext
+}
+
+// Lookup returns the MIME type registered for ext. The leading dot is
+// optional and the comparison is case-insensitive. Unlike TypeByExtension it
+// never consults the standard library.
+func (r *Registry) Lookup(ext string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.types[normExt(ext)]
+	return e.typ, ok
+}
+
+// Add associates ext with the MIME type typ, replacing any previous entry.
+func (r *Registry) Add(ext, typ string) {
+	r.mu.Lock()
+	ext = normExt(ext)
+	r.types[ext] = entry{typ: typ}
+	delete(r.removed, ext)
+	r.mu.Unlock()
+}
+
+// Remove deletes the entry for ext, if any. TypeByExtension and
+// ExtensionsByType no longer report ext, even where the standard library
+// knows it, until it is added again.
+func (r *Registry) Remove(ext string) {
+	r.mu.Lock()
+	ext = normExt(ext)
+	delete(r.types, ext)
+	r.removed[ext] = true
+	r.mu.Unlock()
+}
+
+// Clone returns an independent copy of r.
+func (r *Registry) Clone() *Registry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c := NewRegistry()
+	for ext, e := range r.types {
+		c.types[ext] = e
+	}
+	for ext := range r.removed {
+		c.removed[ext] = true
+	}
+	c.useStdlib = r.useStdlib
+	for a, typ := range r.aliases {
+		c.aliases[a] = typ
+	}
+	for typ, ps := range r.parents {
+		c.parents[typ] = append([]string(nil), ps...)
+	}
+	c.globs = append(c.globs, r.globs...)
+	for typ, cs := range r.charsets {
+		c.charsets[typ] = cs
+	}
+	for typ, f := range r.flags {
+		c.flags[typ] = f
+	}
+	for typ, d := range r.descs {
+		c.descs[typ] = d
+	}
+	c.builtinL10n = r.builtinL10n
+	return c
+}
+
+// Merge copies every entry of other into r. Extension and alias entries of
+// other win over those already present in r; parent relations are combined.
+// Extensions removed from other are not removed from r, and r only consults
+// the standard library if it did before.
+func (r *Registry) Merge(other *Registry) {
+	o := other.Clone()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for ext, e := range o.types {
+		r.types[ext] = e
+		delete(r.removed, ext)
+	}
+	for a, typ := range o.aliases {
+		r.setAlias(a, typ)
+	}
+	for typ, ps := range o.parents {
+		for _, p := range ps {
+			r.addParent(typ, p)
+		}
+	}
+	for _, g := range o.globs {
+		r.addGlobLocked(g)
+	}
+	for typ, cs := range o.charsets {
+		r.charsets[typ] = cs
+	}
+	for typ, f := range o.flags {
+		r.flags[r.canonicalLocked(typ)] = f
+	}
+	for typ, d := range o.descs {
+		r.descs[typ] = d
+	}
+	r.builtinL10n = r.builtinL10n || o.builtinL10n
+}
+
+// addParent records parent as a direct parent of typ, both resolved to their
+// canonical names. r.mu must be held.
+func (r *Registry) addParent(typ, parent string) {
+	typ = r.canonicalLocked(strings.ToLower(typ))
+	parent = r.canonicalLocked(strings.ToLower(parent))
+	if typ == parent {
+		return
+	}
+	for _, p := range r.parents[typ] {
+		if p == parent {
+			return
+		}
+	}
+	r.parents[typ] = append(r.parents[typ], parent)
+}
+
+// TypeByExtension returns the MIME type associated with the file name of
+// filePath, or "" if there is none. Glob rules with a weight of 50 or more
+// are consulted first, then the extension table, where compound extensions
+// are matched longest first so that "backup.tar.gz" finds an entry for
+// ".tar.gz" before the one for ".gz". Glob rules with a lower weight only
+// apply when nothing else matched. A type without a charset parameter gets
+// the one the default charset policy assigns to it.
+func (r *Registry) TypeByExtension(filePath string) string {
+	base := path.Base(filePath)
+	r.mu.RLock()
+	g, globbed := r.matchGlobLocked(base)
+	r.mu.RUnlock()
+	typ := g.typ
+	if !globbed || g.Weight < defaultGlobWeight {
+		if t := r.typeByExt(base); t != "" {
+			typ = t
+		}
+	}
+	if typ == "" {
+		return ""
+	}
+	return r.withCharset(typ, "")
+}
+
+// typeByExt looks up the extension of the file name base.
+func (r *Registry) typeByExt(base string) string {
+	ext := path.Ext(base)
+	r.mu.RLock()
+	for i := 1; i < len(base)-len(ext); i++ {
+		if base[i] != '.' {
+			continue
+		}
+		if e, ok := r.types[strings.ToLower(base[i:])]; ok {
+			r.mu.RUnlock()
+			return e.typ
+		}
+	}
+	e, ok := r.types[strings.ToLower(ext)]
+	std := r.useStdlib && !r.removed[strings.ToLower(ext)]
+	r.mu.RUnlock()
+	if !std || ok && !e.builtin {
+		return e.typ
+	}
+	// The system database only overrides a built-in entry with something
+	// more specific than "unknown binary data". The standard library adds
+	// "; charset=utf-8" to every text type; it is dropped so that the
+	// charset policy of r applies instead.
+	if typ := mime.TypeByExtension(ext); typ != "" && !(ok && baseType(typ) == typeOctetStream) {
+		if i := strings.IndexByte(typ, ';'); i >= 0 {
+			typ = strings.TrimSpace(typ[:i])
+		}
+		return typ
+	}
+	return e.typ
+}
+
+// ExtensionsByType returns the extensions associated with the MIME type typ
+// or any of its aliases, drawn from r and, for registries derived from
+// DefaultRegistry, the standard library table. Parameters such as
+// "; charset=utf-8" are ignored. The preferred extension comes first, the
+// remaining ones follow in lexical order. When no extension is known the
+// result is nil.
+func (r *Registry) ExtensionsByType(typ string) ([]string, error) {
+	mediaType, _, err := mime.ParseMediaType(typ)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	useStdlib := r.useStdlib
+	r.mu.RUnlock()
+	canon := r.canonical(mediaType)
+	var std []string
+	if useStdlib {
+		names := []string{canon}
+		if mediaType != canon {
+			names = append(names, mediaType)
+		}
+		for _, name := range names {
+			exts, err := mime.ExtensionsByType(name)
+			if err != nil {
+				return nil, err
+			}
+			std = append(std, exts...)
+		}
+	}
+	seen := make(map[string]bool)
+	r.mu.RLock()
+	for _, ext := range std {
+		if ext = strings.ToLower(ext); !r.removed[ext] {
+			seen[ext] = true
+		}
+	}
+	for ext, e := range r.types {
+		if r.canonicalLocked(strings.ToLower(e.typ)) == canon {
+			seen[ext] = true
+		}
+	}
+	r.mu.RUnlock()
+	if len(seen) == 0 {
+		return nil, nil
+	}
+	exts := make([]string, 0, len(seen))
+	for ext := range seen {
+		exts = append(exts, ext)
+	}
+	preferred := preferredExt[canon]
+	sort.Slice(exts, func(i, j int) bool {
+		if (exts[i] == preferred) != (exts[j] == preferred) {
+			return exts[i] == preferred
+		}
+		return exts[i] < exts[j]
+	})
+	return exts, nil
+}
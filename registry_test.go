@@ -0,0 +1,82 @@
+package mime
+
+import "testing"
+
+func TestNewRegistryIsolated(t *testing.T) {
+	r := NewRegistry()
+	for _, name := range []string{"a.html", "a.png", "a.pdf"} {
+		if got := r.TypeByExtension(name); got != "" {
+			t.Errorf("NewRegistry().TypeByExtension(%q) = %q, want \"\"", name, got)
+		}
+	}
+	if exts, err := r.ExtensionsByType("text/html"); err != nil || exts != nil {
+		t.Errorf("NewRegistry().ExtensionsByType(text/html) = %v, %v; want nil", exts, err)
+	}
+
+	r.Add(".html", "text/html")
+	if got := r.TypeByExtension("a.HTML"); got != "text/html" {
+		t.Errorf("TypeByExtension(a.HTML) = %q", got)
+	}
+	if exts, _ := r.ExtensionsByType("text/html"); len(exts) != 1 || exts[0] != ".html" {
+		t.Errorf("ExtensionsByType(text/html) = %v, want [.html]", exts)
+	}
+}
+
+func TestRegistryRemove(t *testing.T) {
+	for _, ext := range []string{".png", ".xlsb", ".html"} {
+		r := DefaultRegistry.Clone()
+		name := "a" + ext
+		if r.TypeByExtension(name) == "" {
+			t.Fatalf("TypeByExtension(%q) = \"\" before Remove", name)
+		}
+		r.Remove(ext)
+		if typ, ok := r.Lookup(ext); ok {
+			t.Errorf("Lookup(%q) = %q after Remove", ext, typ)
+		}
+		if got := r.TypeByExtension(name); got != "" {
+			t.Errorf("TypeByExtension(%q) = %q after Remove", name, got)
+		}
+		if DefaultRegistry.TypeByExtension(name) == "" {
+			t.Errorf("Remove(%q) on a clone changed DefaultRegistry", ext)
+		}
+
+		r.Add(ext, "application/x-test")
+		if got := r.TypeByExtension(name); got != "application/x-test" {
+			t.Errorf("TypeByExtension(%q) = %q after Add", name, got)
+		}
+	}
+
+	r := DefaultRegistry.Clone()
+	r.Remove(".png")
+	exts, _ := r.ExtensionsByType("image/png")
+	for _, ext := range exts {
+		if ext == ".png" {
+			t.Errorf("ExtensionsByType(image/png) = %v after Remove(.png)", exts)
+		}
+	}
+	if c := r.Clone(); c.TypeByExtension("a.png") != "" {
+		t.Errorf("Clone lost the removal of .png")
+	}
+}
+
+func TestRegistryMerge(t *testing.T) {
+	r := NewRegistry()
+	r.Add(".foo", "application/x-foo")
+	r.Remove(".bar")
+
+	o := NewRegistry()
+	o.Add(".bar", "application/x-bar")
+	o.Add(".foo", "application/x-foo2")
+	r.Merge(o)
+	if got, _ := r.Lookup(".foo"); got != "application/x-foo2" {
+		t.Errorf("Lookup(.foo) = %q after Merge", got)
+	}
+	if got := r.TypeByExtension("a.bar"); got != "application/x-bar" {
+		t.Errorf("TypeByExtension(a.bar) = %q after Merge", got)
+	}
+
+	r.Merge(DefaultRegistry)
+	if got := r.TypeByExtension("a.html"); got != "text/html; charset=utf-8" {
+		t.Errorf("TypeByExtension(a.html) = %q after merging DefaultRegistry", got)
+	}
+}
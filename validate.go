@@ -0,0 +1,229 @@
+package mime
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// ReasonCode identifies why Validate rejected an upload.
+type ReasonCode string
+
+const (
+	// ReasonDeclaredInvalid: the declared Content-Type cannot be parsed.
+	ReasonDeclaredInvalid ReasonCode = "declared-invalid"
+	// ReasonExtensionMismatch: the type implied by the file name disagrees
+	// with the content.
+	ReasonExtensionMismatch ReasonCode = "extension-mismatch"
+	// ReasonDeclaredMismatch: the declared Content-Type disagrees with the
+	// content.
+	ReasonDeclaredMismatch ReasonCode = "declared-mismatch"
+	// ReasonDeclaredExtension: the declared Content-Type disagrees with the
+	// type implied by the file name.
+	ReasonDeclaredExtension ReasonCode = "declared-extension-mismatch"
+	// ReasonActiveContent: the content is markup or script that a browser
+	// may execute, while the name or declared type claims otherwise.
+	ReasonActiveContent ReasonCode = "active-content"
+	// ReasonPolyglot: the content is valid as several unrelated formats, or
+	// a binary format carries embedded markup.
+	ReasonPolyglot ReasonCode = "polyglot"
+)
+
+// Reason is one finding of Validate.
+type Reason struct {
+	Code   ReasonCode
+	Detail string
+}
+
+func (r Reason) String() string {
+	return string(r.Code) + ": " + r.Detail
+}
+
+// Verdict is the outcome of Validate. The type fields hold canonical names
+// without parameters; they are empty when the corresponding source gave no
+// answer.
+type Verdict struct {
+	OK       bool     // no reasons were found
+	ByName   string   // type implied by the file name
+	Declared string   // type declared by the client
+	Detected string   // type determined from the content
+	Matches  []string // every format the content is valid as
+	Reasons  []Reason
+}
+
+// embeddedMarkup are byte sequences that indicate markup or script inside an
+// otherwise binary file, as found in GIFAR-style polyglots.
+var embeddedMarkup = [][]byte{
+	[]byte("<script"), []byte("<html"), []byte("<svg"), []byte("<iframe"),
+	[]byte("<body"), []byte("javascript:"), []byte("<?php"),
+}
+
+// Validate cross-checks an upload: the type r implies for name, the
+// Content-Type declaredType sent by the client and the type sniffed from
+// the content read from rd. Types agree when they are equal, aliases of each
+// other, or one is a kind of the other, so a .docx upload sniffed as
+// application/zip passes. Only the beginning of rd is read, unless rd also
+// implements io.ReaderAt and io.Seeker, as *os.File and multipart.File do,
+// in which case ZIP-based formats are told apart by DetectArchive. Either
+// name or declaredType may be empty to skip that check. The error is only
+// non-nil when reading fails.
+func (r *Registry) Validate(name, declaredType string, rd io.Reader) (Verdict, error) {
+	var v Verdict
+	data := make([]byte, defaultMagic.readLen())
+	n, err := io.ReadFull(rd, data)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return v, err
+	}
+	data = data[:n]
+
+	if name != "" {
+		if typ := r.TypeByExtension(name); typ != "" {
+			v.ByName = r.canonical(baseType(typ))
+		}
+	}
+	if declaredType != "" {
+		if m, err := Parse(declaredType); err != nil {
+			v.reject(ReasonDeclaredInvalid, fmt.Sprintf("cannot parse %q", declaredType))
+		} else {
+			v.Declared = r.canonical(m.Essence())
+		}
+	}
+
+	sigs := defaultMagic.detectAll(data)
+	for _, sig := range sigs {
+		v.Matches = appendUnique(v.Matches, r.canonical(sig.Type))
+	}
+	detected := DetectBytes(data).Type
+	if detected == typeZip || r.IsSubtypeOf(detected, typeZip) {
+		if typ, err := detectArchiveAt(rd); err == nil && typ != "" {
+			detected = typ
+		}
+	}
+	if detected != typeOctetStream {
+		v.Detected = r.canonical(detected)
+	}
+
+	if v.Detected != "" {
+		if v.ByName != "" && !r.agree(v.ByName, v.Detected) {
+			v.reject(ReasonExtensionMismatch, fmt.Sprintf("%s implies %s, content is %s", name, v.ByName, v.Detected))
+		}
+		if v.Declared != "" && !r.agree(v.Declared, v.Detected) {
+			v.reject(ReasonDeclaredMismatch, fmt.Sprintf("declared %s, content is %s", v.Declared, v.Detected))
+		}
+		if r.isActive(v.Detected) && (v.ByName != "" && !r.isActive(v.ByName) || v.Declared != "" && !r.isActive(v.Declared)) {
+			v.reject(ReasonActiveContent, fmt.Sprintf("content is %s", v.Detected))
+		}
+	}
+	if v.ByName != "" && v.Declared != "" && !r.agree(v.ByName, v.Declared) {
+		v.reject(ReasonDeclaredExtension, fmt.Sprintf("declared %s, %s implies %s", v.Declared, name, v.ByName))
+	}
+	if detail := r.polyglot(sigs, data); detail != "" {
+		v.reject(ReasonPolyglot, detail)
+	}
+	v.OK = len(v.Reasons) == 0
+	return v, nil
+}
+
+// Validate cross-checks an upload using DefaultRegistry.
+func Validate(name, declaredType string, rd io.Reader) (Verdict, error) {
+	return DefaultRegistry.Validate(name, declaredType, rd)
+}
+
+func (v *Verdict) reject(code ReasonCode, detail string) {
+	v.Reasons = append(v.Reasons, Reason{Code: code, Detail: detail})
+}
+
+// agree reports whether a and b describe compatible types.
+func (r *Registry) agree(a, b string) bool {
+	return r.IsSubtypeOf(a, b) || r.IsSubtypeOf(b, a)
+}
+
+// isActive reports whether typ is rendered or run as active content.
+func (r *Registry) isActive(typ string) bool {
+	return r.HasFlags(typ, Scriptable)
+}
+
+// polyglot describes why the content looks like a polyglot file, or returns
+// "" if it does not. Only confident signatures are considered; those related
+// by the type hierarchy, such as a .docx and application/zip, and generic
+// signatures that a more specific one refines do not count.
+func (r *Registry) polyglot(sigs []Signature, data []byte) string {
+	const minPriority = 50
+	var confident []Signature
+	var first string
+	for _, sig := range sigs {
+		if sig.Priority < minPriority || refinedBy(sig, confident, data) {
+			continue
+		}
+		if first == "" {
+			first = sig.Type
+		} else if !r.agree(first, sig.Type) {
+			return fmt.Sprintf("content is both %s and %s", r.canonical(first), r.canonical(sig.Type))
+		}
+		confident = append(confident, sig)
+	}
+	if first == "" || r.isTextual(first) || r.isActive(first) {
+		return ""
+	}
+	lower := bytes.ToLower(data)
+	for _, m := range embeddedMarkup {
+		if bytes.Contains(lower, m) {
+			return fmt.Sprintf("%s content embeds %q", r.canonical(first), m)
+		}
+	}
+	return ""
+}
+
+// refinedBy reports whether one of specific matches data with a test that
+// extends a test of sig at the same offset, as the "ftypqt  " brand of
+// QuickTime extends the "ftyp" box every ISO base media file starts with.
+func refinedBy(sig Signature, specific []Signature, data []byte) bool {
+	for _, m := range sig.Matches {
+		if m.Range != 0 || m.Mask != nil || !m.match(data) {
+			continue
+		}
+		for _, s := range specific {
+			for _, sm := range s.Matches {
+				if sm.Offset == m.Offset && sm.Range == 0 && sm.Mask == nil &&
+					bytes.HasPrefix(sm.Value, m.Value) && sm.match(data) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// detectArchiveAt runs DetectArchive on rd if it supports random access. The
+// read offset of rd is left where it was.
+func detectArchiveAt(rd io.Reader) (string, error) {
+	type readSeekerAt interface {
+		io.ReaderAt
+		io.Seeker
+	}
+	rs, ok := rd.(readSeekerAt)
+	if !ok {
+		return "", nil
+	}
+	cur, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", err
+	}
+	size, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", err
+	}
+	if _, err := rs.Seek(cur, io.SeekStart); err != nil {
+		return "", err
+	}
+	return DetectArchive(rs, size)
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, t := range list {
+		if t == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
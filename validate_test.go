@@ -0,0 +1,110 @@
+package mime
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// isoBMFF returns the start of an ISO base media file with the given major
+// brand.
+func isoBMFF(brand string) []byte {
+	b := []byte("\x00\x00\x00\x18ftyp" + brand + "\x00\x00\x02\x00isomiso2")
+	return append(b, "\x00\x00\x00\x08free\x00\x00\x00\x08mdat"...)
+}
+
+// zipFile returns a ZIP archive holding the named entries.
+func zipFile(t *testing.T, entries ...string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for i := 0; i+1 < len(entries); i += 2 {
+		w, err := zw.Create(entries[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(entries[i+1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// contentTypes returns a [Content_Types].xml declaring the given overrides,
+// each a part name followed by its content type.
+func contentTypes(overrides ...string) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	for i := 0; i+1 < len(overrides); i += 2 {
+		b.WriteString(`<Override PartName="` + overrides[i] + `" ContentType="` + overrides[i+1] + `"/>`)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+func docx(t *testing.T) []byte {
+	return zipFile(t,
+		"[Content_Types].xml", contentTypes("/word/document.xml", "application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"),
+		"word/document.xml", `<w:document/>`,
+	)
+}
+
+func TestValidateOK(t *testing.T) {
+	tests := []struct {
+		name, declared string
+		data           []byte
+	}{
+		{"clip.mov", "video/quicktime", isoBMFF("qt  ")},
+		{"clip.3gp", "video/3gpp", isoBMFF("3gp5")},
+		{"clip.mp4", "video/mp4", isoBMFF("isom")},
+		{"clip.m4v", "video/x-m4v", isoBMFF("M4V ")},
+		{"report.docx", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", docx(t)},
+		{"report.docx", "application/zip", docx(t)},
+		{"photo.png", "image/png", []byte("\x89PNG\r\n\x1A\n\x00\x00\x00\x0DIHDR")},
+		{"notes.txt", "text/plain; charset=utf-8", []byte("just some notes\n")},
+		{"page.html", "text/html", []byte("<!DOCTYPE html><html></html>")},
+		{"movie.swf", "application/x-shockwave-flash", []byte("FWS\x0A\x10\x00\x00\x00")},
+	}
+	for _, tt := range tests {
+		v, err := Validate(tt.name, tt.declared, bytes.NewReader(tt.data))
+		if err != nil {
+			t.Fatalf("Validate(%q): %v", tt.name, err)
+		}
+		if !v.OK {
+			t.Errorf("Validate(%q, %q) = %+v, want OK", tt.name, tt.declared, v)
+		}
+	}
+}
+
+func TestValidateReject(t *testing.T) {
+	gifar := append([]byte("GIF89a\x01\x00\x01\x00"), "<script>alert(1)</script>"...)
+	tests := []struct {
+		name, declared string
+		data           []byte
+		want           ReasonCode
+	}{
+		{"photo.jpg", "image/jpeg", []byte("<html><script>alert(1)</script></html>"), ReasonActiveContent},
+		{"photo.jpg", "image/jpeg", []byte("\x89PNG\r\n\x1A\n"), ReasonExtensionMismatch},
+		{"photo.png", "image/jpeg", []byte("\x89PNG\r\n\x1A\n"), ReasonDeclaredMismatch},
+		{"photo.png", "not a type", []byte("\x89PNG\r\n\x1A\n"), ReasonDeclaredInvalid},
+		{"image.gif", "image/gif", gifar, ReasonPolyglot},
+	}
+	for _, tt := range tests {
+		v, err := Validate(tt.name, tt.declared, bytes.NewReader(tt.data))
+		if err != nil {
+			t.Fatalf("Validate(%q): %v", tt.name, err)
+		}
+		found := false
+		for _, r := range v.Reasons {
+			found = found || r.Code == tt.want
+		}
+		if v.OK || !found {
+			t.Errorf("Validate(%q, %q) = %+v, want reason %s", tt.name, tt.declared, v, tt.want)
+		}
+	}
+}